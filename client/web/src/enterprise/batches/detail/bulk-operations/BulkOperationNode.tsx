@@ -1,9 +1,11 @@
 import classNames from 'classnames'
+import AccountMultipleIcon from 'mdi-react/AccountMultipleIcon'
 import CommentOutlineIcon from 'mdi-react/CommentOutlineIcon'
 import ExternalLinkIcon from 'mdi-react/ExternalLinkIcon'
 import LinkVariantRemoveIcon from 'mdi-react/LinkVariantRemoveIcon'
 import SourceBranchIcon from 'mdi-react/SourceBranchIcon'
 import SyncIcon from 'mdi-react/SyncIcon'
+import TagIcon from 'mdi-react/TagIcon'
 import UploadIcon from 'mdi-react/UploadIcon'
 import React from 'react'
 
@@ -50,6 +52,16 @@ const OPERATION_TITLES: Record<BulkOperationType, JSX.Element> = {
             <UploadIcon className="icon-inline text-muted" /> Publish changesets
         </>
     ),
+    REQUEST_REVIEWERS: (
+        <>
+            <AccountMultipleIcon className="icon-inline text-muted" /> Request reviewers on changesets
+        </>
+    ),
+    ADD_LABELS: (
+        <>
+            <TagIcon className="icon-inline text-muted" /> Add labels to changesets
+        </>
+    ),
 }
 
 export interface BulkOperationNodeProps {
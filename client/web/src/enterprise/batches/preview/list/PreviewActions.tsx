@@ -7,6 +7,7 @@ import ImportIcon from 'mdi-react/ImportIcon'
 import SourceBranchCheckIcon from 'mdi-react/SourceBranchCheckIcon'
 import SourceBranchRefreshIcon from 'mdi-react/SourceBranchRefreshIcon'
 import SourceBranchSyncIcon from 'mdi-react/SourceBranchSyncIcon'
+import SourceMergeIcon from 'mdi-react/SourceMergeIcon'
 import TrashIcon from 'mdi-react/TrashIcon'
 import UploadIcon from 'mdi-react/UploadIcon'
 import UploadNetworkIcon from 'mdi-react/UploadNetworkIcon'
@@ -74,6 +75,10 @@ const PreviewAction: React.FunctionComponent<PreviewActionProps> = ({ operation,
             return <PreviewActionDetach className={className} />
         case ChangesetSpecOperation.ARCHIVE:
             return <PreviewActionArchive className={className} />
+        case ChangesetSpecOperation.MERGE:
+            return <PreviewActionMerge className={className} />
+        case ChangesetSpecOperation.REBASE:
+            return <PreviewActionRebase className={className} />
         case ChangesetSpecOperation.SYNC:
         case ChangesetSpecOperation.SLEEP:
             // We don't want to expose these states.
@@ -211,6 +216,30 @@ export const PreviewActionArchive: React.FunctionComponent<{ label?: string; cla
         <span>{label}</span>
     </div>
 )
+export const PreviewActionMerge: React.FunctionComponent<{ label?: string; className?: string }> = ({
+    label = 'Merge',
+    className,
+}) => (
+    <div className={classNames(className, iconClassNames)}>
+        <SourceMergeIcon
+            className="text-merged mr-1 icon-inline"
+            data-tooltip="This changeset will be merged on the code host, because its checks passed and it has been approved"
+        />
+        <span>{label}</span>
+    </div>
+)
+export const PreviewActionRebase: React.FunctionComponent<{ label?: string; className?: string }> = ({
+    label = 'Rebase',
+    className,
+}) => (
+    <div className={classNames(className, iconClassNames)}>
+        <SourceBranchSyncIcon
+            className="text-muted mr-1 icon-inline"
+            data-tooltip="This changeset will be recreated on top of the new commit of its base branch"
+        />
+        <span>{label}</span>
+    </div>
+)
 export enum NoActionReason {
     NO_ACCESS = 'no-access',
 }
@@ -42,6 +42,7 @@ add('Requires SSH - step 1', () => {
                     )}
                     externalServiceURL="https://github.com/"
                     requiresSSH={true}
+                    requiresUsername={false}
                     afterCreate={noop}
                     onCancel={noop}
                     createBatchChangesCredential={createBatchChangesCredential}
@@ -63,6 +64,7 @@ add('Requires SSH - step 2', () => (
                 )}
                 externalServiceURL="https://github.com/"
                 requiresSSH={true}
+                requiresUsername={false}
                 afterCreate={noop}
                 onCancel={noop}
                 initialStep="get-ssh-key"
@@ -80,6 +82,7 @@ add('GitHub', () => (
                 externalServiceKind={ExternalServiceKind.GITHUB}
                 externalServiceURL="https://github.com/"
                 requiresSSH={false}
+                requiresUsername={false}
                 afterCreate={noop}
                 onCancel={noop}
             />
@@ -96,6 +99,7 @@ add('GitLab', () => (
                 externalServiceKind={ExternalServiceKind.GITLAB}
                 externalServiceURL="https://gitlab.com/"
                 requiresSSH={false}
+                requiresUsername={false}
                 afterCreate={noop}
                 onCancel={noop}
             />
@@ -112,6 +116,24 @@ add('Bitbucket Server', () => (
                 externalServiceKind={ExternalServiceKind.BITBUCKETSERVER}
                 externalServiceURL="https://bitbucket.sgdev.org/"
                 requiresSSH={false}
+                requiresUsername={false}
+                afterCreate={noop}
+                onCancel={noop}
+            />
+        )}
+    </WebStory>
+))
+
+add('Bitbucket Cloud', () => (
+    <WebStory>
+        {props => (
+            <AddCredentialModal
+                {...props}
+                userID="user-id-1"
+                externalServiceKind={ExternalServiceKind.BITBUCKETCLOUD}
+                externalServiceURL="https://bitbucket.org/"
+                requiresSSH={false}
+                requiresUsername={true}
                 afterCreate={noop}
                 onCancel={noop}
             />
@@ -21,6 +21,7 @@ export interface AddCredentialModalProps {
     externalServiceKind: ExternalServiceKind
     externalServiceURL: string
     requiresSSH: boolean
+    requiresUsername: boolean
 
     /** For testing only. */
     createBatchChangesCredential?: typeof _createBatchChangesCredential
@@ -57,10 +58,21 @@ const helpTexts: Record<ExternalServiceKind, JSX.Element> = {
         </>
     ),
 
+    [ExternalServiceKind.BITBUCKETCLOUD]: (
+        <>
+            <a href={HELP_TEXT_LINK_URL} rel="noreferrer noopener" target="_blank">
+                Create a new app password
+            </a>{' '}
+            with <code>account:read</code>, <code>repositories:write</code>, and <code>pullrequests:write</code>{' '}
+            permissions.
+        </>
+    ),
+
     // These are just for type completeness and serve as placeholders for a bright future.
-    [ExternalServiceKind.BITBUCKETCLOUD]: <span>Unsupported</span>,
     [ExternalServiceKind.GITOLITE]: <span>Unsupported</span>,
+    [ExternalServiceKind.GOMODULES]: <span>Unsupported</span>,
     [ExternalServiceKind.JVMPACKAGES]: <span>Unsupported</span>,
+    [ExternalServiceKind.NPMPACKAGES]: <span>Unsupported</span>,
     [ExternalServiceKind.PERFORCE]: <span>Unsupported</span>,
     [ExternalServiceKind.PHABRICATOR]: <span>Unsupported</span>,
     [ExternalServiceKind.AWSCODECOMMIT]: <span>Unsupported</span>,
@@ -76,12 +88,14 @@ export const AddCredentialModal: React.FunctionComponent<AddCredentialModalProps
     externalServiceKind,
     externalServiceURL,
     requiresSSH,
+    requiresUsername,
     createBatchChangesCredential = _createBatchChangesCredential,
     initialStep = 'add-token',
 }) => {
     const labelId = 'addCredential'
     const [isLoading, setIsLoading] = useState<boolean | Error>(false)
     const [credential, setCredential] = useState<string>('')
+    const [username, setUsername] = useState<string>('')
     const [sshPublicKey, setSSHPublicKey] = useState<string>()
     const [step, setStep] = useState<Step>(initialStep)
 
@@ -89,6 +103,10 @@ export const AddCredentialModal: React.FunctionComponent<AddCredentialModalProps
         setCredential(event.target.value)
     }, [])
 
+    const onChangeUsername = useCallback<React.ChangeEventHandler<HTMLInputElement>>(event => {
+        setUsername(event.target.value)
+    }, [])
+
     const onSubmit = useCallback<React.FormEventHandler>(
         async event => {
             event.preventDefault()
@@ -96,6 +114,7 @@ export const AddCredentialModal: React.FunctionComponent<AddCredentialModalProps
             try {
                 const createdCredential = await createBatchChangesCredential({
                     user: userID,
+                    username: requiresUsername ? username : null,
                     credential,
                     externalServiceKind,
                     externalServiceURL,
@@ -113,6 +132,8 @@ export const AddCredentialModal: React.FunctionComponent<AddCredentialModalProps
         [
             afterCreate,
             userID,
+            requiresUsername,
+            username,
             credential,
             externalServiceKind,
             externalServiceURL,
@@ -164,8 +185,29 @@ export const AddCredentialModal: React.FunctionComponent<AddCredentialModalProps
                     <>
                         {isErrorLike(isLoading) && <ErrorAlert error={isLoading} />}
                         <Form onSubmit={onSubmit}>
+                            {requiresUsername && (
+                                <div className="form-group">
+                                    <label htmlFor="username">Username</label>
+                                    <input
+                                        id="username"
+                                        name="username"
+                                        type="text"
+                                        autoComplete="off"
+                                        className="form-control test-add-credential-modal-username-input"
+                                        required={true}
+                                        spellCheck="false"
+                                        minLength={1}
+                                        value={username}
+                                        onChange={onChangeUsername}
+                                    />
+                                </div>
+                            )}
                             <div className="form-group">
-                                <label htmlFor="token">Personal access token</label>
+                                <label htmlFor="token">
+                                    {externalServiceKind === ExternalServiceKind.BITBUCKETCLOUD
+                                        ? 'App password'
+                                        : 'Personal access token'}
+                                </label>
                                 <input
                                     id="token"
                                     name="token"
@@ -191,7 +233,11 @@ export const AddCredentialModal: React.FunctionComponent<AddCredentialModalProps
                                 </button>
                                 <button
                                     type="submit"
-                                    disabled={isLoading === true || credential.length === 0}
+                                    disabled={
+                                        isLoading === true ||
+                                        credential.length === 0 ||
+                                        (requiresUsername && username.length === 0)
+                                    }
                                     className="btn btn-primary test-add-credential-modal-submit"
                                 >
                                     {isLoading === true && <LoadingSpinner className="icon-inline" />}
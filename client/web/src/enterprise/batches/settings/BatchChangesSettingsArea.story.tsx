@@ -30,12 +30,14 @@ add('Overview', () => (
                                 externalServiceKind: ExternalServiceKind.GITHUB,
                                 externalServiceURL: 'https://github.com/',
                                 requiresSSH: false,
+                                requiresUsername: false,
                             },
                             {
                                 credential: null,
                                 externalServiceKind: ExternalServiceKind.GITLAB,
                                 externalServiceURL: 'https://gitlab.com/',
                                 requiresSSH: false,
+                                requiresUsername: false,
                             },
                             {
                                 credential: {
@@ -47,6 +49,7 @@ add('Overview', () => (
                                 externalServiceKind: ExternalServiceKind.BITBUCKETSERVER,
                                 externalServiceURL: 'https://bitbucket.sgdev.org/',
                                 requiresSSH: true,
+                                requiresUsername: false,
                             },
                         ],
                     })
@@ -80,6 +83,7 @@ add('Config added', () => (
                                 externalServiceKind: ExternalServiceKind.GITHUB,
                                 externalServiceURL: 'https://github.com/',
                                 requiresSSH: false,
+                                requiresUsername: false,
                             },
                             {
                                 credential: {
@@ -91,6 +95,7 @@ add('Config added', () => (
                                 externalServiceKind: ExternalServiceKind.GITLAB,
                                 externalServiceURL: 'https://gitlab.com/',
                                 requiresSSH: false,
+                                requiresUsername: false,
                             },
                             {
                                 credential: {
@@ -102,6 +107,7 @@ add('Config added', () => (
                                 externalServiceKind: ExternalServiceKind.BITBUCKETSERVER,
                                 externalServiceURL: 'https://bitbucket.sgdev.org/',
                                 requiresSSH: true,
+                                requiresUsername: false,
                             },
                         ],
                     })
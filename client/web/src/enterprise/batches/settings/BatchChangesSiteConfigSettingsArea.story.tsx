@@ -29,18 +29,21 @@ add('Overview', () => (
                                 externalServiceKind: ExternalServiceKind.GITHUB,
                                 externalServiceURL: 'https://github.com/',
                                 requiresSSH: false,
+                                requiresUsername: false,
                             },
                             {
                                 credential: null,
                                 externalServiceKind: ExternalServiceKind.GITLAB,
                                 externalServiceURL: 'https://gitlab.com/',
                                 requiresSSH: false,
+                                requiresUsername: false,
                             },
                             {
                                 credential: null,
                                 externalServiceKind: ExternalServiceKind.BITBUCKETSERVER,
                                 externalServiceURL: 'https://bitbucket.sgdev.org/',
                                 requiresSSH: true,
+                                requiresUsername: false,
                             },
                         ],
                     })
@@ -73,6 +76,7 @@ add('Config added', () => (
                                 externalServiceKind: ExternalServiceKind.GITHUB,
                                 externalServiceURL: 'https://github.com/',
                                 requiresSSH: false,
+                                requiresUsername: false,
                             },
                             {
                                 credential: {
@@ -84,6 +88,7 @@ add('Config added', () => (
                                 externalServiceKind: ExternalServiceKind.GITLAB,
                                 externalServiceURL: 'https://gitlab.com/',
                                 requiresSSH: false,
+                                requiresUsername: false,
                             },
                             {
                                 credential: {
@@ -95,6 +100,7 @@ add('Config added', () => (
                                 externalServiceKind: ExternalServiceKind.BITBUCKETSERVER,
                                 externalServiceURL: 'https://bitbucket.sgdev.org/',
                                 requiresSSH: true,
+                                requiresUsername: false,
                             },
                         ],
                     })
@@ -32,6 +32,7 @@ add('No ssh', () => (
                 codeHost={{
                     credential,
                     requiresSSH: false,
+                    requiresUsername: false,
                     externalServiceKind: ExternalServiceKind.GITHUB,
                     externalServiceURL: 'https://github.com/',
                 }}
@@ -51,6 +52,7 @@ add('Requires ssh', () => (
                 codeHost={{
                     credential,
                     requiresSSH: true,
+                    requiresUsername: false,
                     externalServiceKind: ExternalServiceKind.GITHUB,
                     externalServiceURL: 'https://github.com/',
                 }}
@@ -33,6 +33,7 @@ add('View', () => (
                     externalServiceKind: ExternalServiceKind.GITHUB,
                     externalServiceURL: 'https://github.com/',
                     requiresSSH: true,
+                    requiresUsername: false,
                 }}
                 credential={credential}
                 onClose={noop}
@@ -830,6 +830,7 @@ describe('Batches', () => {
                                           }
                                         : null,
                                     requiresSSH: false,
+                                    requiresUsername: false,
                                 },
                             ],
                         },
@@ -885,5 +886,69 @@ describe('Batches', () => {
             // No modal open.
             assert.strictEqual(await driver.page.$('.test-remove-credential-modal'), null)
         })
+
+        it('requires a username for Bitbucket Cloud', async () => {
+            let createdWithUsername: string | null | undefined
+            testContext.overrideGraphQL({
+                ...commonWebGraphQlResults,
+                ...mockCommonGraphQLResponses('user'),
+                UserBatchChangesCodeHosts: () => ({
+                    node: {
+                        __typename: 'User',
+                        batchChangesCodeHosts: {
+                            totalCount: 1,
+                            pageInfo: {
+                                endCursor: null,
+                                hasNextPage: false,
+                            },
+                            nodes: [
+                                {
+                                    externalServiceKind: ExternalServiceKind.BITBUCKETCLOUD,
+                                    externalServiceURL: 'https://bitbucket.org/',
+                                    credential:
+                                        createdWithUsername !== undefined
+                                            ? {
+                                                  id: '123',
+                                                  isSiteCredential: false,
+                                                  sshPublicKey: null,
+                                              }
+                                            : null,
+                                    requiresSSH: false,
+                                    requiresUsername: true,
+                                },
+                            ],
+                        },
+                    },
+                }),
+                CreateBatchChangesCredential: ({ username }) => {
+                    createdWithUsername = username
+                    return {
+                        createBatchChangesCredential: {
+                            id: '123',
+                            isSiteCredential: false,
+                            sshPublicKey: null,
+                        },
+                    }
+                },
+            })
+
+            await driver.page.goto(driver.sourcegraphBaseUrl + '/users/alice/settings/batch-changes')
+            await driver.page.waitForSelector('.test-code-host-connection-node')
+            await driver.page.click('.test-code-host-connection-node-btn-add')
+            await driver.page.waitForSelector('.test-add-credential-modal')
+            // Enter the app password only: the form can't be submitted yet.
+            await driver.page.type('.test-add-credential-modal-input', 'SUPER SECRET')
+            assert.strictEqual(
+                await driver.page.$eval('.test-add-credential-modal-submit', button =>
+                    (button as HTMLButtonElement).hasAttribute('disabled')
+                ),
+                true
+            )
+            // Enter the username and submit.
+            await driver.page.type('.test-add-credential-modal-username-input', 'alice')
+            await driver.page.click('.test-add-credential-modal-submit')
+            await driver.page.waitForSelector('.test-code-host-connection-node-enabled')
+            assert.strictEqual(createdWithUsername, 'alice')
+        })
     })
 })
@@ -154,6 +154,7 @@ type CreateBatchChangesCredentialArgs struct {
 	ExternalServiceKind string
 	ExternalServiceURL  string
 	User                *graphql.ID
+	Username            *string
 	Credential          string
 }
 
@@ -212,6 +213,17 @@ type PublishChangesetsArgs struct {
 	Draft bool
 }
 
+type RequestReviewersOnChangesetsArgs struct {
+	BulkOperationBaseArgs
+	Reviewers     []string
+	TeamReviewers []string
+}
+
+type AddLabelsToChangesetsArgs struct {
+	BulkOperationBaseArgs
+	Labels []string
+}
+
 type ResolveWorkspacesForBatchSpecArgs struct {
 	BatchSpec        string
 	AllowIgnored     bool
@@ -261,6 +273,8 @@ type BatchChangesResolver interface {
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
 	CloseChangesets(ctx context.Context, args *CloseChangesetsArgs) (BulkOperationResolver, error)
 	PublishChangesets(ctx context.Context, args *PublishChangesetsArgs) (BulkOperationResolver, error)
+	RequestReviewersOnChangesets(ctx context.Context, args *RequestReviewersOnChangesetsArgs) (BulkOperationResolver, error)
+	AddLabelsToChangesets(ctx context.Context, args *AddLabelsToChangesetsArgs) (BulkOperationResolver, error)
 
 	// Queries
 	BatchChange(ctx context.Context, args *BatchChangeArgs) (BatchChangeResolver, error)
@@ -507,6 +521,7 @@ type BatchChangesCodeHostResolver interface {
 	ExternalServiceKind() string
 	ExternalServiceURL() string
 	RequiresSSH() bool
+	RequiresUsername() bool
 	HasWebhooks() bool
 	Credential() BatchChangesCredentialResolver
 }
@@ -700,6 +715,8 @@ type ExternalChangesetResolver interface {
 
 	Error() *string
 	SyncerError() *string
+	// AutoMergeWaitReason returns a value of type *btypes.ChangesetAutoMergeWaitReason.
+	AutoMergeWaitReason() *string
 	ScheduleEstimateAt(ctx context.Context) (*DateTime, error)
 
 	CurrentSpec(ctx context.Context) (VisibleChangesetSpecResolver, error)
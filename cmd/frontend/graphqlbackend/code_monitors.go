@@ -20,6 +20,7 @@ type CodeMonitorsResolver interface {
 	UpdateCodeMonitor(ctx context.Context, args *UpdateCodeMonitorArgs) (MonitorResolver, error)
 	ResetTriggerQueryTimestamps(ctx context.Context, args *ResetTriggerQueryTimestampsArgs) (*EmptyResponse, error)
 	TriggerTestEmailAction(ctx context.Context, args *TriggerTestEmailActionArgs) (*EmptyResponse, error)
+	SetCodeMonitorEmailOptOut(ctx context.Context, args *SetCodeMonitorEmailOptOutArgs) (*EmptyResponse, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -167,6 +168,11 @@ type TriggerTestEmailActionArgs struct {
 	Email       *CreateActionEmailArgs
 }
 
+type SetCodeMonitorEmailOptOutArgs struct {
+	Id     graphql.ID
+	OptOut bool
+}
+
 type CreateMonitorArgs struct {
 	Namespace   graphql.ID
 	Description string
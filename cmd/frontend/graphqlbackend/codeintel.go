@@ -153,7 +153,7 @@ type GitBlobLSIFDataResolver interface {
 	Stencil(ctx context.Context) ([]RangeResolver, error)
 	Ranges(ctx context.Context, args *LSIFRangesArgs) (CodeIntelligenceRangeConnectionResolver, error)
 	Definitions(ctx context.Context, args *LSIFQueryPositionArgs) (LocationConnectionResolver, error)
-	References(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
+	References(ctx context.Context, args *LSIFPagedQueryReferencesArgs) (LocationConnectionResolver, error)
 	Implementations(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Hover(ctx context.Context, args *LSIFQueryPositionArgs) (HoverResolver, error)
 	Documentation(ctx context.Context, args *LSIFQueryPositionArgs) (DocumentationResolver, error)
@@ -183,6 +183,11 @@ type LSIFPagedQueryPositionArgs struct {
 	After *string
 }
 
+type LSIFPagedQueryReferencesArgs struct {
+	LSIFPagedQueryPositionArgs
+	VersionRange *string
+}
+
 type LSIFQueryDocumentationArgs struct {
 	PathID string
 }
@@ -213,6 +218,13 @@ type CodeIntelligenceRangeResolver interface {
 type LocationConnectionResolver interface {
 	Nodes(ctx context.Context) ([]LocationResolver, error)
 	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+	Groups(ctx context.Context) ([]LocationGroupResolver, error)
+}
+
+type LocationGroupResolver interface {
+	Repository() *RepositoryResolver
+	Version() *string
+	Nodes() []LocationResolver
 }
 
 type HoverResolver interface {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/inconshreveable/log15"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/compute"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -122,6 +123,10 @@ func (c *computeTextResolver) Commit() *string {
 }
 
 func (c *computeTextResolver) Path() *string {
+	if c.path == "" {
+		// Results such as patches span several files.
+		return nil
+	}
 	value := c.path
 	return &value
 }
@@ -132,6 +137,29 @@ func (c *computeTextResolver) Kind() *string {
 }
 func (c *computeTextResolver) Value() string { return c.t.Value }
 
+// ComputeGroupCount GQL result resolver definitions.
+
+type computeGroupCountResolver struct {
+	gc *compute.GroupCounts
+}
+
+func (c *computeGroupCountResolver) Groups() []*computeGroupResolver {
+	groups := make([]*computeGroupResolver, 0, len(c.gc.Groups))
+	for _, g := range c.gc.Groups {
+		groups = append(groups, &computeGroupResolver{g: g})
+	}
+	return groups
+}
+
+func (c *computeGroupCountResolver) OtherCount() int32 { return int32(c.gc.OtherCount) }
+
+type computeGroupResolver struct {
+	g compute.Group
+}
+
+func (c *computeGroupResolver) Value() string { return c.g.Value }
+func (c *computeGroupResolver) Count() int32  { return int32(c.g.Count) }
+
 // Definitions required by https://github.com/graph-gophers/graphql-go to resolve
 // a union type in GraphQL.
 
@@ -145,6 +173,11 @@ func (r *computeResultResolver) ToComputeText() (*computeTextResolver, bool) {
 	return res, ok
 }
 
+func (r *computeResultResolver) ToComputeGroupCount() (*computeGroupCountResolver, bool) {
+	res, ok := r.result.(*computeGroupCountResolver)
+	return res, ok
+}
+
 func toComputeMatchContextResolver(fm *result.FileMatch, mc *compute.MatchContext, repository *RepositoryResolver) *computeMatchContextResolver {
 	var computeMatches []*computeMatchResolver
 	for _, m := range mc.Matches {
@@ -195,6 +228,58 @@ func toResultResolverList(ctx context.Context, cmd compute.Command, matches []re
 		return resolver
 	}
 
+	if count, ok := cmd.(*compute.Count); ok {
+		// Counts are aggregated over all matches into a single result.
+		counter := count.NewGroupCounter()
+		for _, m := range matches {
+			if fm, ok := m.(*result.FileMatch); ok {
+				result, err := count.Run(ctx, fm)
+				if err != nil {
+					return nil, err
+				}
+				counter.Add(result.(*compute.GroupCounts))
+			}
+		}
+		return []*computeResultResolver{{result: &computeGroupCountResolver{gc: counter.Snapshot()}}}, nil
+	}
+
+	if replace, ok := cmd.(*compute.Replace); ok && replace.Diff {
+		// File diffs are combined into a single patch per repository and commit.
+		type patchKey struct {
+			Repo   types.MinimalRepo
+			Commit api.CommitID
+		}
+		var keys []patchKey
+		diffs := make(map[patchKey][]*compute.Text)
+		for _, m := range matches {
+			if fm, ok := m.(*result.FileMatch); ok {
+				result, err := replace.Run(ctx, fm)
+				if err != nil {
+					return nil, err
+				}
+				key := patchKey{Repo: fm.Repo, Commit: fm.CommitID}
+				if _, ok := diffs[key]; !ok {
+					keys = append(keys, key)
+				}
+				diffs[key] = append(diffs[key], result.(*compute.Text))
+			}
+		}
+
+		results := make([]*computeResultResolver, 0, len(keys))
+		for _, key := range keys {
+			patch := compute.CombineDiffs(diffs[key])
+			if patch.Value == "" {
+				continue
+			}
+			results = append(results, &computeResultResolver{result: &computeTextResolver{
+				repository: getRepoResolver(key.Repo, ""),
+				commit:     string(key.Commit),
+				t:          patch,
+			}})
+		}
+		return results, nil
+	}
+
 	results := make([]*computeResultResolver, 0, len(matches))
 	for _, m := range matches {
 		if fm, ok := m.(*result.FileMatch); ok {
@@ -3,6 +3,7 @@ package graphqlbackend
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/hexops/autogold"
@@ -40,3 +41,31 @@ func TestToResultResolverList(t *testing.T) {
 
 	autogold.Want("resolver copies all match results", `["a","b"]`).Equal(t, test("a|b"))
 }
+
+func TestToResultResolverList_Count(t *testing.T) {
+	matches := []result.Match{
+		&result.FileMatch{LineMatches: []*result.LineMatch{{Preview: "a1 b2"}, {Preview: "a1"}}},
+		&result.FileMatch{LineMatches: []*result.LineMatch{{Preview: "a3"}}},
+	}
+	computeQuery, err := compute.Parse(`content:count(a(\d) -> $1)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolvers, err := toResultResolverList(context.Background(), computeQuery.Command, matches, dbmock.NewMockDB())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []string
+	for _, r := range resolvers {
+		gc, ok := r.ToComputeGroupCount()
+		if !ok {
+			t.Fatalf("unexpected result %T", r.result)
+		}
+		for _, g := range gc.Groups() {
+			results = append(results, fmt.Sprintf("%s:%d", g.Value(), g.Count()))
+		}
+	}
+	v, _ := json.Marshal(results)
+	autogold.Want("resolver aggregates counts over all matches", `["1:2","3:1"]`).Equal(t, string(v))
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/honey"
 	"github.com/sourcegraph/sourcegraph/internal/rcache"
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/blame"
 	"github.com/sourcegraph/sourcegraph/internal/search/commit"
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
@@ -1564,15 +1565,24 @@ func (r *searchResolver) doResults(ctx context.Context, args *search.TextParamet
 		defer cancelOnLimit()
 	}
 
-	var agg *run.Aggregator
+	var filterFuncs []func(*streaming.SearchEvent) error
 	if r.subRepoPerms.Enabled() {
 		// If enabled, provide an additional filtering function for aggregator to apply.
 		//
 		// TODO(#27372): Applying sub-repo permissions here is not the intended final design.
-		agg = run.NewAggregator(r.db, stream, subRepoPermsFilter(ctx, r.subRepoPerms))
-	} else {
-		agg = run.NewAggregator(r.db, stream, nil)
+		filterFuncs = append(filterFuncs, subRepoPermsFilter(ctx, r.subRepoPerms))
+	}
+	blameFilter, err := blame.FilterFromQuery(args.Query)
+	if err != nil {
+		return nil, err
 	}
+	if blameFilter != nil {
+		// The blame filter runs last, so that only authorized matches are blamed.
+		filterFuncs = append(filterFuncs, func(event *streaming.SearchEvent) error {
+			return blameFilter.Apply(ctx, event)
+		})
+	}
+	agg := run.NewAggregator(r.db, stream, chainFilterFuncs(filterFuncs))
 
 	// This ensures we properly cleanup in the case of an early return. In
 	// particular we want to cancel global searches before returning early.
@@ -1951,3 +1961,20 @@ func subRepoPermsFilter(ctx context.Context, srp authz.SubRepoPermissionChecker)
 		return errs.ErrorOrNil()
 	}
 }
+
+// chainFilterFuncs returns a filtering function that applies all of fns in
+// order, or nil if there are none.
+func chainFilterFuncs(fns []func(*streaming.SearchEvent) error) func(*streaming.SearchEvent) error {
+	if len(fns) == 0 {
+		return nil
+	}
+	return func(event *streaming.SearchEvent) error {
+		errs := &multierror.Error{}
+		for _, fn := range fns {
+			if err := fn(event); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
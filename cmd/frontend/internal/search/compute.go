@@ -0,0 +1,144 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/compute"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// ComputeStreamHandler is an http handler which streams back the group counts
+// of a compute count query. While the search runs, it periodically sends the
+// counts of the results found so far as "groupCounts" events, starting with
+// empty counts. The last "groupCounts" event before "done" holds the final
+// counts.
+//
+// Only count commands are supported. Other compute commands are resolved per
+// result and are available through the GraphQL compute field.
+func ComputeStreamHandler(db database.DB) http.Handler {
+	return &computeStreamHandler{
+		db:                  db,
+		newSearchResolver:   defaultNewSearchResolver,
+		flushTickerInternal: 500 * time.Millisecond,
+	}
+}
+
+type computeStreamHandler struct {
+	db                  database.DB
+	newSearchResolver   func(context.Context, database.DB, *graphqlbackend.SearchArgs) (searchResolver, error)
+	flushTickerInternal time.Duration
+}
+
+func (h *computeStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	q := r.URL.Query().Get("q")
+	if q == "" {
+		http.Error(w, "no query found", http.StatusBadRequest)
+		return
+	}
+	computeQuery, err := compute.Parse(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	count, ok := computeQuery.Command.(*compute.Count)
+	if !ok {
+		http.Error(w, "only count commands can be streamed", http.StatusBadRequest)
+		return
+	}
+	searchQuery, err := computeQuery.ToSearchQuery()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tr, ctx := trace.New(ctx, "compute.ServeStream", q)
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	eventWriter, err := streamhttp.NewWriter(w)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Always send a final done event so clients know the stream is shutting
+	// down.
+	defer eventWriter.Event("done", map[string]interface{}{})
+
+	counter := count.NewGroupCounter()
+	// Start with empty counts, so that clients are sent a first event right
+	// away.
+	dirty := int32(1)
+	patternType := "regexp"
+	search, err := h.newSearchResolver(ctx, h.db, &graphqlbackend.SearchArgs{
+		Query:       searchQuery,
+		PatternType: &patternType,
+
+		Stream: streaming.StreamFunc(func(event streaming.SearchEvent) {
+			for _, m := range event.Results {
+				fm, ok := m.(*result.FileMatch)
+				if !ok {
+					continue
+				}
+				counts, err := count.Run(ctx, fm)
+				if err != nil {
+					log15.Warn("compute: failed to count groups", "repo", fm.Repo.Name, "path", fm.Path, "error", err)
+					continue
+				}
+				counter.Add(counts.(*compute.GroupCounts))
+				atomic.StoreInt32(&dirty, 1)
+			}
+		}),
+	})
+	if err != nil {
+		_ = eventWriter.Event("error", streamhttp.EventError{Message: err.Error()})
+		return
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := search.Results(ctx)
+		done <- err
+	}()
+
+	flushTicker := time.NewTicker(h.flushTickerInternal)
+	defer flushTicker.Stop()
+
+	sendCounts := func() {
+		if atomic.SwapInt32(&dirty, 0) == 1 {
+			_ = eventWriter.Event("groupCounts", counter.Snapshot())
+		}
+	}
+	sendCounts()
+
+	for {
+		select {
+		case <-flushTicker.C:
+			sendCounts()
+		case err = <-done:
+			// Always send the final counts, even if nothing was found.
+			atomic.StoreInt32(&dirty, 1)
+			sendCounts()
+			if err != nil && !errors.Is(err, context.Canceled) {
+				_ = eventWriter.Event("error", streamhttp.EventError{Message: err.Error()})
+			}
+			return
+		}
+	}
+}
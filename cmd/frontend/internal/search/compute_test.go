@@ -0,0 +1,115 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/compute"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+)
+
+func TestServeComputeStream(t *testing.T) {
+	mock := &mockSearchResolver{
+		done: make(chan struct{}),
+	}
+	streams := make(chan streaming.Sender, 1)
+
+	ts := httptest.NewServer(&computeStreamHandler{
+		flushTickerInternal: 1 * time.Millisecond,
+		newSearchResolver: func(_ context.Context, _ database.DB, args *graphqlbackend.SearchArgs) (searchResolver, error) {
+			streams <- args.Stream
+			return mock, nil
+		}})
+	defer ts.Close()
+
+	fileMatch := func(lines ...string) *result.FileMatch {
+		fm := &result.FileMatch{}
+		for _, l := range lines {
+			fm.LineMatches = append(fm.LineMatches, &result.LineMatch{Preview: l})
+		}
+		return fm
+	}
+
+	res, err := http.Get(ts.URL + "?q=" + url.QueryEscape(`content:count(v(\d+) -> $1)`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != 200 {
+		t.Fatalf("expected status 200, got %d", res.StatusCode)
+	}
+
+	stream := <-streams
+
+	var events []*compute.GroupCounts
+	dec := streamhttp.NewDecoder(res.Body)
+	for dec.Scan() {
+		switch string(dec.Event()) {
+		case "groupCounts":
+			var gc compute.GroupCounts
+			if err := json.Unmarshal(dec.Data(), &gc); err != nil {
+				t.Fatal(err)
+			}
+			events = append(events, &gc)
+			switch len(events) {
+			case 1:
+				// The first batch of results is reported before the search
+				// finishes.
+				stream.Send(streaming.SearchEvent{Results: []result.Match{fileMatch("v1 v2", "v1")}})
+			case 2:
+				stream.Send(streaming.SearchEvent{Results: []result.Match{fileMatch("v2 v3")}})
+				mock.Close()
+			}
+		case "error":
+			t.Fatalf("unexpected error event: %s", dec.Data())
+		}
+	}
+	if err := dec.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) < 3 {
+		t.Fatalf("expected initial, partial and final group counts, got %d events", len(events))
+	}
+	if diff := cmp.Diff(&compute.GroupCounts{Groups: []compute.Group{}}, events[0]); diff != "" {
+		t.Errorf("unexpected initial counts (-want +got):\n%s", diff)
+	}
+	wantPartial := &compute.GroupCounts{Groups: []compute.Group{{Value: "1", Count: 2}, {Value: "2", Count: 1}}}
+	if diff := cmp.Diff(wantPartial, events[1]); diff != "" {
+		t.Errorf("unexpected partial counts (-want +got):\n%s", diff)
+	}
+	wantFinal := &compute.GroupCounts{Groups: []compute.Group{{Value: "1", Count: 2}, {Value: "2", Count: 2}, {Value: "3", Count: 1}}}
+	if diff := cmp.Diff(wantFinal, events[len(events)-1]); diff != "" {
+		t.Errorf("unexpected final counts (-want +got):\n%s", diff)
+	}
+}
+
+func TestServeComputeStream_unsupportedCommand(t *testing.T) {
+	ts := httptest.NewServer(&computeStreamHandler{
+		flushTickerInternal: 1 * time.Millisecond,
+		newSearchResolver: func(context.Context, database.DB, *graphqlbackend.SearchArgs) (searchResolver, error) {
+			t.Fatal("search should not run")
+			return nil, nil
+		}})
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "?q=" + url.QueryEscape(`content:replace(a -> b)`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", res.StatusCode)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodules/goproxy"
 	"github.com/sourcegraph/sourcegraph/internal/hostname"
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
 	"github.com/sourcegraph/sourcegraph/internal/logging"
@@ -41,6 +43,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
 	"github.com/sourcegraph/sourcegraph/internal/tracer"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -94,6 +97,10 @@ func main() {
 	}, nil)
 	externalServiceStore := database.ExternalServices(db)
 
+	// All Go module syncers share the state of the checksum databases, so
+	// that the tree heads they verified survive restarts.
+	goSumDBCache := goproxy.NewSumDBCache(filepath.Join(reposDir, ".go-sumdb"))
+
 	err = keyring.Init(ctx)
 	if err != nil {
 		log.Fatalf("failed to initialise keyring: %s", err)
@@ -133,21 +140,8 @@ func main() {
 			case extsvc.TypePerforce:
 				// Extract options from external service config
 				var c schema.PerforceConnection
-				for _, info := range r.Sources {
-					es, err := externalServiceStore.GetByID(ctx, info.ExternalServiceID())
-					if err != nil {
-						return nil, errors.Wrap(err, "get external service")
-					}
-
-					normalized, err := jsonc.Parse(es.Config)
-					if err != nil {
-						return nil, errors.Wrap(err, "normalize JSON")
-					}
-
-					if err = jsoniter.Unmarshal(normalized, &c); err != nil {
-						return nil, errors.Wrap(err, "unmarshal JSON")
-					}
-					break
+				if err := getVCSSyncerConfig(ctx, externalServiceStore, r, &c); err != nil {
+					return nil, err
 				}
 
 				return &server.PerforceDepotSyncer{
@@ -157,24 +151,29 @@ func main() {
 				}, nil
 			case extsvc.TypeJVMPackages:
 				var c schema.JVMPackagesConnection
-				for _, info := range r.Sources {
-					es, err := externalServiceStore.GetByID(ctx, info.ExternalServiceID())
-					if err != nil {
-						return nil, errors.Wrap(err, "get external service")
-					}
-
-					normalized, err := jsonc.Parse(es.Config)
-					if err != nil {
-						return nil, errors.Wrap(err, "normalize JSON")
-					}
-
-					if err = jsoniter.Unmarshal(normalized, &c); err != nil {
-						return nil, errors.Wrap(err, "unmarshal JSON")
-					}
-					break
+				if err := getVCSSyncerConfig(ctx, externalServiceStore, r, &c); err != nil {
+					return nil, err
+				}
+
+				return server.NewJVMPackagesSyncer(&c, codeintelDB), nil
+			case extsvc.TypeNPMPackages:
+				var c schema.NPMPackagesConnection
+				if err := getVCSSyncerConfig(ctx, externalServiceStore, r, &c); err != nil {
+					return nil, err
+				}
+
+				return server.NewNPMPackagesSyncer(&c, nil), nil
+			case extsvc.TypeGoModules:
+				var c schema.GoModulesConnection
+				if err := getVCSSyncerConfig(ctx, externalServiceStore, r, &c); err != nil {
+					return nil, err
 				}
 
-				return &server.JVMPackagesSyncer{Config: &c, DBStore: codeintelDB}, nil
+				client, err := goproxy.NewClient(&c, nil, goSumDBCache)
+				if err != nil {
+					return nil, err
+				}
+				return server.NewGoModulesSyncer(&c, client)
 			}
 			return &server.GitRepoSyncer{}, nil
 		},
@@ -247,6 +246,28 @@ func main() {
 	gitserver.Stop()
 }
 
+// getVCSSyncerConfig unmarshals the config of the first external service of
+// the repo into config.
+func getVCSSyncerConfig(ctx context.Context, externalServiceStore database.ExternalServiceStore, r *types.Repo, config interface{}) error {
+	for _, info := range r.Sources {
+		es, err := externalServiceStore.GetByID(ctx, info.ExternalServiceID())
+		if err != nil {
+			return errors.Wrap(err, "get external service")
+		}
+
+		normalized, err := jsonc.Parse(es.Config)
+		if err != nil {
+			return errors.Wrap(err, "normalize JSON")
+		}
+
+		if err = jsoniter.Unmarshal(normalized, config); err != nil {
+			return errors.Wrap(err, "unmarshal JSON")
+		}
+		break
+	}
+	return nil
+}
+
 func configureFusionClient(conn schema.PerforceConnection) server.FusionConfig {
 	// Set up default settings first
 	fc := server.FusionConfig{
@@ -869,6 +869,15 @@ func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
 		paths   = q["path"]
 	)
 
+	if r.Method == http.MethodPost {
+		var req protocol.ArchiveRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		treeish, repo, format, paths = req.Treeish, string(req.Repo), req.Format, req.Paths
+	}
+
 	if err := checkSpecArgSafety(treeish); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		log15.Error("gitserver.archive.CheckSpecArgSafety", "error", err)
@@ -905,7 +914,12 @@ func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
 	}
 
 	req.Args = append(req.Args, treeish, "--")
-	req.Args = append(req.Args, paths...)
+	for _, path := range paths {
+		// The literal magic has the effect of --literal-pathspecs, which we
+		// can't pass since the arguments must start with the subcommand:
+		// paths are matched exactly instead of as glob patterns.
+		req.Args = append(req.Args, ":(literal)"+path)
+	}
 
 	s.exec(w, r, req)
 }
@@ -1033,6 +1047,8 @@ func (s *Server) search(ctx context.Context, args *protocol.SearchRequest, match
 			// TODO add result to trace
 			if rev.RevSpec != "" {
 				_ = s.ensureRevision(ctx, args.Repo, rev.RevSpec, dir)
+			} else if rev.ExcludeRevSpec != "" {
+				_ = s.ensureRevision(ctx, args.Repo, rev.ExcludeRevSpec, dir)
 			} else if rev.RefGlob != "" {
 				_ = s.ensureRevision(ctx, args.Repo, rev.RefGlob, dir)
 			}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	modzip "golang.org/x/mod/zip"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodules/goproxy"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// sourcegraphGoDependency is used to set GIT_AUTHOR_NAME for git commands
+// that don't create commits or tags. The name of this dependency should never
+// be publicly visible so it can have any random value.
+var sourcegraphGoDependency = reposource.GoDependency{
+	GoModule: reposource.GoModule{Path: "sourcegraph.com/sourcegraph"},
+	Version:  "v1.0.0",
+}
+
+// NewGoModulesSyncer returns a VCSSyncer that syncs the Go modules of the
+// given connection. If client is nil, a client for the proxies of the
+// connection is used.
+func NewGoModulesSyncer(connection *schema.GoModulesConnection, client *goproxy.Client) (*PackagesSyncer, error) {
+	if client == nil {
+		var err error
+		if client, err = goproxy.NewClient(connection, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return &PackagesSyncer{
+		typ:         "go_modules",
+		placeholder: sourcegraphGoDependency,
+		source:      &goModulesSource{config: connection, client: client},
+	}, nil
+}
+
+// goModulesSource downloads module zips from Go module proxies.
+type goModulesSource struct {
+	config *schema.GoModulesConnection
+	client *goproxy.Client
+}
+
+var _ packagesSource = &goModulesSource{}
+
+// dependencies returns the list of Go dependencies that belong to the given
+// URL path, sorted by version with the latest version first. A URL maps to a
+// single Go module, which may contain multiple versions (one git tag per
+// version).
+func (s *goModulesSource) dependencies(_ context.Context, repoURLPath string) ([]packageDependency, error) {
+	mod, err := reposource.ParseGoModuleFromRepoURL(repoURLPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependencies []reposource.GoDependency
+	for _, dep := range s.config.Dependencies {
+		if !mod.MatchesDependencyString(dep) {
+			continue
+		}
+		dependency, err := reposource.ParseGoDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+
+	if len(dependencies) == 0 {
+		return nil, errors.Errorf("no Go dependencies for URL path %s", repoURLPath)
+	}
+
+	reposource.SortGoDependencies(dependencies)
+
+	packageDependencies := make([]packageDependency, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		packageDependencies = append(packageDependencies, dependency)
+	}
+	return packageDependencies, nil
+}
+
+func (s *goModulesSource) exists(ctx context.Context, dependency packageDependency) (bool, error) {
+	return s.client.Exists(ctx, dependency.(reposource.GoDependency))
+}
+
+// download fetches the module zip, which is verified against the checksum
+// database, and extracts it to workingDirectory.
+func (s *goModulesSource) download(ctx context.Context, dependency packageDependency, workingDirectory string) error {
+	goDependency := dependency.(reposource.GoDependency)
+
+	zipFile := filepath.Join(filepath.Dir(workingDirectory), "module.zip")
+	if err := s.client.GetZip(ctx, goDependency, zipFile); err != nil {
+		return err
+	}
+
+	return unzipModule(goDependency, zipFile, workingDirectory)
+}
+
+// unzipModule extracts the module zip to destination, which must not exist
+// or be empty.
+// modzip.Unzip validates the zip, such that it only contains files of the
+// module below the `path@version/` prefix.
+func unzipModule(dependency reposource.GoDependency, zipFile, destination string) error {
+	if err := modzip.Unzip(destination, dependency.ModuleVersion(), zipFile); err != nil {
+		return errors.Wrapf(err, "failed to unzip %s", dependency.PackageManagerSyntax())
+	}
+
+	// For security reasons, don't keep files under the `.git/` directory,
+	// which would be used by the `git init` that follows. See
+	// https://github.com/sourcegraph/security-issues/issues/163
+	return os.RemoveAll(filepath.Join(destination, ".git"))
+}
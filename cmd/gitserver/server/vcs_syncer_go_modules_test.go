@@ -0,0 +1,200 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	exampleGoFilePath      = "example.go"
+	exampleGoFileContents  = "package example\n\nconst Version = 1\n"
+	exampleGoFileContents2 = "package example\n\nconst Version = 2\n"
+	exampleGoModule        = "example.com/example"
+	exampleGoVersion       = "v1.0.0"
+	exampleGoVersion2      = "v2.0.0+incompatible"
+	exampleGoDependency    = exampleGoModule + "@" + exampleGoVersion
+	exampleGoDependency2   = exampleGoModule + "@" + exampleGoVersion2
+	exampleGoModuleURL     = "go/" + exampleGoModule
+)
+
+// newGoModuleProxy returns a stand-in for a Go module proxy that serves the
+// zips of the given versions of the example.com/example module.
+func newGoModuleProxy(t *testing.T, zips map[string][]byte) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		prefix := "/" + exampleGoModule + "/@v/"
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			http.NotFound(w, r)
+			return
+		}
+		file := strings.TrimPrefix(r.URL.Path, prefix)
+		ext := path.Ext(file)
+		moduleZip, ok := zips[strings.TrimSuffix(file, ext)]
+		if !ok {
+			http.Error(w, "not found", http.StatusGone)
+			return
+		}
+		switch ext {
+		case ".info":
+			fmt.Fprintf(w, `{"Version":%q}`, strings.TrimSuffix(file, ext))
+		case ".zip":
+			_, _ = w.Write(moduleZip)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func createModuleZip(t *testing.T, version string, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for name, contents := range files {
+		w, err := zipWriter.Create(exampleGoModule + "@" + version + "/" + name)
+		assert.Nil(t, err)
+		_, err = w.Write([]byte(contents))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, zipWriter.Close())
+	return buf.Bytes()
+}
+
+func runGoModulesCloneCommand(t *testing.T, config *schema.GoModulesConnection, bareGitDirectory string, dependencies []string) {
+	t.Helper()
+	u := vcs.URL{
+		URL: url.URL{Path: exampleGoModuleURL},
+	}
+	config.Dependencies = dependencies
+	s, err := NewGoModulesSyncer(config, nil)
+	assert.Nil(t, err)
+	cmd, err := s.CloneCommand(context.Background(), &u, bareGitDirectory)
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.Run())
+}
+
+func TestGoModulesCloneCommand(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	proxy := newGoModuleProxy(t, map[string][]byte{
+		exampleGoVersion:  createModuleZip(t, exampleGoVersion, map[string]string{exampleGoFilePath: exampleGoFileContents}),
+		exampleGoVersion2: createModuleZip(t, exampleGoVersion2, map[string]string{exampleGoFilePath: exampleGoFileContents2}),
+	})
+
+	// The checksum database is covered by the tests of the goproxy package.
+	config := &schema.GoModulesConnection{Urls: []string{proxy.URL}, ChecksumDatabase: "off"}
+	bareGitDirectory := path.Join(dir, "git")
+
+	runGoModulesCloneCommand(t, config, bareGitDirectory, []string{exampleGoDependency})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\n",
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", fmt.Sprintf("%s:%s", exampleGoVersion, exampleGoFilePath)),
+		bareGitDirectory,
+		exampleGoFileContents,
+	)
+
+	runGoModulesCloneCommand(t, config, bareGitDirectory, []string{exampleGoDependency, exampleGoDependency2})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\nv2.0.0+incompatible\n", // verify that the v2.0.0+incompatible tag got added
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", "latest:"+exampleGoFilePath),
+		bareGitDirectory,
+		exampleGoFileContents2,
+	)
+
+	runGoModulesCloneCommand(t, config, bareGitDirectory, []string{exampleGoDependency})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\n", // verify that the v2.0.0+incompatible tag has been removed.
+	)
+
+	// The commits of a version are stable, so that URLs that contain them
+	// remain valid after recloning.
+	assertCommandOutput(t,
+		exec.Command("git", "log", "--format=%ad %s", exampleGoVersion),
+		bareGitDirectory,
+		"Thu Apr 8 14:24:52 2021 +0200 "+exampleGoDependency+"\n",
+	)
+}
+
+func TestGoModulesIsCloneable(t *testing.T) {
+	proxy := newGoModuleProxy(t, map[string][]byte{
+		exampleGoVersion: createModuleZip(t, exampleGoVersion, map[string]string{exampleGoFilePath: exampleGoFileContents}),
+	})
+	u := &vcs.URL{URL: url.URL{Path: exampleGoModuleURL}}
+
+	s, err := NewGoModulesSyncer(&schema.GoModulesConnection{Urls: []string{proxy.URL}, Dependencies: []string{exampleGoDependency}}, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, s.IsCloneable(context.Background(), u))
+
+	s, err = NewGoModulesSyncer(&schema.GoModulesConnection{Urls: []string{proxy.URL}, Dependencies: []string{exampleGoDependency2}}, nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, s.IsCloneable(context.Background(), u))
+}
+
+func TestUnzipModuleNoMaliciousFiles(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	dependency, err := reposource.ParseGoDependency(exampleGoDependency)
+	assert.Nil(t, err)
+
+	zipFile := filepath.Join(dir, "module.zip")
+	assert.Nil(t, os.WriteFile(zipFile, createModuleZip(t, exampleGoVersion, map[string]string{
+		"example.go":   "ok",
+		"lib/a.go":     "ok",
+		".git/config":  "malicious",
+		".git/HEAD":    "malicious",
+		"lib/.gitkeep": "ok",
+	}), 0600))
+
+	destination := filepath.Join(dir, "module")
+	assert.Nil(t, unzipModule(dependency, zipFile, destination))
+
+	var files []string
+	err = filepath.WalkDir(destination, func(p string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			rel, _ := filepath.Rel(destination, p)
+			files = append(files, rel)
+		}
+		return err
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"example.go", "lib/.gitkeep", "lib/a.go"}, files)
+
+	// Files outside of the module prefix make the whole zip invalid.
+	assert.Nil(t, os.WriteFile(zipFile, createModuleZip(t, exampleGoVersion, map[string]string{
+		"../../burger": "malicious",
+	}), 0600))
+	assert.NotNil(t, unzipModule(dependency, zipFile, filepath.Join(dir, "other")))
+}
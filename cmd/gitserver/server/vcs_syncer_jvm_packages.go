@@ -21,7 +21,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/jvmpackages/coursier"
 	"github.com/sourcegraph/sourcegraph/internal/repos"
-	"github.com/sourcegraph/sourcegraph/internal/vcs"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -46,146 +45,57 @@ var sourcegraphMavenDependency = reposource.MavenDependency{
 	Version: "1.0.0",
 }
 
-type JVMPackagesSyncer struct {
-	Config  *schema.JVMPackagesConnection
-	DBStore repos.JVMPackagesRepoStore
-}
-
-var _ VCSSyncer = &JVMPackagesSyncer{}
-
-func (s *JVMPackagesSyncer) MavenDependencies() []string {
-	if s.Config == nil || s.Config.Maven == nil || s.Config.Maven.Dependencies == nil {
-		return nil
+// NewJVMPackagesSyncer returns a VCSSyncer that syncs the JVM packages of the
+// given connection and the JVM dependency repos of dbStore.
+func NewJVMPackagesSyncer(connection *schema.JVMPackagesConnection, dbStore repos.JVMPackagesRepoStore) *PackagesSyncer {
+	return &PackagesSyncer{
+		typ:         "jvm_packages",
+		placeholder: sourcegraphMavenDependency,
+		source:      &jvmPackagesSource{config: connection, dbStore: dbStore},
 	}
-	return s.Config.Maven.Dependencies
 }
 
-func (s *JVMPackagesSyncer) Type() string {
-	return "jvm_packages"
-}
-
-// IsCloneable checks to see if the VCS remote URL is cloneable. Any non-nil
-// error indicates there is a problem.
-func (s *JVMPackagesSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
-	dependencies, err := s.packageDependencies(ctx, remoteURL.Path)
-	if err != nil {
-		return err
-	}
-
-	for _, dependency := range dependencies {
-		sources, err := coursier.FetchSources(ctx, s.Config, dependency)
-		if err != nil {
-			return err
-		}
-		if len(sources) == 0 {
-			return errors.Errorf("no sources for dependency %s", dependency)
-		}
-	}
-	return nil
-}
-
-// CloneCommand returns the command to be executed for cloning from remote.
-// There is no external tool that performs all the step for creating a JVM
-// package repository so the actual cloning happens inside this method and the
-// returned command is a no-op. This means that the web UI can't display a
-// helpful progress bar while cloning JVM package repositories, but that's an
+// jvmPackagesSource fetches source jars of Maven dependencies with Coursier.
+// There is no external tool that performs all the steps for creating a JVM
+// package repository, which means that the web UI can't display a helpful
+// progress bar while cloning JVM package repositories, but that's an
 // acceptable tradeoff we're willing to make.
-func (s *JVMPackagesSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, bareGitDirectory string) (*exec.Cmd, error) {
-	err := os.MkdirAll(bareGitDirectory, 0755)
-	if err != nil {
-		return nil, err
-	}
-
-	cmd := exec.CommandContext(ctx, "git", "--bare", "init")
-	if _, err := runCommandInDirectory(ctx, cmd, bareGitDirectory, sourcegraphMavenDependency); err != nil {
-		return nil, err
-	}
-
-	// The Fetch method is responsible for cleaning up temporary directories.
-	if err := s.Fetch(ctx, remoteURL, GitDir(bareGitDirectory)); err != nil {
-		return nil, err
-	}
-
-	// no-op command to satisfy VCSSyncer interface, see docstring for more details.
-	return exec.CommandContext(ctx, "git", "--version"), nil
+type jvmPackagesSource struct {
+	config  *schema.JVMPackagesConnection
+	dbStore repos.JVMPackagesRepoStore
 }
 
-// Fetch adds git tags for newly added dependency versions and removes git tags
-// for deleted deleted versions.
-func (s *JVMPackagesSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
-	dependencies, err := s.packageDependencies(ctx, remoteURL.Path)
-	if err != nil {
-		return err
-	}
-
-	tags := map[string]bool{}
-
-	out, err := runCommandInDirectory(ctx, exec.CommandContext(ctx, "git", "tag"), string(dir), sourcegraphMavenDependency)
-	if err != nil {
-		return err
-	}
-
-	for _, line := range strings.Split(out, "\n") {
-		if len(line) == 0 {
-			continue
-		}
-		tags[line] = true
-	}
-
-	for i, dependency := range dependencies {
-		if tags[dependency.GitTagFromVersion()] {
-			continue
-		}
-		// the gitPushDependencyTag method is reponsible for cleaning up temporary directories.
-		if err := s.gitPushDependencyTag(ctx, string(dir), dependency, i == 0); err != nil {
-			return errors.Wrapf(err, "error pushing dependency %q", dependency.CoursierSyntax())
-		}
-	}
-
-	dependencyTags := make(map[string]struct{}, len(dependencies))
-	for _, dependency := range dependencies {
-		dependencyTags[dependency.GitTagFromVersion()] = struct{}{}
-	}
+var _ packagesSource = &jvmPackagesSource{}
 
-	for tag := range tags {
-		if _, isDependencyTag := dependencyTags[tag]; !isDependencyTag {
-			cmd := exec.CommandContext(ctx, "git", "tag", "-d", tag)
-			if _, err := runCommandInDirectory(ctx, cmd, string(dir), sourcegraphMavenDependency); err != nil {
-				log15.Error("Failed to delete git tag", "error", err, "tag", tag)
-				continue
-			}
-		}
+func (s *jvmPackagesSource) mavenDependencies() []string {
+	if s.config == nil || s.config.Maven == nil || s.config.Maven.Dependencies == nil {
+		return nil
 	}
-
-	return nil
-}
-
-// RemoteShowCommand returns the command to be executed for showing remote.
-func (s *JVMPackagesSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
-	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+	return s.config.Maven.Dependencies
 }
 
-// packageDependencies returns the list of JVM dependencies that belong to the given URL path.
+// dependencies returns the list of JVM dependencies that belong to the given URL path.
 // The returned package dependencies are sorted by semantic versioning.
 // A URL maps to a single JVM package, which may contain multiple versions (one git tag per version).
-func (s *JVMPackagesSyncer) packageDependencies(ctx context.Context, repoUrlPath string) (dependencies []reposource.MavenDependency, err error) {
+func (s *jvmPackagesSource) dependencies(ctx context.Context, repoUrlPath string) ([]packageDependency, error) {
 	module, err := reposource.ParseMavenModule(repoUrlPath)
 	if err != nil {
 		return nil, err
 	}
 
 	var (
+		dependencies       []reposource.MavenDependency
 		totalConfigMatched int
 		timedout           []reposource.MavenDependency
 	)
-	for _, dependency := range s.MavenDependencies() {
+	for _, dependency := range s.mavenDependencies() {
 		if module.MatchesDependencyString(dependency) {
 			dependency, err := reposource.ParseMavenDependency(dependency)
 			if err != nil {
 				return nil, err
 			}
 
-			exists, err := coursier.Exists(ctx, s.Config, dependency)
+			exists, err := coursier.Exists(ctx, s.config, dependency)
 			if exists {
 				totalConfigMatched++
 				dependencies = append(dependencies, dependency)
@@ -202,7 +112,7 @@ func (s *JVMPackagesSyncer) packageDependencies(ctx context.Context, repoUrlPath
 		log15.Warn("non-zero number of timed-out coursier invocations", "count", len(timedout), "dependencies", timedout)
 	}
 
-	dbDeps, err := s.DBStore.GetJVMDependencyRepos(ctx, dbstore.GetJVMDependencyReposOpts{
+	dbDeps, err := s.dbStore.GetJVMDependencyRepos(ctx, dbstore.GetJVMDependencyReposOpts{
 		ArtifactName: repoUrlPath,
 	})
 	if err != nil {
@@ -233,72 +143,44 @@ func (s *JVMPackagesSyncer) packageDependencies(ctx context.Context, repoUrlPath
 
 	log15.Info("fetched maven artifact for repo path", "repoPath", repoUrlPath, "totalDB", totalDBMatched, "totalConfig", totalConfigMatched)
 	reposource.SortDependencies(dependencies)
-	return dependencies, nil
-}
 
-// gitPushDependencyTag pushes a git tag to the given bareGitDirectory path. The
-// tag points to a commit that adds all sources of given dependency. When
-// isMainBranch is true, the main branch of the bare git directory will also be
-// updated to point to the same commit as the git tag.
-func (s *JVMPackagesSyncer) gitPushDependencyTag(ctx context.Context, bareGitDirectory string, dependency reposource.MavenDependency, isLatestVersion bool) error {
-	tmpDirectory, err := os.MkdirTemp("", "maven")
-	if err != nil {
-		return err
+	packageDependencies := make([]packageDependency, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		packageDependencies = append(packageDependencies, dependency)
 	}
-	// Always clean up created temporary directories.
-	defer os.RemoveAll(tmpDirectory)
+	return packageDependencies, nil
+}
 
-	sourceCodePaths, err := coursier.FetchSources(ctx, s.Config, dependency)
+func (s *jvmPackagesSource) exists(ctx context.Context, dependency packageDependency) (bool, error) {
+	sources, err := coursier.FetchSources(ctx, s.config, dependency.(reposource.MavenDependency))
 	if err != nil {
-		return err
-	}
-
-	if len(sourceCodePaths) == 0 {
-		return errors.Errorf("no sources for dependency %s", dependency)
+		return false, err
 	}
+	return len(sources) > 0, nil
+}
 
-	sourceCodePath := sourceCodePaths[0]
-
-	cmd := exec.CommandContext(ctx, "git", "init")
-	if _, err := runCommandInDirectory(ctx, cmd, tmpDirectory, dependency); err != nil {
-		return err
-	}
+// download fetches the source jar of the dependency and extracts it to
+// workingDirectory.
+func (s *jvmPackagesSource) download(ctx context.Context, dependency packageDependency, workingDirectory string) error {
+	mavenDependency := dependency.(reposource.MavenDependency)
 
-	err = s.commitJar(ctx, dependency, tmpDirectory, sourceCodePath, s.Config)
+	sourceCodePaths, err := coursier.FetchSources(ctx, s.config, mavenDependency)
 	if err != nil {
 		return err
 	}
 
-	cmd = exec.CommandContext(ctx, "git", "remote", "add", "origin", bareGitDirectory)
-	if _, err := runCommandInDirectory(ctx, cmd, tmpDirectory, dependency); err != nil {
-		return err
-	}
-
-	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
-	cmd = exec.CommandContext(ctx, "git", "push", "--no-verify", "--force", "origin", "--tags")
-	if _, err := runCommandInDirectory(ctx, cmd, tmpDirectory, dependency); err != nil {
-		return err
-	}
-
-	if isLatestVersion {
-		defaultBranch, err := runCommandInDirectory(ctx, exec.CommandContext(ctx, "git", "rev-parse", "--abbrev-ref", "HEAD"), tmpDirectory, dependency)
-		if err != nil {
-			return err
-		}
-		// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
-		cmd = exec.CommandContext(ctx, "git", "push", "--no-verify", "--force", "origin", strings.TrimSpace(defaultBranch)+":latest", dependency.GitTagFromVersion())
-		if _, err := runCommandInDirectory(ctx, cmd, tmpDirectory, dependency); err != nil {
-			return err
-		}
+	if len(sourceCodePaths) == 0 {
+		return errors.Errorf("no sources for dependency %s", mavenDependency)
 	}
 
-	return nil
+	return s.extractJar(ctx, mavenDependency, workingDirectory, sourceCodePaths[0])
 }
 
-// commitJar creates a git commit in the given working directory that adds all the file contents of the given jar file.
-// A `*.jar` file works the same way as a `*.zip` file, it can even be uncompressed with the `unzip` command-line tool.
-func (s *JVMPackagesSyncer) commitJar(ctx context.Context, dependency reposource.MavenDependency,
-	workingDirectory, sourceCodeJarPath string, connection *schema.JVMPackagesConnection) error {
+// extractJar adds all the file contents of the given jar file to the given
+// working directory, together with an lsif-java.json file for indexing. A
+// `*.jar` file works the same way as a `*.zip` file, it can even be
+// uncompressed with the `unzip` command-line tool.
+func (s *jvmPackagesSource) extractJar(ctx context.Context, dependency reposource.MavenDependency, workingDirectory, sourceCodeJarPath string) error {
 	if err := unzipJarFile(sourceCodeJarPath, workingDirectory); err != nil {
 		return errors.Wrapf(err, "failed to unzip jar file for %s to %v", dependency.CoursierSyntax(), sourceCodeJarPath)
 	}
@@ -309,7 +191,7 @@ func (s *JVMPackagesSyncer) commitJar(ctx context.Context, dependency reposource
 	}
 	defer file.Close()
 
-	jvmVersion, err := inferJVMVersionFromByteCode(ctx, connection, dependency)
+	jvmVersion, err := inferJVMVersionFromByteCode(ctx, s.config, dependency)
 	if err != nil {
 		return err
 	}
@@ -324,27 +206,7 @@ func (s *JVMPackagesSyncer) commitJar(ctx context.Context, dependency reposource
 	}
 
 	_, err = file.Write(jsonContents)
-	if err != nil {
-		return err
-	}
-
-	cmd := exec.CommandContext(ctx, "git", "add", ".")
-	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
-		return err
-	}
-
-	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
-	cmd = exec.CommandContext(ctx, "git", "commit", "--no-verify", "-m", dependency.CoursierSyntax(), "--date", stableGitCommitDate)
-	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
-		return err
-	}
-
-	cmd = exec.CommandContext(ctx, "git", "tag", "-m", dependency.CoursierSyntax(), dependency.GitTagFromVersion())
-	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
-		return err
-	}
-
-	return nil
+	return err
 }
 
 func unzipJarFile(jarPath, destination string) (err error) {
@@ -482,8 +344,8 @@ func roundJVMVersionToNearestStableVersion(javaVersion int) int {
 	return javaVersion
 }
 
-func runCommandInDirectory(ctx context.Context, cmd *exec.Cmd, workingDirectory string, dependency reposource.MavenDependency) (string, error) {
-	gitName := dependency.MavenModule.CoursierSyntax() + " authors"
+func runCommandInDirectory(ctx context.Context, cmd *exec.Cmd, workingDirectory string, dependency packageDependency) (string, error) {
+	gitName := dependency.PackageSyntax() + " authors"
 	gitEmail := "code-intel@sourcegraph.com"
 	cmd.Dir = workingDirectory
 	cmd.Env = append(cmd.Env, "EMAIL="+gitEmail)
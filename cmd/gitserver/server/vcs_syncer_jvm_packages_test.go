@@ -108,12 +108,12 @@ fi
 	return coursierPath.Name()
 }
 
-func (s JVMPackagesSyncer) runCloneCommand(t *testing.T, bareGitDirectory string, dependencies []string) {
+func runJVMCloneCommand(t *testing.T, config *schema.JVMPackagesConnection, bareGitDirectory string, dependencies []string) {
 	url := vcs.URL{
 		URL: url.URL{Path: examplePackageUrl},
 	}
-	s.Config.Maven.Dependencies = dependencies
-	cmd, err := s.CloneCommand(context.Background(), &url, bareGitDirectory)
+	config.Maven.Dependencies = dependencies
+	cmd, err := NewJVMPackagesSyncer(config, &simpleJVMPackageDBStoreMock{}).CloneCommand(context.Background(), &url, bareGitDirectory)
 	assert.Nil(t, err)
 	assert.Nil(t, cmd.Run())
 }
@@ -129,14 +129,14 @@ func TestNoMaliciousFiles(t *testing.T) {
 
 	createMaliciousJar(t, jarPath)
 
-	s := JVMPackagesSyncer{
-		Config:  &schema.JVMPackagesConnection{Maven: &schema.Maven{Dependencies: []string{}}},
-		DBStore: &simpleJVMPackageDBStoreMock{},
+	s := jvmPackagesSource{
+		config:  &schema.JVMPackagesConnection{Maven: &schema.Maven{Dependencies: []string{}}},
+		dbStore: &simpleJVMPackageDBStoreMock{},
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // cancel now  to prevent any network IO
-	err = s.commitJar(ctx, reposource.MavenDependency{}, extractPath, jarPath, &schema.JVMPackagesConnection{Maven: &schema.Maven{}})
+	err = s.extractJar(ctx, reposource.MavenDependency{}, extractPath, jarPath)
 	assert.NotNil(t, err)
 
 	files, err := os.ReadDir(extractPath)
@@ -177,13 +177,10 @@ func TestJVMCloneCommand(t *testing.T) {
 
 	coursier.CoursierBinary = coursierScript(t, dir)
 
-	s := JVMPackagesSyncer{
-		Config:  &schema.JVMPackagesConnection{Maven: &schema.Maven{Dependencies: []string{}}},
-		DBStore: &simpleJVMPackageDBStoreMock{},
-	}
+	config := &schema.JVMPackagesConnection{Maven: &schema.Maven{Dependencies: []string{}}}
 	bareGitDirectory := path.Join(dir, "git")
 
-	s.runCloneCommand(t, bareGitDirectory, []string{examplePackageDependency})
+	runJVMCloneCommand(t, config, bareGitDirectory, []string{examplePackageDependency})
 	assertCommandOutput(t,
 		exec.Command("git", "tag", "--list"),
 		bareGitDirectory,
@@ -195,7 +192,7 @@ func TestJVMCloneCommand(t *testing.T) {
 		exampleFileContents,
 	)
 
-	s.runCloneCommand(t, bareGitDirectory, []string{examplePackageDependency, examplePackageDependency2})
+	runJVMCloneCommand(t, config, bareGitDirectory, []string{examplePackageDependency, examplePackageDependency2})
 	assertCommandOutput(t,
 		exec.Command("git", "tag", "--list"),
 		bareGitDirectory,
@@ -227,7 +224,7 @@ func TestJVMCloneCommand(t *testing.T) {
 		exampleFileContents2,
 	)
 
-	s.runCloneCommand(t, bareGitDirectory, []string{examplePackageDependency})
+	runJVMCloneCommand(t, config, bareGitDirectory, []string{examplePackageDependency})
 	assertCommandOutput(t,
 		exec.Command("git", "show", fmt.Sprintf("v%s:%s", examplePackageVersion, exampleFilePath)),
 		bareGitDirectory,
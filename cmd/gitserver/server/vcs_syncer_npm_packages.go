@@ -0,0 +1,198 @@
+package server
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npmpackages/npm"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// sourcegraphNPMDependency is used to set GIT_AUTHOR_NAME for git commands
+// that don't create commits or tags. The name of this dependency should never
+// be publicly visible so it can have any random value.
+var sourcegraphNPMDependency = reposource.NPMDependency{
+	NPMPackage: reposource.NPMPackage{
+		Scope: "sourcegraph",
+		Name:  "sourcegraph",
+	},
+	Version: "1.0.0",
+}
+
+// NewNPMPackagesSyncer returns a VCSSyncer that syncs the npm packages of the
+// given connection. If client is nil, a client for the registry of the
+// connection is used.
+func NewNPMPackagesSyncer(connection *schema.NPMPackagesConnection, client *npm.Client) *PackagesSyncer {
+	if client == nil {
+		client = npm.NewClient(connection, nil)
+	}
+
+	return &PackagesSyncer{
+		typ:         "npm_packages",
+		placeholder: sourcegraphNPMDependency,
+		source:      &npmPackagesSource{config: connection, client: client},
+	}
+}
+
+// npmPackagesSource downloads package tarballs from an npm registry.
+type npmPackagesSource struct {
+	config *schema.NPMPackagesConnection
+	client *npm.Client
+}
+
+var _ packagesSource = &npmPackagesSource{}
+
+// dependencies returns the list of npm dependencies that belong to the given
+// URL path, sorted by version with the latest version first. A URL maps to a
+// single npm package, which may contain multiple versions (one git tag per
+// version).
+func (s *npmPackagesSource) dependencies(_ context.Context, repoURLPath string) ([]packageDependency, error) {
+	pkg, err := reposource.ParseNPMPackageFromRepoURL(repoURLPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependencies []reposource.NPMDependency
+	for _, dep := range s.config.Dependencies {
+		if !pkg.MatchesDependencyString(dep) {
+			continue
+		}
+		dependency, err := reposource.ParseNPMDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+
+	if len(dependencies) == 0 {
+		return nil, errors.Errorf("no npm dependencies for URL path %s", repoURLPath)
+	}
+
+	reposource.SortNPMDependencies(dependencies)
+
+	packageDependencies := make([]packageDependency, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		packageDependencies = append(packageDependencies, dependency)
+	}
+	return packageDependencies, nil
+}
+
+func (s *npmPackagesSource) exists(ctx context.Context, dependency packageDependency) (bool, error) {
+	return s.client.Exists(ctx, dependency.(reposource.NPMDependency))
+}
+
+// download fetches the package tarball and extracts it to workingDirectory.
+func (s *npmPackagesSource) download(ctx context.Context, dependency packageDependency, workingDirectory string) error {
+	tarball, err := s.client.FetchTarball(ctx, dependency.(reposource.NPMDependency))
+	if err != nil {
+		return err
+	}
+	defer tarball.Close()
+
+	if err := decompressTgz(tarball, workingDirectory); err != nil {
+		return errors.Wrapf(err, "failed to decompress tarball for %s", dependency.PackageManagerSyntax())
+	}
+	return nil
+}
+
+// maxDecompressedTarballSize limits the size of the decompressed contents of
+// a package tarball, which can be much larger than the compressed tarball.
+var maxDecompressedTarballSize int64 = 2 << 30
+
+// decompressTgz extracts the regular files of the gzipped tarball to
+// destination. npm tarballs contain a single top-level directory (usually
+// `package/`), which is stripped from the paths.
+func decompressTgz(tgz io.Reader, destination string) error {
+	gzipReader, err := gzip.NewReader(tgz)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	// Read one byte more than allowed to detect tarballs that are too large.
+	limitedReader := &io.LimitedReader{R: gzipReader, N: maxDecompressedTarballSize + 1}
+	tooLarge := func() error {
+		if limitedReader.N <= 0 {
+			return errors.Errorf("decompressed tarball is larger than %d bytes", maxDecompressedTarballSize)
+		}
+		return nil
+	}
+
+	tarReader := tar.NewReader(limitedReader)
+	for {
+		header, err := tarReader.Next()
+		if err := tooLarge(); err != nil {
+			return err
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			// Skip directories, links and other special files.
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimPrefix(header.Name, "/"), "/", 2)
+		if len(parts) != 2 {
+			// Skip files outside of the top-level directory.
+			continue
+		}
+
+		// Check the cleaned name, since `.` and `..` elements could otherwise
+		// hide a path below `.git/` or outside of destination.
+		name := path.Clean(parts[1])
+		if name == ".." || strings.HasPrefix(name, "../") {
+			// For security reasons, skip file if it's not a child
+			// of the target directory. See "Zip Slip Vulnerability".
+			continue
+		}
+		if topLevel := strings.SplitN(name, "/", 2)[0]; strings.EqualFold(topLevel, ".git") {
+			// For security reasons, don't extract files under the `.git/`
+			// directory. See https://github.com/sourcegraph/security-issues/issues/163
+			continue
+		}
+
+		if err := copyTarFileEntry(tarReader, filepath.Join(destination, filepath.FromSlash(name))); err != nil {
+			if tooLargeErr := tooLarge(); tooLargeErr != nil {
+				return tooLargeErr
+			}
+			return err
+		}
+	}
+
+	// Like for Go modules, make sure that nothing ended up under `.git/`,
+	// which would be used by the `git init` that follows.
+	return os.RemoveAll(filepath.Join(destination, ".git"))
+}
+
+func copyTarFileEntry(r io.Reader, outputPath string) (err error) {
+	if err = os.MkdirAll(filepath.Dir(outputPath), 0700); err != nil {
+		return err
+	}
+	outputFile, err := os.OpenFile(outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err1 := outputFile.Close()
+		if err == nil {
+			err = err1
+		}
+	}()
+
+	_, err = io.Copy(outputFile, r)
+	return err
+}
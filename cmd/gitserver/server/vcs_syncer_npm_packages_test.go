@@ -0,0 +1,220 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	exampleNPMFilePath      = "index.js"
+	exampleNPMFileContents  = "module.exports = 1\n"
+	exampleNPMFileContents2 = "module.exports = 2\n"
+	exampleNPMVersion       = "1.0.0"
+	exampleNPMVersion2      = "2.0.0"
+	exampleNPMDependency    = "@example/example@1.0.0"
+	exampleNPMDependency2   = "@example/example@2.0.0"
+	exampleNPMPackageURL    = "npm/@example/example"
+)
+
+// newNPMRegistry returns a stand-in for an npm registry that serves the
+// tarballs of the given versions of the @example/example package.
+func newNPMRegistry(t *testing.T, tarballs map[string][]byte) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/tarballs/"):
+			tarball, ok := tarballs[strings.TrimPrefix(r.URL.Path, "/tarballs/")]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write(tarball)
+		case strings.HasPrefix(r.URL.EscapedPath(), "/@example%2Fexample/"):
+			version := path.Base(r.URL.Path)
+			tarball, ok := tarballs[version]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			sum := sha512.Sum512(tarball)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"dist": map[string]string{
+					"tarball":   server.URL + "/tarballs/" + version,
+					"integrity": "sha512-" + base64.StdEncoding.EncodeToString(sum[:]),
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func createTgz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, contents := range files {
+		assert.Nil(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tarWriter.Write([]byte(contents))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, tarWriter.Close())
+	assert.Nil(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func runNPMCloneCommand(t *testing.T, config *schema.NPMPackagesConnection, bareGitDirectory string, dependencies []string) {
+	t.Helper()
+	u := vcs.URL{
+		URL: url.URL{Path: exampleNPMPackageURL},
+	}
+	config.Dependencies = dependencies
+	cmd, err := NewNPMPackagesSyncer(config, nil).CloneCommand(context.Background(), &u, bareGitDirectory)
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.Run())
+}
+
+func TestNPMCloneCommand(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	registry := newNPMRegistry(t, map[string][]byte{
+		exampleNPMVersion:  createTgz(t, map[string]string{"package/" + exampleNPMFilePath: exampleNPMFileContents}),
+		exampleNPMVersion2: createTgz(t, map[string]string{"package/" + exampleNPMFilePath: exampleNPMFileContents2}),
+	})
+
+	config := &schema.NPMPackagesConnection{Registry: registry.URL}
+	bareGitDirectory := path.Join(dir, "git")
+
+	runNPMCloneCommand(t, config, bareGitDirectory, []string{exampleNPMDependency})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\n",
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", fmt.Sprintf("v%s:%s", exampleNPMVersion, exampleNPMFilePath)),
+		bareGitDirectory,
+		exampleNPMFileContents,
+	)
+
+	runNPMCloneCommand(t, config, bareGitDirectory, []string{exampleNPMDependency, exampleNPMDependency2})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\nv2.0.0\n", // verify that the v2.0.0 tag got added
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", fmt.Sprintf("v%s:%s", exampleNPMVersion2, exampleNPMFilePath)),
+		bareGitDirectory,
+		exampleNPMFileContents2,
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", "latest:"+exampleNPMFilePath),
+		bareGitDirectory,
+		exampleNPMFileContents2,
+	)
+
+	runNPMCloneCommand(t, config, bareGitDirectory, []string{exampleNPMDependency})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\n", // verify that the v2.0.0 tag has been removed.
+	)
+
+	// The commits of a version are stable, so that URLs that contain them
+	// remain valid after recloning.
+	assertCommandOutput(t,
+		exec.Command("git", "log", "--format=%ad %s", "v"+exampleNPMVersion),
+		bareGitDirectory,
+		"Thu Apr 8 14:24:52 2021 +0200 "+exampleNPMDependency+"\n",
+	)
+}
+
+func TestNPMIsCloneable(t *testing.T) {
+	registry := newNPMRegistry(t, map[string][]byte{
+		exampleNPMVersion: createTgz(t, map[string]string{"package/" + exampleNPMFilePath: exampleNPMFileContents}),
+	})
+	u := &vcs.URL{URL: url.URL{Path: exampleNPMPackageURL}}
+
+	s := NewNPMPackagesSyncer(&schema.NPMPackagesConnection{Registry: registry.URL, Dependencies: []string{exampleNPMDependency}}, nil)
+	assert.Nil(t, s.IsCloneable(context.Background(), u))
+
+	s = NewNPMPackagesSyncer(&schema.NPMPackagesConnection{Registry: registry.URL, Dependencies: []string{exampleNPMDependency2}}, nil)
+	assert.NotNil(t, s.IsCloneable(context.Background(), u))
+}
+
+func TestDecompressTgzNoMaliciousFiles(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	tgz := createTgz(t, map[string]string{
+		"package/index.js":           "ok",
+		"package/lib/a.js":           "ok",
+		"package/.git/config":        "malicious",
+		"package/../../burger":       "malicious",
+		"package/lib/../../../a.txt": "malicious",
+		"package/./.git/config":      "malicious",
+		"package/x/../.git/hooks/a":  "malicious",
+		"package/.GIT/config":        "malicious",
+		"toplevel.js":                "ignored",
+	})
+	assert.Nil(t, decompressTgz(bytes.NewReader(tgz), dir))
+
+	var files []string
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			rel, _ := filepath.Rel(dir, p)
+			files = append(files, rel)
+		}
+		return err
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"index.js", "lib/a.js"}, files)
+}
+
+func TestDecompressTgzSizeLimit(t *testing.T) {
+	old := maxDecompressedTarballSize
+	maxDecompressedTarballSize = 4096
+	t.Cleanup(func() { maxDecompressedTarballSize = old })
+
+	small := createTgz(t, map[string]string{"package/index.js": "ok"})
+	assert.Nil(t, decompressTgz(bytes.NewReader(small), t.TempDir()))
+
+	// The compressed tarball is much smaller than the limit.
+	large := createTgz(t, map[string]string{"package/index.js": strings.Repeat("a", 64*1024)})
+	assert.Less(t, len(large), 4096)
+	assert.NotNil(t, decompressTgz(bytes.NewReader(large), t.TempDir()))
+}
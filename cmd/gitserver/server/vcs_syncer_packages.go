@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// packageDependency is a version of a package that is synced into a git
+// repository by a package syncer.
+type packageDependency interface {
+	// PackageSyntax returns the name of the package without the version.
+	PackageSyntax() string
+	// PackageManagerSyntax returns the name of the package with the version,
+	// as it is written in the package manager's configuration.
+	PackageManagerSyntax() string
+	// GitTagFromVersion returns the git tag of the version.
+	GitTagFromVersion() string
+}
+
+// packagesSource downloads the versions of the packages of one package
+// ecosystem for a PackagesSyncer.
+type packagesSource interface {
+	// dependencies returns the versions of the package at the given URL path
+	// that are configured to be synced, sorted with the latest version first.
+	dependencies(ctx context.Context, repoURLPath string) ([]packageDependency, error)
+	// exists returns true if the package host has the given version.
+	exists(ctx context.Context, dependency packageDependency) (bool, error)
+	// download writes the verified sources of the given version to the empty
+	// workingDirectory. Its parent directory is temporary and can hold
+	// intermediate files. Files under `.git/` must not be written.
+	download(ctx context.Context, dependency packageDependency, workingDirectory string) error
+}
+
+// PackagesSyncer is a VCSSyncer for package hosts, such as Maven repositories,
+// npm registries and Go module proxies. A URL maps to a single package, and
+// each version of the package is committed and tagged in its own temporary git
+// repository, which is pushed to the bare git directory of the package. The
+// latest version is also pushed to the `latest` branch.
+type PackagesSyncer struct {
+	typ string
+	// placeholder is used to set GIT_AUTHOR_NAME for git commands that don't
+	// create commits or tags. Its name should never be publicly visible.
+	placeholder packageDependency
+	source      packagesSource
+}
+
+var _ VCSSyncer = &PackagesSyncer{}
+
+func (s *PackagesSyncer) Type() string {
+	return s.typ
+}
+
+// IsCloneable checks to see if the VCS remote URL is cloneable. Any non-nil
+// error indicates there is a problem.
+func (s *PackagesSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	dependencies, err := s.source.dependencies(ctx, remoteURL.Path)
+	if err != nil {
+		return err
+	}
+
+	for _, dependency := range dependencies {
+		exists, err := s.source.exists(ctx, dependency)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.Errorf("package %s not found", dependency.PackageManagerSyntax())
+		}
+	}
+	return nil
+}
+
+// CloneCommand returns the command to be executed for cloning from remote.
+// The actual cloning happens inside this method and the returned command is a
+// no-op.
+func (s *PackagesSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, bareGitDirectory string) (*exec.Cmd, error) {
+	err := os.MkdirAll(bareGitDirectory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "--bare", "init")
+	if _, err := runCommandInDirectory(ctx, cmd, bareGitDirectory, s.placeholder); err != nil {
+		return nil, err
+	}
+
+	// The Fetch method is responsible for cleaning up temporary directories.
+	if err := s.Fetch(ctx, remoteURL, GitDir(bareGitDirectory)); err != nil {
+		return nil, err
+	}
+
+	// no-op command to satisfy VCSSyncer interface, see docstring for more details.
+	return exec.CommandContext(ctx, "git", "--version"), nil
+}
+
+// Fetch adds git tags for newly added dependency versions and removes git tags
+// for deleted versions.
+func (s *PackagesSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
+	dependencies, err := s.source.dependencies(ctx, remoteURL.Path)
+	if err != nil {
+		return err
+	}
+
+	out, err := runCommandInDirectory(ctx, exec.CommandContext(ctx, "git", "tag"), string(dir), s.placeholder)
+	if err != nil {
+		return err
+	}
+
+	tags := map[string]bool{}
+	for _, line := range strings.Split(out, "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		tags[line] = true
+	}
+
+	for i, dependency := range dependencies {
+		if tags[dependency.GitTagFromVersion()] {
+			continue
+		}
+		// the gitPushDependencyTag method is responsible for cleaning up temporary directories.
+		if err := s.gitPushDependencyTag(ctx, string(dir), dependency, i == 0); err != nil {
+			return errors.Wrapf(err, "error pushing dependency %q", dependency.PackageManagerSyntax())
+		}
+	}
+
+	dependencyTags := make(map[string]struct{}, len(dependencies))
+	for _, dependency := range dependencies {
+		dependencyTags[dependency.GitTagFromVersion()] = struct{}{}
+	}
+
+	for tag := range tags {
+		if _, isDependencyTag := dependencyTags[tag]; !isDependencyTag {
+			cmd := exec.CommandContext(ctx, "git", "tag", "-d", tag)
+			if _, err := runCommandInDirectory(ctx, cmd, string(dir), s.placeholder); err != nil {
+				log15.Error("Failed to delete git tag", "error", err, "tag", tag)
+				continue
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing remote.
+func (s *PackagesSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+}
+
+// gitPushDependencyTag pushes a git tag to the given bareGitDirectory path. The
+// tag points to a commit that adds all sources of given dependency. When
+// isLatestVersion is true, the latest branch of the bare git directory will
+// also be updated to point to the same commit as the git tag.
+func (s *PackagesSyncer) gitPushDependencyTag(ctx context.Context, bareGitDirectory string, dependency packageDependency, isLatestVersion bool) error {
+	tmpDirectory, err := os.MkdirTemp("", s.typ)
+	if err != nil {
+		return err
+	}
+	// Always clean up created temporary directories.
+	defer os.RemoveAll(tmpDirectory)
+
+	workingDirectory := filepath.Join(tmpDirectory, "package")
+	if err := os.Mkdir(workingDirectory, 0700); err != nil {
+		return err
+	}
+
+	if err := s.source.download(ctx, dependency, workingDirectory); err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "init")
+	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
+		return err
+	}
+
+	if err := s.commitDependency(ctx, dependency, workingDirectory); err != nil {
+		return err
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "remote", "add", "origin", bareGitDirectory)
+	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
+		return err
+	}
+
+	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+	cmd = exec.CommandContext(ctx, "git", "push", "--no-verify", "--force", "origin", "--tags")
+	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
+		return err
+	}
+
+	if isLatestVersion {
+		defaultBranch, err := runCommandInDirectory(ctx, exec.CommandContext(ctx, "git", "rev-parse", "--abbrev-ref", "HEAD"), workingDirectory, dependency)
+		if err != nil {
+			return err
+		}
+		// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+		cmd = exec.CommandContext(ctx, "git", "push", "--no-verify", "--force", "origin", strings.TrimSpace(defaultBranch)+":latest", dependency.GitTagFromVersion())
+		if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commitDependency creates a git commit in the given working directory that
+// adds all the files of the downloaded dependency, and tags it with the
+// version.
+func (s *PackagesSyncer) commitDependency(ctx context.Context, dependency packageDependency, workingDirectory string) error {
+	cmd := exec.CommandContext(ctx, "git", "add", ".")
+	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
+		return err
+	}
+
+	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+	cmd = exec.CommandContext(ctx, "git", "commit", "--no-verify", "-m", dependency.PackageManagerSyntax(), "--date", stableGitCommitDate)
+	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
+		return err
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "tag", "-m", dependency.PackageManagerSyntax(), dependency.GitTagFromVersion())
+	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
+		return err
+	}
+
+	return nil
+}
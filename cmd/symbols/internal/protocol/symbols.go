@@ -35,6 +35,15 @@ type SearchArgs struct {
 	// need to match to get included in the result
 	ExcludePattern string
 
+	// Kinds is an optional list of symbol kinds, using the values of
+	// select:symbol.<kind>. If non-empty, only symbols of one of the kinds
+	// are included in the result.
+	Kinds []string
+
+	// ParentPattern is an optional regex that the parent (scope) of symbols
+	// needs to match to get included in the result.
+	ParentPattern string
+
 	// First indicates that only the first n symbols should be returned.
 	First int
 }
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
 	"github.com/sourcegraph/go-ctags"
 
 	"github.com/sourcegraph/sourcegraph/internal/env"
@@ -15,18 +16,39 @@ const debugLogs = false
 
 var logErrors = os.Getenv("DEPLOY_TYPE") == "dev"
 
+var useCtags, _ = strconv.ParseBool(env.Get("USE_CTAGS", "true", "use universal-ctags (see CTAGS_COMMAND) to parse symbols; if disabled or unavailable, built-in symbol extractors are used for some languages"))
+
 var ctagsCommand = env.Get("CTAGS_COMMAND", "universal-ctags", "ctags command (should point to universal-ctags executable compiled with JSON and seccomp support)")
 
 // Increasing this value may increase the size of the symbols cache, but will also stop long lines containing symbols from
 // being highlighted improperly. See https://github.com/sourcegraph/sourcegraph/issues/7668.
 var rawPatternLengthLimit = env.Get("CTAGS_PATTERN_LENGTH_LIMIT", "250", "the maximum length of the patterns output by ctags")
 
-// NewParser runs the ctags command from the CTAGS_COMMAND environment
-// variable, falling back to `universal-ctags`.
+// NewParser returns a ctags parser. If USE_CTAGS is not set or the ctags
+// command cannot be started, it returns a parser that uses the built-in symbol
+// extractors instead, which only support some languages.
 func NewParser() (ctags.Parser, error) {
-	patternLengthLimit, err := strconv.Atoi(rawPatternLengthLimit)
+	if useCtags {
+		parser, err := NewCtagsParser()
+		if err == nil {
+			return parser, nil
+		}
+		log15.Warn("Failed to start ctags, falling back to built-in symbol extractors", "error", err)
+	}
+
+	patternLengthLimit, err := parsePatternLengthLimit()
 	if err != nil {
-		return nil, errors.Errorf("invalid pattern length limit: %s", rawPatternLengthLimit)
+		return nil, err
+	}
+	return NewNativeParser(patternLengthLimit), nil
+}
+
+// NewCtagsParser runs the ctags command from the CTAGS_COMMAND environment
+// variable, falling back to `universal-ctags`.
+func NewCtagsParser() (ctags.Parser, error) {
+	patternLengthLimit, err := parsePatternLengthLimit()
+	if err != nil {
+		return nil, err
 	}
 
 	var info *log.Logger
@@ -46,3 +68,11 @@ func NewParser() (ctags.Parser, error) {
 		Debug:              debug,
 	})
 }
+
+func parsePatternLengthLimit() (int, error) {
+	patternLengthLimit, err := strconv.Atoi(rawPatternLengthLimit)
+	if err != nil {
+		return 0, errors.Errorf("invalid pattern length limit: %s", rawPatternLengthLimit)
+	}
+	return patternLengthLimit, nil
+}
@@ -0,0 +1,103 @@
+package symbols
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
+)
+
+// Changes are the paths that differ between two commits.
+type Changes struct {
+	Added    []string
+	Modified []string
+	Deleted  []string
+}
+
+// GitDiff returns the paths that differ between commitA and commitB in repo
+// according to gitserver.
+func GitDiff(ctx context.Context, repo api.RepoName, commitA, commitB api.CommitID) (Changes, error) {
+	span, ctx := ot.StartSpanFromContext(ctx, "GitDiff")
+	span.SetTag("A", commitA)
+	span.SetTag("B", commitB)
+	defer span.Finish()
+
+	cmd := gitserver.DefaultClient.Command("git", "diff", "-z", "--name-status", "--no-renames", string(commitA), string(commitB))
+	cmd.Repo = repo
+	out, stderr, err := cmd.DividedOutput(ctx)
+	if err != nil {
+		return Changes{}, errors.WithMessage(err, fmt.Sprintf("git command %v failed (output: %q)", cmd.Args, stderr))
+	}
+	return parseGitDiffOutput(out)
+}
+
+// maxAncestorSearch is the number of ancestors of a commit that
+// ClosestAncestor looks at.
+const maxAncestorSearch = 10000
+
+// ClosestAncestor returns the candidate that is the closest ancestor of commit
+// in repo according to gitserver, or an empty commit ID if none of the
+// candidates is among the nearest ancestors of commit. A commit is its own
+// closest ancestor.
+func ClosestAncestor(ctx context.Context, repo api.RepoName, commit api.CommitID, candidates []api.CommitID) (api.CommitID, error) {
+	span, ctx := ot.StartSpanFromContext(ctx, "ClosestAncestor")
+	span.SetTag("Commit", commit)
+	span.SetTag("Candidates", len(candidates))
+	defer span.Finish()
+
+	cmd := gitserver.DefaultClient.Command("git", "rev-list", fmt.Sprintf("--max-count=%d", maxAncestorSearch), string(commit))
+	cmd.Repo = repo
+	out, stderr, err := cmd.DividedOutput(ctx)
+	if err != nil {
+		return "", errors.WithMessage(err, fmt.Sprintf("git command %v failed (output: %q)", cmd.Args, stderr))
+	}
+	return parseClosestAncestor(out, candidates), nil
+}
+
+// parseClosestAncestor returns the first of the candidates in the output of
+// `git rev-list`, which lists the ancestors of a commit newest first.
+func parseClosestAncestor(out []byte, candidates []api.CommitID) api.CommitID {
+	isCandidate := make(map[api.CommitID]struct{}, len(candidates))
+	for _, candidate := range candidates {
+		isCandidate[candidate] = struct{}{}
+	}
+	for _, line := range bytes.Split(out, []byte{'\n'}) {
+		if _, ok := isCandidate[api.CommitID(bytes.TrimSpace(line))]; ok {
+			return api.CommitID(bytes.TrimSpace(line))
+		}
+	}
+	return ""
+}
+
+// parseGitDiffOutput parses the output of `git diff -z --name-status
+// --no-renames`, which is a NUL-separated list of alternating statuses and
+// paths.
+func parseGitDiffOutput(out []byte) (changes Changes, err error) {
+	fields := bytes.Split(bytes.TrimSuffix(out, []byte{0}), []byte{0})
+	if len(fields) == 1 && len(fields[0]) == 0 {
+		return changes, nil
+	}
+	if len(fields)%2 != 0 {
+		return changes, errors.Errorf("unexpected git diff output: odd number of fields (%d)", len(fields))
+	}
+
+	for i := 0; i < len(fields); i += 2 {
+		status, path := string(fields[i]), string(fields[i+1])
+		switch status {
+		case "A":
+			changes.Added = append(changes.Added, path)
+		case "M", "T":
+			changes.Modified = append(changes.Modified, path)
+		case "D":
+			changes.Deleted = append(changes.Deleted, path)
+		default:
+			return changes, errors.Errorf("unexpected git diff status %q for path %q", status, path)
+		}
+	}
+	return changes, nil
+}
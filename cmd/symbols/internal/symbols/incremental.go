@@ -0,0 +1,227 @@
+package symbols
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+const (
+	// maxIncrementalPaths is the maximum number of changed paths for which we
+	// update a copy of an existing database. Above it, parsing all files is
+	// usually cheaper than passing the paths to gitserver.
+	maxIncrementalPaths = 5000
+
+	// maxIncrementalBases is the number of most recently used databases of a
+	// repository that are considered as the base of an incremental update.
+	maxIncrementalBases = 10
+)
+
+// writeSymbolsToNewDB writes the symbols of repo@commit to the blank database
+// file `dbFile`. If possible, it updates a copy of the database of the closest
+// ancestor of the commit by parsing only the files that changed between the
+// two commits. Otherwise it parses all files.
+func (s *Service) writeSymbolsToNewDB(ctx context.Context, dbFile string, repoName api.RepoName, commitID api.CommitID) error {
+	if s.GitDiff != nil && s.FetchTarPaths != nil && s.ClosestAncestor != nil {
+		ok, err := s.writeSymbolsIncrementally(ctx, dbFile, repoName, commitID)
+		if err == nil && ok {
+			dbWrites.WithLabelValues("incremental").Inc()
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			incrementalWritesFailed.Inc()
+			log15.Warn("Failed to update symbols incrementally, parsing all files.", "repo", repoName, "commitID", commitID, "error", err)
+
+			// Start over with a blank database.
+			if err := os.Truncate(dbFile, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.writeAllSymbolsToNewDB(ctx, dbFile, repoName, commitID); err != nil {
+		return err
+	}
+	dbWrites.WithLabelValues("full").Inc()
+	return nil
+}
+
+// writeSymbolsIncrementally copies the database of the closest ancestor of
+// repo@commit to `dbFile` and updates it to repo@commit. It returns false if
+// there is no suitable database to start from.
+func (s *Service) writeSymbolsIncrementally(ctx context.Context, dbFile string, repoName api.RepoName, commitID api.CommitID) (ok bool, err error) {
+	oldDBFile, oldCommitID, err := s.closestAncestorDB(ctx, repoName, commitID)
+	if err != nil || oldDBFile == "" {
+		return false, err
+	}
+
+	changes, err := s.GitDiff(ctx, repoName, oldCommitID, commitID)
+	if err != nil {
+		return false, errors.Wrap(err, "GitDiff")
+	}
+
+	// Paths to parse again.
+	addedOrModified := append(append([]string{}, changes.Added...), changes.Modified...)
+	if len(addedOrModified) > maxIncrementalPaths {
+		return false, nil
+	}
+
+	if err := copyFile(oldDBFile, dbFile); err != nil {
+		return false, errors.Wrap(err, "copying previous database")
+	}
+
+	db, err := sqlx.Open("sqlite3_with_regexp", dbFile)
+	if err != nil {
+		return false, err
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	deleteStatement, err := tx.Preparex(`DELETE FROM symbols WHERE path = ?`)
+	if err != nil {
+		return false, err
+	}
+	for _, paths := range [][]string{changes.Added, changes.Modified, changes.Deleted} {
+		for _, path := range paths {
+			if _, err := deleteStatement.ExecContext(ctx, path); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if err := writeCommitID(tx, commitID); err != nil {
+		return false, err
+	}
+
+	// An empty list of paths would fetch the whole repository.
+	if len(addedOrModified) == 0 {
+		return true, nil
+	}
+
+	insertStatement, err := prepareInsertSymbol(tx)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.parseUncached(ctx, repoName, commitID, addedOrModified, func(symbol result.Symbol) error {
+		symbolInDBValue := symbolToSymbolInDB(symbol)
+		_, err := insertStatement.Exec(&symbolInDBValue)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// closestAncestorDB returns the path and commit of the database of the
+// repository whose commit is the closest ancestor of commitID. Only the most
+// recently used databases are considered, and unreadable ones are skipped. It
+// returns an empty path if none of them belongs to an ancestor.
+func (s *Service) closestAncestorDB(ctx context.Context, repoName api.RepoName, commitID api.CommitID) (string, api.CommitID, error) {
+	dbFiles, err := s.cache.ListInDir(cacheDir(repoName))
+	if err != nil {
+		return "", "", err
+	}
+	if len(dbFiles) > maxIncrementalBases {
+		dbFiles = dbFiles[:maxIncrementalBases]
+	}
+
+	dbFileByCommitID := make(map[api.CommitID]string, len(dbFiles))
+	candidates := make([]api.CommitID, 0, len(dbFiles))
+	for _, dbFile := range dbFiles {
+		oldCommitID, err := readCommitID(ctx, dbFile)
+		if err != nil {
+			log15.Warn("Failed to read the commit of a symbols database, skipping it.", "repo", repoName, "dbFile", dbFile, "error", err)
+			continue
+		}
+		if _, ok := dbFileByCommitID[oldCommitID]; !ok {
+			dbFileByCommitID[oldCommitID] = dbFile
+			candidates = append(candidates, oldCommitID)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", "", nil
+	}
+
+	closestCommitID, err := s.ClosestAncestor(ctx, repoName, commitID, candidates)
+	if err != nil {
+		return "", "", errors.Wrap(err, "ClosestAncestor")
+	}
+	if closestCommitID == "" {
+		return "", "", nil
+	}
+	return dbFileByCommitID[closestCommitID], closestCommitID, nil
+}
+
+// readCommitID returns the commit the symbols in the database belong to.
+func readCommitID(ctx context.Context, dbFile string) (api.CommitID, error) {
+	db, err := sqlx.Open("sqlite3_with_regexp", dbFile)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	var commitID string
+	if err := db.GetContext(ctx, &commitID, `SELECT revision FROM meta WHERE id = 0`); err != nil {
+		return "", err
+	}
+	return api.CommitID(commitID), nil
+}
+
+// copyFile copies the contents of src to the existing file dst.
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+var (
+	dbWrites = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "symbols_store_db_writes_total",
+		Help: "The total number of symbols databases written, by whether all files were parsed (full) or only the files changed since a previously indexed commit (incremental).",
+	}, []string{"mode"})
+	incrementalWritesFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "symbols_store_incremental_writes_failed",
+		Help: "The total number of incremental database updates that failed and fell back to parsing all files.",
+	})
+)
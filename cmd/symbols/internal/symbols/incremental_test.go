@@ -0,0 +1,197 @@
+package symbols
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/go-ctags"
+
+	"github.com/sourcegraph/sourcegraph/cmd/symbols/internal/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestService_Incremental(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { os.RemoveAll(tmpDir) }()
+
+	commits := map[api.CommitID]map[string]string{
+		"c1": {
+			"a.go": "package a\nfunc A() {}\n",
+			"b.go": "package b\nfunc B() {}\n",
+			"d.go": "package d\nfunc D() {}\n",
+		},
+		"c2": {
+			"a.go": "package a\nfunc A2() {}\n",
+			"c.go": "package c\nfunc C() {}\n",
+			"d.go": "package d\nfunc D() {}\n",
+		},
+		// c3 branches off c1, so it has to be based on c1 rather than on the
+		// more recently indexed c2.
+		"c3": {
+			"a.go": "package a\nfunc A() {}\n",
+			"b.go": "package b\nfunc B3() {}\n",
+			"d.go": "package d\nfunc D() {}\n",
+		},
+		// c4 shares no history with the other commits.
+		"c4": {
+			"e.go": "package e\nfunc E() {}\n",
+		},
+		"c5": {
+			"a.go": "package a\nfunc A5() {}\n",
+			"b.go": "package b\nfunc B3() {}\n",
+			"d.go": "package d\nfunc D() {}\n",
+		},
+	}
+	parents := map[api.CommitID]api.CommitID{"c2": "c1", "c3": "c1", "c5": "c3"}
+	diffs := map[[2]api.CommitID]Changes{
+		{"c1", "c2"}: {Added: []string{"c.go"}, Modified: []string{"a.go"}, Deleted: []string{"b.go"}},
+		{"c1", "c3"}: {Modified: []string{"b.go"}},
+		{"c3", "c5"}: {Modified: []string{"a.go"}},
+	}
+
+	var fetchedPaths [][]string
+	service := Service{
+		FetchTar: func(ctx context.Context, repo api.RepoName, commit api.CommitID) (io.ReadCloser, error) {
+			return createTar(commits[commit])
+		},
+		FetchTarPaths: func(ctx context.Context, repo api.RepoName, commit api.CommitID, paths []string) (io.ReadCloser, error) {
+			fetchedPaths = append(fetchedPaths, paths)
+			files := map[string]string{}
+			for _, path := range paths {
+				files[path] = commits[commit][path]
+			}
+			return createTar(files)
+		},
+		GitDiff: func(ctx context.Context, repo api.RepoName, commitA, commitB api.CommitID) (Changes, error) {
+			changes, ok := diffs[[2]api.CommitID{commitA, commitB}]
+			if !ok {
+				return Changes{}, errors.Errorf("unexpected diff %s..%s", commitA, commitB)
+			}
+			return changes, nil
+		},
+		ClosestAncestor: func(ctx context.Context, repo api.RepoName, commit api.CommitID, candidates []api.CommitID) (api.CommitID, error) {
+			for ; commit != ""; commit = parents[commit] {
+				for _, candidate := range candidates {
+					if candidate == commit {
+						return commit, nil
+					}
+				}
+			}
+			return "", nil
+		},
+		NewParser: func() (ctags.Parser, error) {
+			return NewNativeParser(0), nil
+		},
+		Path: tmpDir,
+	}
+	if err := service.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	search := func(commit api.CommitID) []string {
+		res, err := service.search(context.Background(), protocol.SearchArgs{Repo: "r", CommitID: commit, Query: "^[A-Z]", IsCaseSensitive: true, First: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, s := range *res {
+			names = append(names, s.Path+":"+s.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	if diff := cmp.Diff([]string{"a.go:A", "b.go:B", "d.go:D"}, search("c1")); diff != "" {
+		t.Errorf("unexpected symbols for c1 (-want +got):\n%s", diff)
+	}
+	if len(fetchedPaths) != 0 {
+		t.Fatalf("expected the first commit to be parsed in full, fetched %v", fetchedPaths)
+	}
+
+	if diff := cmp.Diff([]string{"a.go:A2", "c.go:C", "d.go:D"}, search("c2")); diff != "" {
+		t.Errorf("unexpected symbols for c2 (-want +got):\n%s", diff)
+	}
+	if want := [][]string{{"c.go", "a.go"}}; !reflect.DeepEqual(fetchedPaths, want) {
+		t.Errorf("unexpected fetched paths: got %v, want %v", fetchedPaths, want)
+	}
+
+	if diff := cmp.Diff([]string{"a.go:A", "b.go:B3", "d.go:D"}, search("c3")); diff != "" {
+		t.Errorf("unexpected symbols for c3 (-want +got):\n%s", diff)
+	}
+	if want := [][]string{{"c.go", "a.go"}, {"b.go"}}; !reflect.DeepEqual(fetchedPaths, want) {
+		t.Errorf("unexpected fetched paths: got %v, want %v", fetchedPaths, want)
+	}
+
+	if diff := cmp.Diff([]string{"e.go:E"}, search("c4")); diff != "" {
+		t.Errorf("unexpected symbols for c4 (-want +got):\n%s", diff)
+	}
+	if len(fetchedPaths) != 2 {
+		t.Errorf("expected c4 to be parsed in full, fetched %v", fetchedPaths)
+	}
+
+	// An unreadable database is skipped.
+	dbFiles, err := service.cache.ListInDir(cacheDir("r"))
+	if err != nil || len(dbFiles) == 0 {
+		t.Fatalf("listing databases: %v (%v)", dbFiles, err)
+	}
+	if err := os.WriteFile(filepath.Join(filepath.Dir(dbFiles[0]), "corrupt.zip"), []byte("not a database"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"a.go:A5", "b.go:B3", "d.go:D"}, search("c5")); diff != "" {
+		t.Errorf("unexpected symbols for c5 (-want +got):\n%s", diff)
+	}
+	if want := [][]string{{"c.go", "a.go"}, {"b.go"}, {"a.go"}}; !reflect.DeepEqual(fetchedPaths, want) {
+		t.Errorf("unexpected fetched paths: got %v, want %v", fetchedPaths, want)
+	}
+}
+
+func TestParseClosestAncestor(t *testing.T) {
+	out := []byte("c3\nc2\nc1\n")
+	for _, tc := range []struct {
+		candidates []api.CommitID
+		want       api.CommitID
+	}{
+		{candidates: []api.CommitID{"c1", "c2"}, want: "c2"},
+		{candidates: []api.CommitID{"c3"}, want: "c3"},
+		{candidates: []api.CommitID{"c4"}, want: ""},
+		{candidates: nil, want: ""},
+	} {
+		if got := parseClosestAncestor(out, tc.candidates); got != tc.want {
+			t.Errorf("parseClosestAncestor(%v) = %q, want %q", tc.candidates, got, tc.want)
+		}
+	}
+}
+
+func TestParseGitDiffOutput(t *testing.T) {
+	out := []byte("A\x00new.go\x00M\x00dir/changed.go\x00T\x00link\x00D\x00old.go\x00")
+	changes, err := parseGitDiffOutput(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Changes{
+		Added:    []string{"new.go"},
+		Modified: []string{"dir/changed.go", "link"},
+		Deleted:  []string{"old.go"},
+	}
+	if diff := cmp.Diff(want, changes); diff != "" {
+		t.Errorf("unexpected changes (-want +got):\n%s", diff)
+	}
+
+	if changes, err := parseGitDiffOutput(nil); err != nil || !reflect.DeepEqual(changes, Changes{}) {
+		t.Errorf("expected no changes for empty output, got %+v (%v)", changes, err)
+	}
+
+	if _, err := parseGitDiffOutput([]byte("M\x00")); err == nil {
+		t.Error("expected an error for truncated output")
+	}
+}
@@ -0,0 +1,106 @@
+package symbols
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/go-ctags"
+)
+
+// extractor extracts the symbols of a single file without shelling out to
+// ctags.
+type extractor func(f *sourceFile) []*ctags.Entry
+
+// extractors maps lowercase file extensions to the native extractor for the
+// language.
+var extractors = map[string]extractor{
+	".go":   extractGo,
+	".py":   pythonExtractor.extract,
+	".java": javaExtractor.extract,
+	".js":   javaScriptExtractor.extract,
+	".jsx":  javaScriptExtractor.extract,
+	".mjs":  javaScriptExtractor.extract,
+	".ts":   typeScriptExtractor.extract,
+	".tsx":  typeScriptExtractor.extract,
+}
+
+// nativeParser is a ctags.Parser that extracts symbols in-process for the
+// file extensions in extractors. All other files yield no symbols. It is used
+// when ctags is unavailable.
+type nativeParser struct {
+	patternLengthLimit int
+}
+
+// NewNativeParser returns a parser that extracts symbols in-process.
+func NewNativeParser(patternLengthLimit int) ctags.Parser {
+	return &nativeParser{patternLengthLimit: patternLengthLimit}
+}
+
+func (p *nativeParser) Parse(path string, content []byte) ([]*ctags.Entry, error) {
+	if extract, ok := extractors[strings.ToLower(filepath.Ext(path))]; ok {
+		return extract(newSourceFile(path, content, p.patternLengthLimit)), nil
+	}
+	return nil, nil
+}
+
+func (p *nativeParser) Close() {}
+
+// sourceFile is the input to an extractor.
+type sourceFile struct {
+	path               string
+	content            []byte
+	lines              []string
+	patternLengthLimit int
+}
+
+func newSourceFile(path string, content []byte, patternLengthLimit int) *sourceFile {
+	lines := strings.Split(string(bytes.TrimSuffix(content, []byte("\n"))), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+
+	return &sourceFile{
+		path:               path,
+		content:            content,
+		lines:              lines,
+		patternLengthLimit: patternLengthLimit,
+	}
+}
+
+// entry returns a ctags entry for a symbol on the given 1-based line.
+func (f *sourceFile) entry(line int, name, kind, language string) *ctags.Entry {
+	return &ctags.Entry{
+		Name:     name,
+		Path:     f.path,
+		Line:     line,
+		Kind:     kind,
+		Language: language,
+		Pattern:  f.pattern(line),
+	}
+}
+
+// pattern returns the ctags search pattern for the given 1-based line. Like
+// ctags, a line longer than the pattern length limit is truncated and the
+// pattern loses its end anchor.
+func (f *sourceFile) pattern(line int) string {
+	if line < 1 || line > len(f.lines) {
+		return ""
+	}
+
+	text, end := f.lines[line-1], "$/"
+	if f.patternLengthLimit > 0 && len(text) > f.patternLengthLimit {
+		text, end = text[:f.patternLengthLimit], "/"
+	}
+
+	var b strings.Builder
+	b.WriteString("/^")
+	for _, r := range text {
+		if r == '/' || r == '\\' {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteString(end)
+	return b.String()
+}
@@ -0,0 +1,144 @@
+package symbols
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/sourcegraph/go-ctags"
+)
+
+// extractGo extracts the symbols of a Go file using go/parser. The kinds match
+// the ones universal-ctags reports for Go. Files with syntax errors yield the
+// symbols of the declarations that could be parsed.
+func extractGo(f *sourceFile) []*ctags.Entry {
+	fset := token.NewFileSet()
+	file, _ := parser.ParseFile(fset, f.path, f.content, parser.SkipObjectResolution)
+	if file == nil || file.Name == nil {
+		return nil
+	}
+
+	const language = "Go"
+	line := func(pos token.Pos) int { return fset.Position(pos).Line }
+	text := func(node ast.Node) string {
+		start, end := fset.Position(node.Pos()).Offset, fset.Position(node.End()).Offset
+		if start < 0 || end > len(f.content) || start > end {
+			return ""
+		}
+		return string(f.content[start:end])
+	}
+
+	entries := []*ctags.Entry{f.entry(line(file.Name.Pos()), file.Name.Name, "package", language)}
+
+	// Methods refer to their receiver type by name, so we collect the kinds
+	// of all types first.
+	typeKinds := map[string]string{}
+	for _, decl := range file.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.TYPE {
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				typeKinds[ts.Name.Name] = goTypeKind(ts)
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			e := f.entry(line(d.Name.Pos()), d.Name.Name, "func", language)
+			e.Signature = text(d.Type.Params)
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				if recv := goReceiverName(d.Recv.List[0].Type); recv != "" {
+					e.Parent = recv
+					e.ParentKind = typeKinds[recv]
+					if e.ParentKind == "" {
+						e.ParentKind = "type"
+					}
+				}
+			}
+			entries = append(entries, e)
+
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					kind := typeKinds[s.Name.Name]
+					entries = append(entries, f.entry(line(s.Name.Pos()), s.Name.Name, kind, language))
+					entries = append(entries, goTypeMembers(f, line, text, s, kind)...)
+
+				case *ast.ValueSpec:
+					kind := "variable"
+					if d.Tok == token.CONST {
+						kind = "constant"
+					}
+					for _, name := range s.Names {
+						entries = append(entries, f.entry(line(name.Pos()), name.Name, kind, language))
+					}
+				}
+			}
+		}
+	}
+
+	return entries
+}
+
+// goTypeMembers returns the fields of a struct or the methods of an
+// interface.
+func goTypeMembers(f *sourceFile, line func(token.Pos) int, text func(ast.Node) string, s *ast.TypeSpec, kind string) []*ctags.Entry {
+	var (
+		fields     *ast.FieldList
+		memberKind string
+	)
+	switch t := s.Type.(type) {
+	case *ast.StructType:
+		fields, memberKind = t.Fields, "member"
+	case *ast.InterfaceType:
+		fields, memberKind = t.Methods, "methodSpec"
+	default:
+		return nil
+	}
+
+	var entries []*ctags.Entry
+	for _, field := range fields.List {
+		for _, name := range field.Names {
+			e := f.entry(line(name.Pos()), name.Name, memberKind, "Go")
+			e.Parent = s.Name.Name
+			e.ParentKind = kind
+			if ft, ok := field.Type.(*ast.FuncType); ok {
+				e.Signature = text(ft.Params)
+			}
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func goTypeKind(s *ast.TypeSpec) string {
+	switch s.Type.(type) {
+	case *ast.StructType:
+		return "struct"
+	case *ast.InterfaceType:
+		return "interface"
+	default:
+		return "type"
+	}
+}
+
+// goReceiverName returns the name of the type of a method receiver such as
+// `T`, `*T` or `*T[K]`.
+func goReceiverName(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
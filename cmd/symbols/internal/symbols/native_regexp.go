@@ -0,0 +1,323 @@
+package symbols
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/sourcegraph/go-ctags"
+)
+
+// regexpExtractor is a line-based extractor for languages without a parser in
+// the standard library. It only recognizes declarations that start on their
+// own line, which covers the conventional formatting of most code, and tracks
+// nesting either by indentation or by curly braces.
+type regexpExtractor struct {
+	language string
+	rules    []regexpRule
+
+	// indentScoped is true if blocks are delimited by indentation rather
+	// than by curly braces.
+	indentScoped bool
+
+	// keywords are never reported as symbol names. They guard against
+	// statements such as `if (x) {` that look like declarations.
+	keywords map[string]struct{}
+}
+
+// regexpRule matches a single kind of declaration. The first submatch of
+// pattern is the symbol name and the optional second one its signature.
+type regexpRule struct {
+	kind    string
+	pattern *regexp.Regexp
+
+	// container is true if the declaration opens a scope for the following
+	// declarations, such as a class.
+	container bool
+
+	// topLevel restricts the rule to declarations outside any container.
+	topLevel bool
+
+	// parentKinds restricts the rule to declarations directly inside a
+	// container of one of the given kinds.
+	parentKinds []string
+}
+
+// regexpScope is an open container while scanning a file.
+type regexpScope struct {
+	name string
+	kind string
+
+	// level is the indentation or brace depth at which the container was
+	// declared.
+	level int
+
+	// opened is true once the body of a brace-scoped container was entered.
+	opened bool
+}
+
+func (x *regexpExtractor) extract(f *sourceFile) []*ctags.Entry {
+	var (
+		entries []*ctags.Entry
+		scopes  []*regexpScope
+		depth   int
+	)
+
+	for i, line := range f.lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		level := depth
+		if x.indentScoped {
+			level = len(line) - len(strings.TrimLeft(line, " \t"))
+		}
+		for len(scopes) > 0 {
+			top := scopes[len(scopes)-1]
+			if level > top.level || (!x.indentScoped && !top.opened) {
+				break
+			}
+			scopes = scopes[:len(scopes)-1]
+		}
+
+		if e, rule := x.match(f, i+1, line, scopes); e != nil {
+			entries = append(entries, e)
+			if rule.container {
+				scopes = append(scopes, &regexpScope{name: e.Name, kind: e.Kind, level: level})
+			}
+		}
+
+		if !x.indentScoped {
+			depth += braceDelta(line)
+			for _, s := range scopes {
+				if depth > s.level {
+					s.opened = true
+				}
+			}
+		}
+	}
+
+	return entries
+}
+
+// match returns the entry of the first rule matching line, if any.
+func (x *regexpExtractor) match(f *sourceFile, lineNumber int, line string, scopes []*regexpScope) (*ctags.Entry, *regexpRule) {
+	var parent *regexpScope
+	if len(scopes) > 0 {
+		parent = scopes[len(scopes)-1]
+	}
+
+	for i := range x.rules {
+		rule := &x.rules[i]
+		if rule.topLevel && parent != nil {
+			continue
+		}
+		if len(rule.parentKinds) > 0 && (parent == nil || !containsString(rule.parentKinds, parent.kind)) {
+			continue
+		}
+
+		m := rule.pattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		if _, ok := x.keywords[m[1]]; ok {
+			continue
+		}
+
+		e := f.entry(lineNumber, m[1], rule.kind, x.language)
+		if len(m) > 2 {
+			e.Signature = m[2]
+		}
+		if parent != nil {
+			names := make([]string, 0, len(scopes))
+			for _, s := range scopes {
+				names = append(names, s.name)
+			}
+			e.Parent = strings.Join(names, ".")
+			e.ParentKind = parent.kind
+		}
+		return e, rule
+	}
+
+	return nil, nil
+}
+
+// braceDelta returns the number of curly braces opened minus the number
+// closed on the line, ignoring braces in string literals and line comments.
+func braceDelta(line string) int {
+	var (
+		delta int
+		quote rune
+		esc   bool
+	)
+	for i, r := range line {
+		switch {
+		case esc:
+			esc = false
+		case quote != 0:
+			if r == '\\' {
+				esc = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '/' && strings.HasPrefix(line[i:], "//"):
+			return delta
+		case r == '{':
+			delta++
+		case r == '}':
+			delta--
+		}
+	}
+	return delta
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+func keywordSet(keywords ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(keywords))
+	for _, k := range keywords {
+		set[k] = struct{}{}
+	}
+	return set
+}
+
+var pythonExtractor = &regexpExtractor{
+	language:     "Python",
+	indentScoped: true,
+	rules: []regexpRule{
+		{
+			kind:      "class",
+			pattern:   regexp.MustCompile(`^\s*class\s+([A-Za-z_]\w*)`),
+			container: true,
+		},
+		{
+			kind:        "member",
+			pattern:     regexp.MustCompile(`^\s*(?:async\s+)?def\s+([A-Za-z_]\w*)\s*(\([^)]*\)?)`),
+			container:   true,
+			parentKinds: []string{"class"},
+		},
+		{
+			kind:      "function",
+			pattern:   regexp.MustCompile(`^\s*(?:async\s+)?def\s+([A-Za-z_]\w*)\s*(\([^)]*\)?)`),
+			container: true,
+		},
+		{
+			kind:     "variable",
+			pattern:  regexp.MustCompile(`^([A-Za-z_]\w*)\s*(?::[^=]*)?=[^=]`),
+			topLevel: true,
+		},
+	},
+}
+
+const javaModifiers = `(?:(?:public|protected|private|static|final|abstract|sealed|non-sealed|strictfp|synchronized|native|transient|volatile|default)\s+)`
+
+var javaExtractor = &regexpExtractor{
+	language: "Java",
+	keywords: keywordSet("if", "else", "for", "while", "do", "switch", "case", "catch", "try", "finally", "return", "new", "throw", "synchronized", "super", "this"),
+	rules: []regexpRule{
+		{
+			kind:     "package",
+			pattern:  regexp.MustCompile(`^\s*package\s+([\w.]+)\s*;`),
+			topLevel: true,
+		},
+		{
+			kind:      "class",
+			pattern:   regexp.MustCompile(`^\s*` + javaModifiers + `*(?:class|record)\s+(\w+)`),
+			container: true,
+		},
+		{
+			kind:      "interface",
+			pattern:   regexp.MustCompile(`^\s*` + javaModifiers + `*@?interface\s+(\w+)`),
+			container: true,
+		},
+		{
+			kind:      "enum",
+			pattern:   regexp.MustCompile(`^\s*` + javaModifiers + `*enum\s+(\w+)`),
+			container: true,
+		},
+		{
+			kind:        "method",
+			pattern:     regexp.MustCompile(`^\s*` + javaModifiers + `*(?:<[^>]*>\s*)?(?:[\w.$<>\[\],?]+\s+)?(\w+)\s*(\([^)]*\))\s*(?:throws\s+[\w.,\s]+)?[{;]?\s*$`),
+			container:   true,
+			parentKinds: []string{"class", "interface", "enum"},
+		},
+		{
+			kind:        "field",
+			pattern:     regexp.MustCompile(`^\s*` + javaModifiers + `+[\w.$<>\[\],?]+\s+(\w+)\s*[=;]`),
+			parentKinds: []string{"class", "interface", "enum"},
+		},
+	},
+}
+
+const javaScriptIdentifier = `([A-Za-z_$][\w$]*)`
+
+var javaScriptRules = []regexpRule{
+	{
+		kind:      "class",
+		pattern:   regexp.MustCompile(`^\s*(?:export\s+)?(?:default\s+)?(?:abstract\s+)?class\s+` + javaScriptIdentifier),
+		container: true,
+	},
+	{
+		kind:      "function",
+		pattern:   regexp.MustCompile(`^\s*(?:export\s+)?(?:default\s+)?(?:async\s+)?function\s*\*?\s*` + javaScriptIdentifier + `\s*(?:<[^>]*>)?\s*(\([^)]*\)?)`),
+		container: true,
+	},
+	{
+		kind:      "function",
+		pattern:   regexp.MustCompile(`^\s*(?:export\s+)?(?:const|let|var)\s+` + javaScriptIdentifier + `\s*(?::[^=]*)?=\s*(?:async\s+)?(?:function\b|\([^)]*\)\s*(?::[^=]*)?=>|[A-Za-z_$][\w$]*\s*=>)`),
+		container: true,
+	},
+	{
+		kind:        "method",
+		pattern:     regexp.MustCompile(`^\s*(?:(?:static|async|get|set|public|private|protected|readonly|abstract|override)\s+)*\*?#?` + javaScriptIdentifier + `\s*(\([^)]*\))\s*(?::[^{]*)?\{?\s*$`),
+		container:   true,
+		parentKinds: []string{"class"},
+	},
+	{
+		kind:     "variable",
+		pattern:  regexp.MustCompile(`^(?:export\s+)?(?:const|let|var)\s+` + javaScriptIdentifier),
+		topLevel: true,
+	},
+}
+
+var javaScriptKeywords = keywordSet("if", "else", "for", "while", "do", "switch", "case", "catch", "try", "finally", "return", "new", "throw", "function", "constructor")
+
+var javaScriptExtractor = &regexpExtractor{
+	language: "JavaScript",
+	keywords: javaScriptKeywords,
+	rules:    javaScriptRules,
+}
+
+var typeScriptExtractor = &regexpExtractor{
+	language: "TypeScript",
+	keywords: javaScriptKeywords,
+	rules: append([]regexpRule{
+		{
+			kind:      "interface",
+			pattern:   regexp.MustCompile(`^\s*(?:export\s+)?(?:default\s+)?(?:declare\s+)?interface\s+` + javaScriptIdentifier),
+			container: true,
+		},
+		{
+			kind:    "alias",
+			pattern: regexp.MustCompile(`^\s*(?:export\s+)?(?:declare\s+)?type\s+` + javaScriptIdentifier + `\s*(?:<[^>]*>)?\s*=`),
+		},
+		{
+			kind:      "enum",
+			pattern:   regexp.MustCompile(`^\s*(?:export\s+)?(?:declare\s+)?(?:const\s+)?enum\s+` + javaScriptIdentifier),
+			container: true,
+		},
+		{
+			kind:      "namespace",
+			pattern:   regexp.MustCompile(`^\s*(?:export\s+)?(?:declare\s+)?(?:namespace|module)\s+` + javaScriptIdentifier),
+			container: true,
+		},
+	}, javaScriptRules...),
+}
@@ -0,0 +1,180 @@
+package symbols
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/sourcegraph/go-ctags"
+)
+
+func TestNativeParser(t *testing.T) {
+	p := NewNativeParser(250)
+	defer p.Close()
+
+	cases := []struct {
+		path string
+		data string
+		want []*ctags.Entry
+	}{{
+		path: "com/sourcegraph/A.java",
+		data: `
+package com.sourcegraph;
+import a.b.c;
+class A implements B extends C {
+  public static int D = 1;
+  public int E;
+  public A() {
+    E = 2;
+  }
+  public int F() {
+    E++;
+  }
+}
+`,
+		want: []*ctags.Entry{
+			{Name: "com.sourcegraph", Path: "com/sourcegraph/A.java", Line: 2, Kind: "package", Language: "Java"},
+			{Name: "A", Path: "com/sourcegraph/A.java", Line: 4, Kind: "class", Language: "Java"},
+			{Name: "D", Path: "com/sourcegraph/A.java", Line: 5, Kind: "field", Language: "Java", Parent: "A", ParentKind: "class"},
+			{Name: "E", Path: "com/sourcegraph/A.java", Line: 6, Kind: "field", Language: "Java", Parent: "A", ParentKind: "class"},
+			{Name: "A", Path: "com/sourcegraph/A.java", Line: 7, Kind: "method", Language: "Java", Parent: "A", ParentKind: "class", Signature: "()"},
+			{Name: "F", Path: "com/sourcegraph/A.java", Line: 10, Kind: "method", Language: "Java", Parent: "A", ParentKind: "class", Signature: "()"},
+		},
+	}, {
+		path: "a/b.go",
+		data: `package b
+
+const C = 1
+
+var V, W int
+
+type S struct {
+	F int
+}
+
+type I interface {
+	M(x int) error
+}
+
+type T = S
+
+func (s *S) Method(a, b string) {}
+
+func Func() {}
+`,
+		want: []*ctags.Entry{
+			{Name: "b", Path: "a/b.go", Line: 1, Kind: "package", Language: "Go"},
+			{Name: "C", Path: "a/b.go", Line: 3, Kind: "constant", Language: "Go"},
+			{Name: "V", Path: "a/b.go", Line: 5, Kind: "variable", Language: "Go"},
+			{Name: "W", Path: "a/b.go", Line: 5, Kind: "variable", Language: "Go"},
+			{Name: "S", Path: "a/b.go", Line: 7, Kind: "struct", Language: "Go"},
+			{Name: "F", Path: "a/b.go", Line: 8, Kind: "member", Language: "Go", Parent: "S", ParentKind: "struct"},
+			{Name: "I", Path: "a/b.go", Line: 11, Kind: "interface", Language: "Go"},
+			{Name: "M", Path: "a/b.go", Line: 12, Kind: "methodSpec", Language: "Go", Parent: "I", ParentKind: "interface", Signature: "(x int)"},
+			{Name: "T", Path: "a/b.go", Line: 15, Kind: "type", Language: "Go"},
+			{Name: "Method", Path: "a/b.go", Line: 17, Kind: "func", Language: "Go", Parent: "S", ParentKind: "struct", Signature: "(a, b string)"},
+			{Name: "Func", Path: "a/b.go", Line: 19, Kind: "func", Language: "Go", Signature: "()"},
+		},
+	}, {
+		path: "pkg/mod.py",
+		data: `X = 1
+
+class A:
+    y = 2
+
+    def method(self, z):
+        def inner():
+            pass
+
+def func(a, b):
+    if a == b:
+        return a
+`,
+		want: []*ctags.Entry{
+			{Name: "X", Path: "pkg/mod.py", Line: 1, Kind: "variable", Language: "Python"},
+			{Name: "A", Path: "pkg/mod.py", Line: 3, Kind: "class", Language: "Python"},
+			{Name: "method", Path: "pkg/mod.py", Line: 6, Kind: "member", Language: "Python", Parent: "A", ParentKind: "class", Signature: "(self, z)"},
+			{Name: "inner", Path: "pkg/mod.py", Line: 7, Kind: "function", Language: "Python", Parent: "A.method", ParentKind: "member", Signature: "()"},
+			{Name: "func", Path: "pkg/mod.py", Line: 10, Kind: "function", Language: "Python", Signature: "(a, b)"},
+		},
+	}, {
+		path: "src/index.ts",
+		data: `export interface Props {
+    name: string
+}
+
+export type ID = string
+
+export class Widget {
+    constructor(private props: Props) {}
+
+    public render(x: number): string {
+        if (x > 0) {
+            return "{"
+        }
+        return ""
+    }
+}
+
+export const handler = async (req: Request) => {
+    return req
+}
+
+export function main(argv: string[]) {}
+`,
+		want: []*ctags.Entry{
+			{Name: "Props", Path: "src/index.ts", Line: 1, Kind: "interface", Language: "TypeScript"},
+			{Name: "ID", Path: "src/index.ts", Line: 5, Kind: "alias", Language: "TypeScript"},
+			{Name: "Widget", Path: "src/index.ts", Line: 7, Kind: "class", Language: "TypeScript"},
+			{Name: "render", Path: "src/index.ts", Line: 10, Kind: "method", Language: "TypeScript", Parent: "Widget", ParentKind: "class", Signature: "(x: number)"},
+			{Name: "handler", Path: "src/index.ts", Line: 18, Kind: "function", Language: "TypeScript"},
+			{Name: "main", Path: "src/index.ts", Line: 22, Kind: "function", Language: "TypeScript", Signature: "(argv: string[])"},
+		},
+	}, {
+		path: "README.md",
+		data: "# Title\n",
+	}}
+
+	for _, tc := range cases {
+		got, err := p.Parse(tc.path, []byte(tc.data))
+		if err != nil {
+			t.Error(err)
+		}
+
+		if d := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(ctags.Entry{}, "Pattern")); d != "" {
+			t.Errorf("%s mismatch (-want +got):\n%s", tc.path, d)
+		}
+	}
+}
+
+func TestNativeParser_UnsupportedLanguage(t *testing.T) {
+	p := NewNativeParser(250)
+
+	got, err := p.Parse("a.rb", []byte("x = 1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no symbols, got %v", got)
+	}
+}
+
+func TestSourceFilePattern(t *testing.T) {
+	f := newSourceFile("a.js", []byte("a /b/ \\c\r\nabcdef\n"), 4)
+
+	for line, want := range map[int]string{
+		1: `/^a \/b/`,
+		2: `/^abcd/`,
+		3: "",
+	} {
+		if have := f.pattern(line); have != want {
+			t.Errorf("line %d: have %q, want %q", line, have, want)
+		}
+	}
+
+	f.patternLengthLimit = 0
+	if have, want := f.pattern(1), `/^a \/b\/ \\c$/`; have != want {
+		t.Errorf("have %q, want %q", have, want)
+	}
+}
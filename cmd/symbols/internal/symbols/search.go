@@ -6,11 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os/exec"
 	"regexp"
 	"regexp/syntax"
 	"strings"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/mattn/go-sqlite3"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -112,8 +114,8 @@ func (s *Service) search(ctx context.Context, args protocol.SearchArgs) (*result
 // specified in `args`. If the database doesn't already exist in the disk cache,
 // it will create a new one and write all the symbols into it.
 func (s *Service) getDBFile(ctx context.Context, args protocol.SearchArgs) (string, error) {
-	diskcacheFile, err := s.cache.OpenWithPath(ctx, fmt.Sprintf("%d-%s@%s", symbolsDBVersion, args.Repo, args.CommitID), func(fetcherCtx context.Context, tempDBFile string) error {
-		err := s.writeAllSymbolsToNewDB(fetcherCtx, tempDBFile, args.Repo, args.CommitID)
+	diskcacheFile, err := s.cache.OpenInDirWithPath(ctx, cacheDir(args.Repo), string(args.CommitID), func(fetcherCtx context.Context, tempDBFile string) error {
+		err := s.writeSymbolsToNewDB(fetcherCtx, tempDBFile, args.Repo, args.CommitID)
 		if err != nil {
 			if err == context.Canceled {
 				log15.Error("Unable to parse repository symbols within the context", "repo", args.Repo, "commit", args.CommitID, "query", args.Query)
@@ -130,6 +132,12 @@ func (s *Service) getDBFile(ctx context.Context, args protocol.SearchArgs) (stri
 	return diskcacheFile.File.Name(), err
 }
 
+// cacheDir returns the disk cache directory for the databases of a
+// repository.
+func cacheDir(repo api.RepoName) string {
+	return fmt.Sprintf("%d-%s", symbolsDBVersion, repo)
+}
+
 // isLiteralEquality checks if the given regex matches literal strings exactly.
 // Returns whether or not the regex is exact, along with the literal string if
 // so.
@@ -205,6 +213,28 @@ func filterSymbols(ctx context.Context, db *sqlx.DB, args protocol.SearchArgs) (
 	}
 	conditions = append(conditions, negateAll(makeCondition("path", args.ExcludePattern))...)
 
+	if len(args.Kinds) > 0 {
+		var kinds []*sqlf.Query
+		for _, selectKind := range args.Kinds {
+			for _, kind := range result.KindsOfSelectKind(selectKind) {
+				kinds = append(kinds, sqlf.Sprintf("%s", kind))
+			}
+		}
+		if len(kinds) == 0 {
+			// None of the kinds correspond to a ctags kind, so nothing matches.
+			return nil, nil
+		}
+		conditions = append(conditions, sqlf.Sprintf("LOWER(kind) IN (%s)", sqlf.Join(kinds, ", ")))
+	}
+
+	if args.ParentPattern != "" {
+		parentPattern := args.ParentPattern
+		if !args.IsCaseSensitive {
+			parentPattern = "(?i:" + parentPattern + ")"
+		}
+		conditions = append(conditions, sqlf.Sprintf("parent REGEXP %s", parentPattern))
+	}
+
 	var sqlQuery *sqlf.Query
 	if len(conditions) == 0 {
 		sqlQuery = sqlf.Sprintf("SELECT * FROM symbols LIMIT %s", args.First)
@@ -230,7 +260,7 @@ func filterSymbols(ctx context.Context, db *sqlx.DB, args protocol.SearchArgs) (
 // filenames to prevent a newer version of the symbols service from attempting
 // to read from a database created by an older (and likely incompatible) symbols
 // service. Increment this when you change the database schema.
-const symbolsDBVersion = 3
+const symbolsDBVersion = 5
 
 // symbolInDB is the same as `protocol.Symbol`, but with two additional columns:
 // namelowercase and pathlowercase, which enable indexed case insensitive
@@ -307,10 +337,32 @@ func (s *Service) writeAllSymbolsToNewDB(ctx context.Context, dbFile string, rep
 		err = tx.Commit()
 	}()
 
+	if err := createSymbolsTables(tx); err != nil {
+		return err
+	}
+
+	if err := writeCommitID(tx, commitID); err != nil {
+		return err
+	}
+
+	insertStatement, err := prepareInsertSymbol(tx)
+	if err != nil {
+		return err
+	}
+
+	return s.parseUncached(ctx, repoName, commitID, nil, func(symbol result.Symbol) error {
+		symbolInDBValue := symbolToSymbolInDB(symbol)
+		_, err := insertStatement.Exec(&symbolInDBValue)
+		return err
+	})
+}
+
+// createSymbolsTables creates the tables of a new database.
+func createSymbolsTables(tx *sqlx.Tx) error {
 	// The column names are the lowercase version of fields in `symbolInDB`
 	// because sqlx lowercases struct fields by default. See
 	// http://jmoiron.github.io/sqlx/#query
-	_, err = tx.Exec(
+	_, err := tx.Exec(
 		`CREATE TABLE IF NOT EXISTS symbols (
 			name VARCHAR(256) NOT NULL,
 			namelowercase VARCHAR(256) NOT NULL,
@@ -350,24 +402,32 @@ func (s *Service) writeAllSymbolsToNewDB(ctx context.Context, dbFile string, rep
 		return err
 	}
 
-	insertStatement, err := tx.PrepareNamed(
+	// `meta` holds the commit the symbols belong to, which is the base for
+	// incremental updates of a copy of the database.
+	_, err = tx.Exec(
+		`CREATE TABLE IF NOT EXISTS meta (
+			id INTEGER PRIMARY KEY CHECK (id = 0),
+			revision TEXT NOT NULL
+		)`)
+	return err
+}
+
+func prepareInsertSymbol(tx *sqlx.Tx) (*sqlx.NamedStmt, error) {
+	return tx.PrepareNamed(
 		fmt.Sprintf(
 			"INSERT INTO symbols %s VALUES %s",
 			"( name,  namelowercase,  path,  pathlowercase,  line,  kind,  language,  parent,  parentkind,  signature,  pattern,  filelimited)",
 			"(:name, :namelowercase, :path, :pathlowercase, :line, :kind, :language, :parent, :parentkind, :signature, :pattern, :filelimited)"))
-	if err != nil {
-		return err
-	}
+}
 
-	return s.parseUncached(ctx, repoName, commitID, func(symbol result.Symbol) error {
-		symbolInDBValue := symbolToSymbolInDB(symbol)
-		_, err := insertStatement.Exec(&symbolInDBValue)
-		return err
-	})
+func writeCommitID(tx *sqlx.Tx, commitID api.CommitID) error {
+	_, err := tx.Exec(`INSERT OR REPLACE INTO meta (id, revision) VALUES (0, ?)`, string(commitID))
+	return err
 }
 
 // SanityCheck makes sure that go-sqlite3 was compiled with cgo by
-// seeing if we can actually create a table.
+// seeing if we can actually create a table. If ctags is enabled, it also
+// makes sure that the ctags command can be found.
 func SanityCheck() error {
 	db, err := sqlx.Open("sqlite3_with_regexp", ":memory:")
 	if err != nil {
@@ -382,5 +442,11 @@ func SanityCheck() error {
 		return err
 	}
 
+	if useCtags {
+		if _, err := exec.LookPath(ctagsCommand); err != nil {
+			return errors.Wrap(err, "USE_CTAGS is set but the ctags command could not be found")
+		}
+	}
+
 	return nil
 }
@@ -24,13 +24,30 @@ type Service struct {
 	// determine if the error is a bad request (eg invalid repo).
 	FetchTar func(context.Context, api.RepoName, api.CommitID) (io.ReadCloser, error)
 
+	// FetchTarPaths is like FetchTar, but the archive only contains the given
+	// paths. Together with GitDiff, it enables updating the symbols of a
+	// previously indexed commit incrementally.
+	FetchTarPaths func(context.Context, api.RepoName, api.CommitID, []string) (io.ReadCloser, error)
+
+	// GitDiff returns the paths that differ between two commits of a
+	// repository.
+	GitDiff func(ctx context.Context, repo api.RepoName, commitA, commitB api.CommitID) (Changes, error)
+
+	// ClosestAncestor returns the candidate that is the closest ancestor of
+	// commit, or an empty commit ID if there is none. It is used to pick the
+	// previously indexed commit to update incrementally.
+	ClosestAncestor func(ctx context.Context, repo api.RepoName, commit api.CommitID, candidates []api.CommitID) (api.CommitID, error)
+
 	// MaxConcurrentFetchTar is the maximum number of concurrent calls allowed
 	// to FetchTar. It defaults to 15.
 	MaxConcurrentFetchTar int
 
+	// NewParser returns a new parser for the pool. See NewParser in this
+	// package for the default, which only uses ctags where configured.
 	NewParser func() (ctags.Parser, error)
 
-	// NumParserProcesses is the maximum number of ctags parser child processes to run.
+	// NumParserProcesses is the number of parsers in the pool, and thus the
+	// maximum number of ctags parser child processes to run.
 	NumParserProcesses int
 
 	// Path is the directory in which to store the cache.
@@ -49,7 +66,7 @@ type Service struct {
 	// semaphore size is controlled by MaxConcurrentFetchTar
 	fetchSem chan int
 
-	// pool of ctags parser child processes
+	// pool of parsers
 	parsers chan ctags.Parser
 }
 
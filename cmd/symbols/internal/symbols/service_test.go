@@ -147,6 +147,99 @@ func TestService(t *testing.T) {
 	}
 }
 
+func TestService_KindAndParentFilters(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { os.RemoveAll(tmpDir) }()
+
+	files := map[string]string{"a.go": `package a
+
+type Store interface {
+	Get() int
+}
+
+type store struct {
+	count int
+}
+
+func (s *store) Get() int { return s.count }
+
+func NewStore() Store { return &store{} }
+`}
+	service := Service{
+		FetchTar: func(ctx context.Context, repo api.RepoName, commit api.CommitID) (io.ReadCloser, error) {
+			return createTar(files)
+		},
+		NewParser: func() (ctags.Parser, error) {
+			return NewNativeParser(0), nil
+		},
+		Path: tmpDir,
+	}
+
+	if err := service.Start(); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(service.Handler())
+	defer server.Close()
+	client := symbolsclient.Client{
+		URL:        server.URL,
+		HTTPClient: httpcli.InternalDoer,
+	}
+
+	tests := map[string]struct {
+		args search.SymbolsParameters
+		want []string
+	}{
+		"kind": {
+			args: search.SymbolsParameters{Kinds: []string{"interface"}, First: 10},
+			want: []string{"Store"},
+		},
+		"kinds": {
+			args: search.SymbolsParameters{Query: "store", Kinds: []string{"interface", "struct"}, First: 10},
+			want: []string{"Store", "store"},
+		},
+		"kind with multiple ctags kinds": {
+			args: search.SymbolsParameters{Kinds: []string{"function"}, First: 10},
+			want: []string{"Get", "NewStore"},
+		},
+		"kind without ctags kinds": {
+			args: search.SymbolsParameters{Kinds: []string{"event"}, First: 10},
+			want: nil,
+		},
+		"parent": {
+			args: search.SymbolsParameters{ParentPattern: "^store$", IsCaseSensitive: true, First: 10},
+			want: []string{"count", "Get"},
+		},
+		"case insensitive parent": {
+			args: search.SymbolsParameters{ParentPattern: "^STORE$", First: 10},
+			want: []string{"Get", "count", "Get"},
+		},
+		"kind and parent": {
+			args: search.SymbolsParameters{Kinds: []string{"method"}, ParentPattern: "Store", First: 10},
+			want: []string{"Get"},
+		},
+	}
+	for label, test := range tests {
+		t.Run(label, func(t *testing.T) {
+			result, err := client.Search(context.Background(), test.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			if result != nil {
+				for _, s := range *result {
+					names = append(names, s.Name)
+				}
+			}
+			if !reflect.DeepEqual(names, test.want) {
+				t.Errorf("got %v, want %v", names, test.want)
+			}
+		})
+	}
+}
+
 func createTar(files map[string]string) (io.ReadCloser, error) {
 	buf := new(bytes.Buffer)
 	w := tar.NewWriter(buf)
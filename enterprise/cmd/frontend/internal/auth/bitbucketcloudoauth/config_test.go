@@ -0,0 +1,68 @@
+package bitbucketcloudoauth
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/auth/oauth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestParseConfig(t *testing.T) {
+	provider := schema.BitbucketCloudAuthProvider{
+		Type:         "bitbucketcloud",
+		ClientKey:    "client-key",
+		ClientSecret: "client-secret",
+		DisplayName:  "Bitbucket",
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		ps, problems := parseConfig(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			ExternalURL:   "https://sourcegraph.example.com",
+			AuthProviders: []schema.AuthProviders{{Bitbucketcloud: &provider}},
+		}}, nil)
+		if len(problems) > 0 {
+			t.Fatalf("unexpected problems: %v", problems)
+		}
+		p, ok := ps[provider]
+		if !ok {
+			t.Fatalf("provider missing: %v", ps)
+		}
+
+		info := p.CachedInfo()
+		if diff := cmp.Diff("https://bitbucket.org/", info.ServiceID); diff != "" {
+			t.Errorf("unexpected service ID (-want +got):\n%s", diff)
+		}
+		if info.DisplayName != "Bitbucket" {
+			t.Errorf("got display name %q, want %q", info.DisplayName, "Bitbucket")
+		}
+
+		wantConfig := oauth2.Config{
+			ClientID:     "client-key",
+			ClientSecret: "client-secret",
+			RedirectURL:  "https://sourcegraph.example.com/.auth/bitbucketcloud/callback",
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  "https://bitbucket.org/site/oauth2/authorize",
+				TokenURL: "https://bitbucket.org/site/oauth2/access_token",
+			},
+		}
+		if diff := cmp.Diff(wantConfig, p.(*oauth.Provider).OAuth2Config()); diff != "" {
+			t.Errorf("unexpected OAuth config (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("no externalURL", func(t *testing.T) {
+		ps, problems := parseConfig(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			AuthProviders: []schema.AuthProviders{{Bitbucketcloud: &provider}},
+		}}, nil)
+		if len(ps) != 0 {
+			t.Errorf("unexpected providers: %v", ps)
+		}
+		if diff := cmp.Diff([]string{"`externalURL` was empty and it is needed to determine the OAuth callback URL."}, problems.Messages()); diff != "" {
+			t.Errorf("unexpected problems (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package bitbucketcloudoauth
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dghubble/gologin"
+	oauth2Login "github.com/dghubble/gologin/oauth2"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+)
+
+func LoginHandler(config *oauth2.Config, failure http.Handler) http.Handler {
+	return oauth2Login.LoginHandler(config, failure)
+}
+
+func CallbackHandler(config *oauth2.Config, apiURL *url.URL, success, failure http.Handler) http.Handler {
+	success = bitbucketCloudHandler(apiURL, success, failure)
+	return oauth2Login.CallbackHandler(config, success, failure)
+}
+
+func bitbucketCloudHandler(apiURL *url.URL, success, failure http.Handler) http.Handler {
+	if failure == nil {
+		failure = gologin.DefaultFailureHandler
+	}
+	fn := func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		token, err := oauth2Login.TokenFromContext(ctx)
+		if err != nil {
+			ctx = gologin.WithError(ctx, err)
+			failure.ServeHTTP(w, req.WithContext(ctx))
+			return
+		}
+
+		client, err := bitbucketcloud.NewClient(apiURL, nil).WithAuthenticator(&auth.OAuthBearerToken{Token: token.AccessToken})
+		if err != nil {
+			ctx = gologin.WithError(ctx, err)
+			failure.ServeHTTP(w, req.WithContext(ctx))
+			return
+		}
+		user, err := client.CurrentUser(ctx)
+		err = validateResponse(user, err)
+		if err != nil {
+			ctx = gologin.WithError(ctx, err)
+			failure.ServeHTTP(w, req.WithContext(ctx))
+			return
+		}
+		emails, err := client.CurrentUserEmails(ctx)
+		if err != nil {
+			ctx = gologin.WithError(ctx, errors.Wrap(err, "unable to get Bitbucket Cloud user emails"))
+			failure.ServeHTTP(w, req.WithContext(ctx))
+			return
+		}
+		ctx = WithUser(ctx, user, emails)
+		success.ServeHTTP(w, req.WithContext(ctx))
+	}
+	return http.HandlerFunc(fn)
+}
+
+// validateResponse returns an error if the given Bitbucket Cloud account or error are unexpected.
+// Returns nil if they are valid.
+func validateResponse(user *bitbucketcloud.Account, err error) error {
+	if err != nil {
+		return errors.Wrap(err, "unable to get Bitbucket Cloud user")
+	}
+	if user == nil || user.UUID == "" {
+		return errors.Errorf("unable to get Bitbucket Cloud user: bad user info %#+v", user)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package bitbucketcloudoauth
+
+import (
+	"net/http"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/auth/oauth"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const authPrefix = auth.AuthURLPrefix + "/bitbucketcloud"
+
+func init() {
+	oauth.AddIsOAuth(func(p schema.AuthProviders) bool {
+		return p.Bitbucketcloud != nil
+	})
+}
+
+func Middleware(db dbutil.DB) *auth.Middleware {
+	return &auth.Middleware{
+		API: func(next http.Handler) http.Handler {
+			return oauth.NewHandler(db, extsvc.TypeBitbucketCloud, authPrefix, true, next)
+		},
+		App: func(next http.Handler) http.Handler {
+			return oauth.NewHandler(db, extsvc.TypeBitbucketCloud, authPrefix, false, next)
+		},
+	}
+}
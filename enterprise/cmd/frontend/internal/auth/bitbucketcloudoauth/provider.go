@@ -0,0 +1,86 @@
+package bitbucketcloudoauth
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/gologin"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/auth/oauth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const sessionKey = "bitbucketcloudoauth@0"
+
+func parseProvider(db dbutil.DB, callbackURL string, p *schema.BitbucketCloudAuthProvider, sourceCfg schema.AuthProviders) (provider *oauth.Provider, messages []string) {
+	rawURL := p.Url
+	if rawURL == "" {
+		rawURL = "https://bitbucket.org/"
+	}
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		messages = append(messages, fmt.Sprintf("Could not parse Bitbucket Cloud URL %q. You will not be able to login via Bitbucket Cloud.", rawURL))
+		return nil, messages
+	}
+	rawAPIURL := p.ApiURL
+	if rawAPIURL == "" {
+		rawAPIURL = "https://api.bitbucket.org/"
+	}
+	apiURL, err := url.Parse(rawAPIURL)
+	if err != nil {
+		messages = append(messages, fmt.Sprintf("Could not parse Bitbucket Cloud API URL %q. You will not be able to login via Bitbucket Cloud.", rawAPIURL))
+		return nil, messages
+	}
+	codeHost := extsvc.NewCodeHost(parsedURL, extsvc.TypeBitbucketCloud)
+
+	return oauth.NewProvider(oauth.ProviderOp{
+		AuthPrefix: authPrefix,
+		// The scopes are configured on the OAuth consumer in Bitbucket Cloud, so extra
+		// scopes can't be requested.
+		OAuth2Config: func(extraScopes ...string) oauth2.Config {
+			return oauth2.Config{
+				RedirectURL:  callbackURL,
+				ClientID:     p.ClientKey,
+				ClientSecret: p.ClientSecret,
+				Endpoint:     bitbucketcloud.OAuthEndpoint(codeHost.BaseURL),
+			}
+		},
+		SourceConfig: sourceCfg,
+		StateConfig:  getStateConfig(),
+		ServiceID:    codeHost.ServiceID,
+		ServiceType:  codeHost.ServiceType,
+		Login: func(oauth2Cfg oauth2.Config) http.Handler {
+			return LoginHandler(&oauth2Cfg, nil)
+		},
+		Callback: func(oauth2Cfg oauth2.Config) http.Handler {
+			return CallbackHandler(
+				&oauth2Cfg,
+				apiURL,
+				oauth.SessionIssuer(db, &sessionIssuerHelper{
+					db:          db,
+					CodeHost:    codeHost,
+					clientKey:   p.ClientKey,
+					allowSignup: p.AllowSignup == nil || *p.AllowSignup,
+				}, sessionKey),
+				nil,
+			)
+		},
+	}), messages
+}
+
+func getStateConfig() gologin.CookieConfig {
+	cfg := gologin.CookieConfig{
+		Name:     "bitbucketcloud-state-cookie",
+		Path:     "/",
+		MaxAge:   900, // 15 minutes
+		HTTPOnly: true,
+		Secure:   conf.IsExternalURLSecure(),
+	}
+	return cfg
+}
@@ -0,0 +1,93 @@
+package bitbucketcloudoauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth/providers"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/auth/oauth"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+)
+
+type sessionIssuerHelper struct {
+	*extsvc.CodeHost
+	clientKey   string
+	allowSignup bool
+	db          dbutil.DB
+}
+
+func (s *sessionIssuerHelper) GetOrCreateUser(ctx context.Context, token *oauth2.Token, anonymousUserID, firstSourceURL, lastSourceURL string) (actr *actor.Actor, safeErrMsg string, err error) {
+	bUser, emails, err := UserFromContext(ctx)
+	if err != nil {
+		return nil, "Could not read Bitbucket Cloud user from callback request.", errors.Wrap(err, "could not read user from context")
+	}
+
+	login, err := auth.NormalizeUsername(bUser.Nickname)
+	if err != nil {
+		return nil, fmt.Sprintf("Error normalizing the username %q. See https://docs.sourcegraph.com/admin/auth/#username-normalization.", login), err
+	}
+
+	var data extsvc.AccountData
+	bitbucketcloud.SetExternalAccountData(&data, bUser, token)
+
+	// We only use the primary email to resolve the user's identity, and only if Bitbucket
+	// Cloud has confirmed that it belongs to the user.
+	var email string
+	for _, e := range emails {
+		if e.IsPrimary && e.IsConfirmed {
+			email = e.Email
+			break
+		}
+	}
+
+	userID, safeErrMsg, err := auth.GetAndSaveUser(ctx, database.NewDB(s.db), auth.GetAndSaveUserOp{
+		UserProps: database.NewUser{
+			Username:        login,
+			Email:           email,
+			EmailIsVerified: email != "",
+			DisplayName:     bUser.DisplayName,
+		},
+		ExternalAccount: extsvc.AccountSpec{
+			ServiceType: s.ServiceType,
+			ServiceID:   s.ServiceID,
+			ClientID:    s.clientKey,
+			AccountID:   bUser.UUID,
+		},
+		ExternalAccountData: data,
+		CreateIfNotExist:    s.allowSignup,
+	})
+	if err != nil {
+		return nil, safeErrMsg, err
+	}
+	return actor.FromUser(userID), "", nil
+}
+
+func (s *sessionIssuerHelper) CreateCodeHostConnection(ctx context.Context, token *oauth2.Token, providerID string) (safeErrMsg string, err error) {
+	return "Creating code host connections from Bitbucket Cloud sign-in is not supported.", errors.New("creating code host connections is not supported for Bitbucket Cloud")
+}
+
+func (s *sessionIssuerHelper) DeleteStateCookie(w http.ResponseWriter) {
+	stateConfig := getStateConfig()
+	stateConfig.MaxAge = -1
+	http.SetCookie(w, oauth.NewCookie(stateConfig, ""))
+}
+
+func (s *sessionIssuerHelper) SessionData(token *oauth2.Token) oauth.SessionData {
+	return oauth.SessionData{
+		ID: providers.ConfigID{
+			ID:   s.ServiceID,
+			Type: s.ServiceType,
+		},
+		AccessToken: token.AccessToken,
+		TokenType:   token.Type(),
+	}
+}
@@ -0,0 +1,135 @@
+package bitbucketcloudoauth
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+)
+
+func TestSessionIssuerHelper_GetOrCreateUser(t *testing.T) {
+	bbURL, _ := url.Parse("https://bitbucket.org")
+	codeHost := extsvc.NewCodeHost(bbURL, extsvc.TypeBitbucketCloud)
+
+	alice := &bitbucketcloud.Account{UUID: "{alice}", Nickname: "alice", DisplayName: "Alice"}
+
+	for _, tc := range []struct {
+		name        string
+		account     *bitbucketcloud.Account
+		emails      []*bitbucketcloud.Email
+		allowSignup bool
+
+		wantEmail string
+		wantErr   bool
+	}{
+		{
+			name:    "confirmed primary email",
+			account: alice,
+			emails: []*bitbucketcloud.Email{
+				{Email: "old@example.com", IsConfirmed: true},
+				{Email: "alice@example.com", IsPrimary: true, IsConfirmed: true},
+			},
+			allowSignup: true,
+			wantEmail:   "alice@example.com",
+		},
+		{
+			name:        "unconfirmed primary email",
+			account:     alice,
+			emails:      []*bitbucketcloud.Email{{Email: "alice@example.com", IsPrimary: true}},
+			allowSignup: true,
+		},
+		{
+			name:    "signup not allowed",
+			account: alice,
+		},
+		{
+			name:    "no account",
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotOp *auth.GetAndSaveUserOp
+			auth.MockGetAndSaveUser = func(ctx context.Context, op auth.GetAndSaveUserOp) (int32, string, error) {
+				gotOp = &op
+				return 1, "", nil
+			}
+			defer func() { auth.MockGetAndSaveUser = nil }()
+
+			s := &sessionIssuerHelper{CodeHost: codeHost, clientKey: "client-key", allowSignup: tc.allowSignup}
+
+			ctx := context.Background()
+			if tc.account != nil {
+				ctx = WithUser(ctx, tc.account, tc.emails)
+			}
+			token := &oauth2.Token{AccessToken: "token", RefreshToken: "refresh"}
+			actr, _, err := s.GetOrCreateUser(ctx, token, "", "", "")
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actr.UID != 1 {
+				t.Fatalf("got actor %d, want %d", actr.UID, 1)
+			}
+
+			wantProps := database.NewUser{
+				Username:        "alice",
+				Email:           tc.wantEmail,
+				EmailIsVerified: tc.wantEmail != "",
+				DisplayName:     "Alice",
+			}
+			if diff := cmp.Diff(wantProps, gotOp.UserProps); diff != "" {
+				t.Errorf("unexpected user props (-want +got):\n%s", diff)
+			}
+			wantAccount := extsvc.AccountSpec{
+				ServiceType: extsvc.TypeBitbucketCloud,
+				ServiceID:   "https://bitbucket.org/",
+				ClientID:    "client-key",
+				AccountID:   "{alice}",
+			}
+			if diff := cmp.Diff(wantAccount, gotOp.ExternalAccount); diff != "" {
+				t.Errorf("unexpected external account (-want +got):\n%s", diff)
+			}
+			if gotOp.CreateIfNotExist != tc.allowSignup {
+				t.Errorf("got CreateIfNotExist %v, want %v", gotOp.CreateIfNotExist, tc.allowSignup)
+			}
+
+			// The token is stored so that permissions can be synced on the user's behalf.
+			gotAccount, gotToken, err := bitbucketcloud.GetExternalAccountData(&gotOp.ExternalAccountData)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(alice, gotAccount); diff != "" {
+				t.Errorf("unexpected account data (-want +got):\n%s", diff)
+			}
+			if gotToken.AccessToken != "token" || gotToken.RefreshToken != "refresh" {
+				t.Errorf("unexpected token data: %+v", gotToken)
+			}
+		})
+	}
+
+	t.Run("GetAndSaveUser error", func(t *testing.T) {
+		auth.MockGetAndSaveUser = func(ctx context.Context, op auth.GetAndSaveUserOp) (int32, string, error) {
+			return 0, "safe", errors.New("x")
+		}
+		defer func() { auth.MockGetAndSaveUser = nil }()
+
+		s := &sessionIssuerHelper{CodeHost: codeHost, clientKey: "client-key"}
+		_, safeErrMsg, err := s.GetOrCreateUser(WithUser(context.Background(), alice, nil), &oauth2.Token{}, "", "", "")
+		if err == nil || safeErrMsg != "safe" {
+			t.Fatalf("got %q, %v, want safe error", safeErrMsg, err)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package bitbucketcloudoauth
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+)
+
+// unexported key type prevents collisions
+type key int
+
+const userKey key = iota
+
+type user struct {
+	account *bitbucketcloud.Account
+	emails  []*bitbucketcloud.Email
+}
+
+// WithUser returns a copy of ctx that stores the Bitbucket Cloud account and its email
+// addresses.
+func WithUser(ctx context.Context, account *bitbucketcloud.Account, emails []*bitbucketcloud.Email) context.Context {
+	return context.WithValue(ctx, userKey, &user{account: account, emails: emails})
+}
+
+// UserFromContext returns the Bitbucket Cloud account and its email addresses from the ctx.
+func UserFromContext(ctx context.Context) (*bitbucketcloud.Account, []*bitbucketcloud.Email, error) {
+	u, ok := ctx.Value(userKey).(*user)
+	if !ok {
+		return nil, nil, errors.Errorf("bitbucketcloud: Context missing Bitbucket Cloud user")
+	}
+	return u.account, u.emails, nil
+}
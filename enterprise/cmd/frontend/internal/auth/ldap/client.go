@@ -0,0 +1,127 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	goldap "github.com/go-ldap/ldap/v3"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	dialTimeout    = 10 * time.Second
+	requestTimeout = 30 * time.Second
+)
+
+// errInvalidCredentials is returned by authenticate when the username doesn't identify exactly one
+// user entry or when the password is wrong. Both cases are reported the same way to avoid leaking
+// which usernames exist in the directory.
+var errInvalidCredentials = errors.New("invalid username or password")
+
+// userInfo is the information about a user that is read from their LDAP entry. It is stored as the
+// data of the user's external account.
+type userInfo struct {
+	DN          string   `json:"dn"`
+	Username    string   `json:"username"`
+	Email       string   `json:"email,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Groups      []string `json:"groups,omitempty"`
+}
+
+// authenticate looks up the entry of the user with the given username with a search as the
+// service account of pc, and verifies the password by binding as that entry.
+//
+// 🚨 SECURITY
+func authenticate(pc *schema.LDAPAuthProvider, username, password string) (*userInfo, error) {
+	// 🚨 SECURITY: A simple bind with an empty password is an unauthenticated bind, which LDAP
+	// servers accept for any DN (see https://tools.ietf.org/html/rfc4513#section-5.1.2).
+	if username == "" || password == "" {
+		return nil, errInvalidCredentials
+	}
+
+	conn, err := dial(pc)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if pc.BindDN != "" {
+		if err := conn.Bind(pc.BindDN, pc.BindPassword); err != nil {
+			return nil, errors.Wrap(err, "binding as the service account")
+		}
+	}
+
+	attributes := []string{pc.UsernameAttribute, pc.EmailAttribute, pc.DisplayNameAttribute, pc.GroupMembershipAttribute}
+	res, err := conn.Search(goldap.NewSearchRequest(
+		pc.UserBaseDN,
+		goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases,
+		2, // more than 1 entry means the filter is ambiguous
+		int(requestTimeout.Seconds()),
+		false,
+		strings.ReplaceAll(pc.UserFilter, usernamePlaceholder, goldap.EscapeFilter(username)),
+		attributes,
+		nil,
+	))
+	if goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, errInvalidCredentials
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "searching for user")
+	}
+	if len(res.Entries) != 1 {
+		return nil, errInvalidCredentials
+	}
+	entry := res.Entries[0]
+
+	// 🚨 SECURITY: Verify the password by binding as the user.
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, errInvalidCredentials
+		}
+		return nil, errors.Wrap(err, "binding as the user")
+	}
+
+	return &userInfo{
+		DN:          entry.DN,
+		Username:    entry.GetEqualFoldAttributeValue(pc.UsernameAttribute),
+		Email:       entry.GetEqualFoldAttributeValue(pc.EmailAttribute),
+		DisplayName: entry.GetEqualFoldAttributeValue(pc.DisplayNameAttribute),
+		Groups:      entry.GetEqualFoldAttributeValues(pc.GroupMembershipAttribute),
+	}, nil
+}
+
+// dial connects to the LDAP server of pc, and upgrades the connection to TLS with StartTLS if
+// configured.
+func dial(pc *schema.LDAPAuthProvider) (*goldap.Conn, error) {
+	u, err := url.Parse(pc.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing LDAP server URL")
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: pc.InsecureSkipVerify,
+	}
+	conn, err := goldap.DialURL(pc.Url,
+		goldap.DialWithDialer(&net.Dialer{Timeout: dialTimeout}),
+		goldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to LDAP server")
+	}
+	conn.SetTimeout(requestTimeout)
+
+	if pc.StartTLS && u.Scheme == "ldap" {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "starting TLS")
+		}
+	}
+	return conn, nil
+}
@@ -0,0 +1,98 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	testServiceDN = "CN=sourcegraph,OU=Service Accounts,DC=example,DC=com"
+	testAliceDN   = "CN=Alice,OU=Users,DC=example,DC=com"
+	testGroupDN   = "CN=Engineering,OU=Groups,DC=example,DC=com"
+)
+
+func newTestDirectory(t *testing.T) *testServer {
+	return newTestServer(t,
+		map[string]map[string][]string{
+			testAliceDN: {
+				"sAMAccountName": {"alice"},
+				"mail":           {"alice@example.com"},
+				"displayName":    {"Alice Smith"},
+				"memberOf":       {testGroupDN},
+			},
+			"CN=Bob 1,OU=Users,DC=example,DC=com": {"sAMAccountName": {"bob"}},
+			"CN=Bob 2,OU=Users,DC=example,DC=com": {"sAMAccountName": {"bob"}},
+			"CN=Bob 3,OU=Users,DC=example,DC=com": {"sAMAccountName": {"bob"}},
+		},
+		map[string]string{
+			testServiceDN:                         "service-secret",
+			testAliceDN:                           "alice-secret",
+			"CN=Bob 1,OU=Users,DC=example,DC=com": "bob-secret",
+		},
+	)
+}
+
+func newTestProviderConfig(url string) *schema.LDAPAuthProvider {
+	return withConfigDefaults(&schema.LDAPAuthProvider{
+		Type:         providerType,
+		Url:          url,
+		BindDN:       testServiceDN,
+		BindPassword: "service-secret",
+		UserBaseDN:   "OU=Users,DC=example,DC=com",
+	})
+}
+
+func TestAuthenticate(t *testing.T) {
+	server := newTestDirectory(t)
+	pc := newTestProviderConfig(server.URL)
+
+	t.Run("valid credentials", func(t *testing.T) {
+		info, err := authenticate(pc, "alice", "alice-secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &userInfo{
+			DN:          testAliceDN,
+			Username:    "alice",
+			Email:       "alice@example.com",
+			DisplayName: "Alice Smith",
+			Groups:      []string{testGroupDN},
+		}
+		if diff := cmp.Diff(want, info); diff != "" {
+			t.Fatalf("unexpected user info (-want +got):\n%s", diff)
+		}
+
+		// The user is looked up as the service account, then the password is verified.
+		if diff := cmp.Diff([]string{testServiceDN, testAliceDN}, server.Binds()); diff != "" {
+			t.Fatalf("unexpected binds (-want +got):\n%s", diff)
+		}
+	})
+
+	for name, test := range map[string]struct {
+		username, password string
+	}{
+		"wrong password":     {"alice", "wrong"},
+		"empty password":     {"alice", ""},
+		"unknown user":       {"carol", "carol-secret"},
+		"ambiguous user":     {"bob", "bob-secret"},
+		"filter injection":   {"*)(sAMAccountName=alice", "alice-secret"},
+		"service account DN": {testServiceDN, "service-secret"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := authenticate(pc, test.username, test.password); err != errInvalidCredentials {
+				t.Fatalf("got error %v, want %v", err, errInvalidCredentials)
+			}
+		})
+	}
+
+	t.Run("wrong service account password", func(t *testing.T) {
+		pc := *pc
+		pc.BindPassword = "wrong"
+		if _, err := authenticate(&pc, "alice", "alice-secret"); err == nil || err == errInvalidCredentials {
+			t.Fatalf("got error %v, want a configuration error", err)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package ldap
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// usernamePlaceholder is replaced with the escaped username entered on the sign-in form in the
+// userFilter of the LDAP auth provider.
+const usernamePlaceholder = "{username}"
+
+// getProviderConfig returns the LDAP auth provider config with defaults applied. At most 1 can be
+// specified in site config; if there is more than 1, it returns multiple == true (which the caller
+// should handle by returning an error and refusing to proceed with auth).
+func getProviderConfig() (pc *schema.LDAPAuthProvider, multiple bool) {
+	for _, p := range conf.Get().AuthProviders {
+		if p.Ldap != nil {
+			if pc != nil {
+				return pc, true // multiple ldap auth providers
+			}
+			pc = withConfigDefaults(p.Ldap)
+		}
+	}
+	return pc, false
+}
+
+// withConfigDefaults returns a copy of pc with the defaults of the schema applied to the fields
+// that are not set.
+func withConfigDefaults(pc *schema.LDAPAuthProvider) *schema.LDAPAuthProvider {
+	c := *pc
+	if c.UserFilter == "" {
+		c.UserFilter = "(sAMAccountName=" + usernamePlaceholder + ")"
+	}
+	if c.UsernameAttribute == "" {
+		c.UsernameAttribute = "sAMAccountName"
+	}
+	if c.EmailAttribute == "" {
+		c.EmailAttribute = "mail"
+	}
+	if c.DisplayNameAttribute == "" {
+		c.DisplayNameAttribute = "displayName"
+	}
+	if c.GroupMembershipAttribute == "" {
+		c.GroupMembershipAttribute = "memberOf"
+	}
+	return &c
+}
+
+func init() {
+	conf.ContributeValidator(validateConfig)
+}
+
+func validateConfig(c conftypes.SiteConfigQuerier) (problems conf.Problems) {
+	var ldapAuthProviders int
+	for _, p := range c.SiteConfig().AuthProviders {
+		if p.Ldap == nil {
+			continue
+		}
+		ldapAuthProviders++
+
+		if p.Ldap.StartTLS && strings.HasPrefix(p.Ldap.Url, "ldaps://") {
+			problems = append(problems, conf.NewSiteProblem(`ldap auth provider: startTLS can't be used with an ldaps:// URL, which already uses TLS`))
+		}
+		if p.Ldap.UserFilter != "" && !strings.Contains(p.Ldap.UserFilter, usernamePlaceholder) {
+			problems = append(problems, conf.NewSiteProblem(`ldap auth provider: userFilter must contain the `+usernamePlaceholder+` placeholder`))
+		}
+		if p.Ldap.BindDN != "" && p.Ldap.BindPassword == "" {
+			problems = append(problems, conf.NewSiteProblem(`ldap auth provider: bindPassword must be set when bindDN is set`))
+		}
+	}
+	if ldapAuthProviders >= 2 {
+		problems = append(problems, conf.NewSiteProblem(`at most 1 ldap auth provider may be used`))
+	}
+	return problems
+}
@@ -0,0 +1,62 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestValidateCustom(t *testing.T) {
+	tests := map[string]struct {
+		input        conf.Unified
+		wantProblems conf.Problems
+	}{
+		"single": {
+			input: conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				AuthProviders: []schema.AuthProviders{
+					{Ldap: &schema.LDAPAuthProvider{Type: "ldap", Url: "ldap://ldap.example.com", StartTLS: true, UserFilter: "(uid={username})"}},
+				},
+			}},
+			wantProblems: nil,
+		},
+		"multiple": {
+			input: conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				AuthProviders: []schema.AuthProviders{
+					{Ldap: &schema.LDAPAuthProvider{Type: "ldap", Url: "ldap://a.example.com"}},
+					{Ldap: &schema.LDAPAuthProvider{Type: "ldap", Url: "ldap://b.example.com"}},
+				},
+			}},
+			wantProblems: conf.NewSiteProblems("at most 1"),
+		},
+		"startTLS with ldaps": {
+			input: conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				AuthProviders: []schema.AuthProviders{
+					{Ldap: &schema.LDAPAuthProvider{Type: "ldap", Url: "ldaps://ldap.example.com", StartTLS: true}},
+				},
+			}},
+			wantProblems: conf.NewSiteProblems("startTLS can't be used"),
+		},
+		"userFilter without placeholder": {
+			input: conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				AuthProviders: []schema.AuthProviders{
+					{Ldap: &schema.LDAPAuthProvider{Type: "ldap", Url: "ldap://ldap.example.com", UserFilter: "(uid=alice)"}},
+				},
+			}},
+			wantProblems: conf.NewSiteProblems("userFilter must contain"),
+		},
+		"bindDN without bindPassword": {
+			input: conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				AuthProviders: []schema.AuthProviders{
+					{Ldap: &schema.LDAPAuthProvider{Type: "ldap", Url: "ldap://ldap.example.com", BindDN: "CN=sourcegraph"}},
+				},
+			}},
+			wantProblems: conf.NewSiteProblems("bindPassword must be set"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			conf.TestValidator(t, test.input, validateConfig, test.wantProblems)
+		})
+	}
+}
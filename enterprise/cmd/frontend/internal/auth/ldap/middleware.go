@@ -0,0 +1,226 @@
+// Package ldap implements auth via LDAP directories, such as Active Directory.
+package ldap
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/external/session"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/globals"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+)
+
+// All LDAP endpoints are under this path prefix.
+const authPrefix = auth.AuthURLPrefix + "/ldap"
+
+// stateCookieName is the name of the cookie that holds the random value that the sign-in form
+// must echo back, which protects against login CSRF. The app's CSRF middleware runs after the auth
+// middlewares and doesn't protect this form.
+const stateCookieName = "sg-ldap-state"
+
+// Middleware is middleware for LDAP authentication, adding endpoints under the auth path prefix
+// ("/.auth") that serve a sign-in form and check the credentials entered into it against the LDAP
+// directory. API requests are not affected, since they authenticate with access tokens or the
+// session cookie that is created upon sign-in.
+//
+// 🚨 SECURITY
+func Middleware(db dbutil.DB) *auth.Middleware {
+	return &auth.Middleware{
+		API: func(next http.Handler) http.Handler {
+			return next
+		},
+		App: func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasPrefix(r.URL.Path, authPrefix+"/") {
+					authHandler(database.NewDB(db))(w, r)
+					return
+				}
+				next.ServeHTTP(w, r)
+			})
+		},
+	}
+}
+
+// authHandler serves the sign-in form on GET requests and signs in the user on POST requests.
+//
+// 🚨 SECURITY
+func authHandler(db database.DB) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimPrefix(r.URL.Path, authPrefix) != "/login" {
+			http.Error(w, "", http.StatusNotFound)
+			return
+		}
+
+		pc, multiple := getProviderConfig()
+		if multiple {
+			log15.Error("At most 1 LDAP auth provider may be set in site config.")
+			http.Error(w, "Misconfigured LDAP auth provider.", http.StatusInternalServerError)
+			return
+		}
+		if pc == nil {
+			http.Error(w, "No LDAP auth provider is configured.", http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			renderSignInForm(w, r.URL.Query().Get("redirect"), "", http.StatusOK)
+
+		case http.MethodPost:
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, "", http.StatusBadRequest)
+				return
+			}
+			redirect := r.PostForm.Get("redirect")
+
+			// 🚨 SECURITY: Check that the form was served by us to prevent login CSRF.
+			stateCookie, err := r.Cookie(stateCookieName)
+			if err != nil || stateCookie.Value == "" || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(r.PostForm.Get("state"))) != 1 {
+				renderSignInForm(w, redirect, "Your sign-in form expired. Try signing in again.", http.StatusBadRequest)
+				return
+			}
+
+			info, err := authenticate(pc, r.PostForm.Get("username"), r.PostForm.Get("password"))
+			if err == errInvalidCredentials {
+				renderSignInForm(w, redirect, "Invalid username or password.", http.StatusUnauthorized)
+				return
+			}
+			if err != nil {
+				log15.Error("Error authenticating user with LDAP.", "url", pc.Url, "err", err)
+				http.Error(w, "Error authenticating with the LDAP server. If the problem persists, a site admin must check the configuration.", http.StatusInternalServerError)
+				return
+			}
+
+			username := info.Username
+			if username == "" {
+				username = r.PostForm.Get("username")
+			}
+			username, err = auth.NormalizeUsername(username)
+			if err != nil {
+				log15.Error("Error normalizing username from LDAP.", "dn", info.DN, "err", err)
+				http.Error(w, "Unable to normalize username.", http.StatusInternalServerError)
+				return
+			}
+
+			var data extsvc.AccountData
+			data.SetAccountData(info)
+			userID, safeErrMsg, err := auth.GetAndSaveUser(r.Context(), db, auth.GetAndSaveUserOp{
+				UserProps: database.NewUser{
+					Username:        username,
+					Email:           info.Email,
+					EmailIsVerified: info.Email != "",
+					DisplayName:     info.DisplayName,
+				},
+				ExternalAccount: extsvc.AccountSpec{
+					ServiceType: providerType,
+					ServiceID:   pc.Url,
+					AccountID:   info.DN,
+				},
+				ExternalAccountData: data,
+				CreateIfNotExist:    pc.AllowSignup == nil || *pc.AllowSignup,
+			})
+			if err != nil {
+				log15.Error("Error looking up LDAP-authenticated user.", "dn", info.DN, "err", err, "userErr", safeErrMsg)
+				http.Error(w, safeErrMsg, http.StatusInternalServerError)
+				return
+			}
+
+			// Failing to sync organizations must not prevent the user from signing in.
+			if err := syncOrgs(r.Context(), db, pc, userID, info.Groups); err != nil {
+				log15.Error("Error syncing organizations of LDAP-authenticated user.", "userID", userID, "err", err)
+			}
+
+			user, err := db.Users().GetByID(r.Context(), userID)
+			if err != nil {
+				log15.Error("Error retrieving LDAP-authenticated user from database.", "error", err)
+				http.Error(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if err := session.SetActor(w, r, &actor.Actor{UID: userID}, 0, user.CreatedAt); err != nil {
+				log15.Error("Error setting LDAP-authenticated actor in session.", "err", err)
+				http.Error(w, "Error starting LDAP-authenticated session. Try signing in again.", http.StatusInternalServerError)
+				return
+			}
+
+			http.SetCookie(w, &http.Cookie{Name: stateCookieName, Path: authPrefix, MaxAge: -1})
+
+			// 🚨 SECURITY: Call auth.SafeRedirectURL to avoid an open-redirect vuln.
+			http.Redirect(w, r, auth.SafeRedirectURL(redirect), http.StatusFound)
+
+		default:
+			http.Error(w, "", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+var signInFormTemplate = template.Must(template.New("").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Sign in with {{.DisplayName}}</title></head>
+<body>
+<h1>Sign in with {{.DisplayName}}</h1>
+{{if .Error}}<p role="alert">{{.Error}}</p>{{end}}
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="state" value="{{.State}}">
+<input type="hidden" name="redirect" value="{{.Redirect}}">
+<p><label>Username <input type="text" name="username" autocomplete="username" required autofocus></label></p>
+<p><label>Password <input type="password" name="password" autocomplete="current-password" required></label></p>
+<p><button type="submit">Sign in</button></p>
+</form>
+</body>
+</html>
+`))
+
+// renderSignInForm writes the sign-in form with a new state value, which is also set as a cookie.
+func renderSignInForm(w http.ResponseWriter, redirect, errorMessage string, statusCode int) {
+	state, err := randomState()
+	if err != nil {
+		log15.Error("Error generating LDAP sign-in form state.", "err", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookieName,
+		Value:    state,
+		Path:     authPrefix,
+		HttpOnly: true,
+		Secure:   globals.ExternalURL().Scheme == "https",
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	displayName := "LDAP"
+	if pc, _ := getProviderConfig(); pc != nil && pc.DisplayName != "" {
+		displayName = pc.DisplayName
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if err := signInFormTemplate.Execute(w, map[string]string{
+		"DisplayName": displayName,
+		"Error":       errorMessage,
+		"Action":      authPrefix + "/login",
+		"State":       state,
+		"Redirect":    redirect,
+	}); err != nil {
+		log15.Error("Error rendering LDAP sign-in form.", "err", err)
+	}
+}
+
+// randomState returns a base64 encoded random 32 byte string.
+func randomState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "reading random bytes")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,139 @@
+package ldap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/external/session"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestMiddleware(t *testing.T) {
+	server := newTestDirectory(t)
+	pc := newTestProviderConfig(server.URL)
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{AuthProviders: []schema.AuthProviders{{Ldap: pc}}}})
+	defer conf.Mock(nil)
+
+	cleanup := session.ResetMockSessionStore(t)
+	defer cleanup()
+
+	const mockedUserID = 123
+	auth.MockGetAndSaveUser = func(ctx context.Context, op auth.GetAndSaveUserOp) (userID int32, safeErrMsg string, err error) {
+		if op.ExternalAccount.ServiceType == "ldap" && op.ExternalAccount.ServiceID == server.URL && op.ExternalAccount.AccountID == testAliceDN &&
+			op.UserProps.Username == "alice" && op.UserProps.Email == "alice@example.com" && op.CreateIfNotExist {
+			return mockedUserID, "", nil
+		}
+		return 0, "safeErr", errors.Errorf("account %v not found in mock", op.ExternalAccount)
+	}
+	defer func() { auth.MockGetAndSaveUser = nil }()
+
+	database.Mocks.Users.GetByID = func(ctx context.Context, id int32) (*types.User, error) {
+		return &types.User{ID: id, CreatedAt: time.Now()}, nil
+	}
+	defer func() { database.Mocks = database.MockStores{} }()
+
+	handler := Middleware(nil).App(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	stateRegexp := regexp.MustCompile(`name="state" value="([^"]+)"`)
+	getForm := func(t *testing.T) (state string, cookie *http.Cookie) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/.auth/ldap/login?redirect=/search", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+		}
+		m := stateRegexp.FindStringSubmatch(rec.Body.String())
+		if m == nil {
+			t.Fatalf("no state in form: %s", rec.Body.String())
+		}
+		for _, c := range rec.Result().Cookies() {
+			if c.Name == stateCookieName {
+				cookie = c
+			}
+		}
+		if cookie == nil || cookie.Value != m[1] {
+			t.Fatalf("state cookie %v doesn't match form state %q", cookie, m[1])
+		}
+		return m[1], cookie
+	}
+	postForm := func(state string, cookie *http.Cookie, username, password string) *httptest.ResponseRecorder {
+		form := url.Values{"state": {state}, "redirect": {"/search"}, "username": {username}, "password": {password}}
+		req := httptest.NewRequest("POST", "/.auth/ldap/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("other paths", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/search", nil))
+		if rec.Code != http.StatusTeapot {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusTeapot)
+		}
+	})
+
+	t.Run("sign in", func(t *testing.T) {
+		state, cookie := getForm(t)
+		rec := postForm(state, cookie, "alice", "alice-secret")
+		if rec.Code != http.StatusFound {
+			t.Fatalf("got status %d, want %d: %s", rec.Code, http.StatusFound, rec.Body.String())
+		}
+		if got, want := rec.Header().Get("Location"), "/search"; got != want {
+			t.Errorf("got redirect %q, want %q", got, want)
+		}
+		var hasSession bool
+		for _, c := range rec.Result().Cookies() {
+			if c.Name == "sgs" && c.Value != "" {
+				hasSession = true
+			}
+		}
+		if !hasSession {
+			t.Error("no session cookie was set")
+		}
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		state, cookie := getForm(t)
+		rec := postForm(state, cookie, "alice", "wrong")
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusUnauthorized)
+		}
+		if !strings.Contains(rec.Body.String(), "Invalid username or password.") {
+			t.Errorf("form doesn't show error: %s", rec.Body.String())
+		}
+	})
+
+	t.Run("missing state cookie", func(t *testing.T) {
+		state, _ := getForm(t)
+		rec := postForm(state, nil, "alice", "alice-secret")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("mismatched state", func(t *testing.T) {
+		_, cookie := getForm(t)
+		rec := postForm("forged", cookie, "alice", "alice-secret")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+}
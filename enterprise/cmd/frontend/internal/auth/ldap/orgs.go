@@ -0,0 +1,69 @@
+package ldap
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// syncOrgs adds the user to the organizations that groupsToOrgs of pc maps any of the given
+// groups to. It never removes the user from organizations, because memberships may also be
+// managed in Sourcegraph. Organizations that don't exist are skipped.
+func syncOrgs(ctx context.Context, db database.DB, pc *schema.LDAPAuthProvider, userID int32, groups []string) error {
+	for _, name := range orgsForGroups(pc.GroupsToOrgs, groups) {
+		org, err := db.Orgs().GetByName(ctx, name)
+		if errcode.IsNotFound(err) {
+			log15.Warn("Organization of LDAP group does not exist.", "org", name)
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "getting organization %q", name)
+		}
+
+		_, err = db.OrgMembers().GetByOrgIDAndUserID(ctx, org.ID, userID)
+		if err == nil {
+			continue // already a member
+		}
+		if !errcode.IsNotFound(err) {
+			return errors.Wrapf(err, "getting membership of organization %q", name)
+		}
+		if _, err := db.OrgMembers().Create(ctx, org.ID, userID); err != nil {
+			return errors.Wrapf(err, "adding user to organization %q", name)
+		}
+	}
+	return nil
+}
+
+// orgsForGroups returns the sorted, deduplicated names of the organizations that groupsToOrgs maps
+// the groups to. Group DNs are compared case-insensitively, like LDAP servers do.
+func orgsForGroups(groupsToOrgs map[string][]string, groups []string) []string {
+	if len(groupsToOrgs) == 0 {
+		return nil
+	}
+
+	set := map[string]struct{}{}
+	for group, orgs := range groupsToOrgs {
+		for _, g := range groups {
+			if strings.EqualFold(group, g) {
+				for _, org := range orgs {
+					set[org] = struct{}{}
+				}
+				break
+			}
+		}
+	}
+
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
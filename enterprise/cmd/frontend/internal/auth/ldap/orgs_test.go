@@ -0,0 +1,61 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmock"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestSyncOrgs(t *testing.T) {
+	orgs := map[string]*types.Org{
+		"engineering": {ID: 1, Name: "engineering"},
+		"everyone":    {ID: 2, Name: "everyone"},
+	}
+
+	orgStore := dbmock.NewMockOrgStore()
+	orgStore.GetByNameFunc.SetDefaultHook(func(_ context.Context, name string) (*types.Org, error) {
+		if org, ok := orgs[name]; ok {
+			return org, nil
+		}
+		return nil, &database.OrgNotFoundError{Message: name}
+	})
+
+	orgMemberStore := dbmock.NewMockOrgMemberStore()
+	orgMemberStore.GetByOrgIDAndUserIDFunc.SetDefaultHook(func(_ context.Context, orgID, userID int32) (*types.OrgMembership, error) {
+		if orgID == 2 {
+			return &types.OrgMembership{OrgID: orgID, UserID: userID}, nil
+		}
+		return nil, &database.ErrOrgMemberNotFound{}
+	})
+
+	db := dbmock.NewMockDB()
+	db.OrgsFunc.SetDefaultReturn(orgStore)
+	db.OrgMembersFunc.SetDefaultReturn(orgMemberStore)
+
+	pc := &schema.LDAPAuthProvider{GroupsToOrgs: map[string][]string{
+		"cn=engineering,ou=groups,dc=example,dc=com": {"engineering", "everyone", "missing"},
+		"CN=Sales,OU=Groups,DC=example,DC=com":       {"sales"},
+	}}
+	if err := syncOrgs(context.Background(), db, pc, 42, []string{testGroupDN}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the membership in the existing organization that the user isn't a member of yet is
+	// created.
+	var created []int32
+	for _, call := range orgMemberStore.CreateFunc.History() {
+		if call.Arg2 != 42 {
+			t.Fatalf("unexpected user ID %d", call.Arg2)
+		}
+		created = append(created, call.Arg1)
+	}
+	if diff := cmp.Diff([]int32{1}, created); diff != "" {
+		t.Fatalf("unexpected created memberships (-want +got):\n%s", diff)
+	}
+}
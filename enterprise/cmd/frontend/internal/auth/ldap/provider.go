@@ -0,0 +1,38 @@
+package ldap
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth/providers"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const providerType = "ldap"
+
+type provider struct {
+	config *schema.LDAPAuthProvider
+}
+
+// ConfigID implements providers.Provider.
+func (p *provider) ConfigID() providers.ConfigID {
+	return providers.ConfigID{Type: providerType, ID: p.config.Url}
+}
+
+// Config implements providers.Provider.
+func (p *provider) Config() schema.AuthProviders { return schema.AuthProviders{Ldap: p.config} }
+
+// Refresh implements providers.Provider.
+func (p *provider) Refresh(context.Context) error { return nil }
+
+// CachedInfo implements providers.Provider.
+func (p *provider) CachedInfo() *providers.Info {
+	info := &providers.Info{
+		DisplayName:       p.config.DisplayName,
+		ServiceID:         p.config.Url,
+		AuthenticationURL: authPrefix + "/login",
+	}
+	if info.DisplayName == "" {
+		info.DisplayName = "LDAP"
+	}
+	return info
+}
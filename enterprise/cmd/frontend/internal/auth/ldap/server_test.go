@@ -0,0 +1,170 @@
+package ldap
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// testServer is an in-process stand-in for an LDAP server. It supports simple binds and searches
+// with equality and "and" filters, which is all that authenticate uses.
+type testServer struct {
+	// URL is the ldap:// URL of the server.
+	URL string
+
+	// entries maps the DNs of entries to their attributes.
+	entries map[string]map[string][]string
+	// passwords maps DNs to the passwords that they can bind with.
+	passwords map[string]string
+
+	mu    sync.Mutex
+	binds []string // DNs of successful binds, in order
+}
+
+func newTestServer(t *testing.T, entries map[string]map[string][]string, passwords map[string]string) *testServer {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s := &testServer{URL: "ldap://" + l.Addr().String(), entries: entries, passwords: passwords}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *testServer) Binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+func (s *testServer) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			dn := op.Children[1].Value.(string)
+			password := op.Children[2].Data.String()
+			code := goldap.LDAPResultInvalidCredentials
+			if want, ok := s.passwords[dn]; ok && password != "" && password == want {
+				code = goldap.LDAPResultSuccess
+				s.mu.Lock()
+				s.binds = append(s.binds, dn)
+				s.mu.Unlock()
+			}
+			s.write(conn, messageID, goldap.ApplicationBindResponse, result(code)...)
+
+		case goldap.ApplicationSearchRequest:
+			baseDN := op.Children[0].Value.(string)
+			sizeLimit := int(op.Children[3].Value.(int64))
+			filter := op.Children[6]
+
+			var matches []string
+			for dn, attrs := range s.entries {
+				if strings.HasSuffix(strings.ToLower(dn), strings.ToLower(baseDN)) && matchFilter(filter, attrs) {
+					matches = append(matches, dn)
+				}
+			}
+			if sizeLimit > 0 && len(matches) > sizeLimit {
+				s.write(conn, messageID, goldap.ApplicationSearchResultDone, result(goldap.LDAPResultSizeLimitExceeded)...)
+				continue
+			}
+			for _, dn := range matches {
+				s.write(conn, messageID, goldap.ApplicationSearchResultEntry, entry(dn, s.entries[dn])...)
+			}
+			s.write(conn, messageID, goldap.ApplicationSearchResultDone, result(goldap.LDAPResultSuccess)...)
+
+		case goldap.ApplicationUnbindRequest:
+			return
+
+		default:
+			s.write(conn, messageID, goldap.ApplicationExtendedResponse, result(goldap.LDAPResultUnwillingToPerform)...)
+		}
+	}
+}
+
+func (s *testServer) write(conn net.Conn, messageID int64, tag ber.Tag, children ...*ber.Packet) {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	for _, child := range children {
+		op.AppendChild(child)
+	}
+	packet.AppendChild(op)
+	_, _ = conn.Write(packet.Bytes())
+}
+
+func result(code int) []*ber.Packet {
+	return []*ber.Packet{
+		ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "resultCode"),
+		ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"),
+		ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"),
+	}
+}
+
+func entry(dn string, attrs map[string][]string) []*ber.Packet {
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for name, values := range attrs {
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+		}
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+	return []*ber.Packet{
+		ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, dn, "objectName"),
+		attributes,
+	}
+}
+
+func matchFilter(filter *ber.Packet, attrs map[string][]string) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matchFilter(child, attrs) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterEqualityMatch:
+		name, value := filter.Children[0].Value.(string), filter.Children[1].Value.(string)
+		for attr, values := range attrs {
+			if !strings.EqualFold(attr, name) {
+				continue
+			}
+			for _, v := range values {
+				if strings.EqualFold(v, value) {
+					return true
+				}
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
@@ -71,8 +71,9 @@ func Init(ctx context.Context, db dbutil.DB, _ conftypes.UnifiedWatchable, outOf
 			return nil
 		}
 
-		// We currently support three types of authz providers: GitHub, GitLab and Bitbucket Server.
-		authzTypes := make(map[string]struct{}, 3)
+		// We currently support four types of authz providers: GitHub, GitLab, Bitbucket Server and
+		// Bitbucket Cloud.
+		authzTypes := make(map[string]struct{}, 4)
 		for _, p := range providers {
 			authzTypes[p.ServiceType()] = struct{}{}
 		}
@@ -86,6 +87,8 @@ func Init(ctx context.Context, db dbutil.DB, _ conftypes.UnifiedWatchable, outOf
 				authzNames = append(authzNames, "GitLab")
 			case extsvc.TypeBitbucketServer:
 				authzNames = append(authzNames, "Bitbucket Server")
+			case extsvc.TypeBitbucketCloud:
+				authzNames = append(authzNames, "Bitbucket Cloud")
 			default:
 				authzNames = append(authzNames, t)
 			}
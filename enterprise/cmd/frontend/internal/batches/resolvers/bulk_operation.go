@@ -121,6 +121,10 @@ func changesetJobTypeToBulkOperationType(t btypes.ChangesetJobType) (string, err
 		return "CLOSE", nil
 	case btypes.ChangesetJobTypePublish:
 		return "PUBLISH", nil
+	case btypes.ChangesetJobTypeRequestReviewers:
+		return "REQUEST_REVIEWERS", nil
+	case btypes.ChangesetJobTypeAddLabels:
+		return "ADD_LABELS", nil
 	default:
 		return "", errors.Errorf("invalid job type %q", t)
 	}
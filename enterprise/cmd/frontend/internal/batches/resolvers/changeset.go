@@ -383,6 +383,15 @@ func (r *changesetResolver) Error() *string { return r.changeset.FailureMessage
 
 func (r *changesetResolver) SyncerError() *string { return r.changeset.SyncErrorMessage }
 
+func (r *changesetResolver) AutoMergeWaitReason() *string {
+	if !r.changeset.Published() || r.changeset.SyncState.AutoMergeWaitReason == btypes.ChangesetAutoMergeWaitReasonNone {
+		return nil
+	}
+
+	reason := string(r.changeset.SyncState.AutoMergeWaitReason)
+	return &reason
+}
+
 func (r *changesetResolver) ScheduleEstimateAt(ctx context.Context) (*graphqlbackend.DateTime, error) {
 	// We need to find out how deep in the queue this changeset is.
 	place, err := r.store.GetChangesetPlaceInSchedulerQueue(ctx, r.changeset.ID)
@@ -29,6 +29,15 @@ func (c *batchChangesCodeHostResolver) RequiresSSH() bool {
 	return c.codeHost.RequiresSSH
 }
 
+func (c *batchChangesCodeHostResolver) RequiresUsername() bool {
+	switch c.codeHost.ExternalServiceType {
+	case extsvc.TypeBitbucketCloud, extsvc.TypeAWSCodeCommit:
+		return true
+	default:
+		return false
+	}
+}
+
 func (c *batchChangesCodeHostResolver) HasWebhooks() bool {
 	return c.codeHost.HasWebhooks
 }
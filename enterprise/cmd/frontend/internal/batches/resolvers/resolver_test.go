@@ -1808,4 +1808,228 @@ mutation($batchChange: ID!, $changesets: [ID!]!, $draft: Boolean!) {
 }
 `
 
+func TestRequestReviewersOnChangesets(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	db := dbtest.NewDB(t)
+	cstore := store.New(db, &observation.TestContext, nil)
+
+	userID := ct.CreateTestUser(t, db, true).ID
+	batchSpec := ct.CreateBatchSpec(t, ctx, cstore, "test-request-reviewers", userID)
+	otherBatchSpec := ct.CreateBatchSpec(t, ctx, cstore, "test-request-reviewers-other", userID)
+	batchChange := ct.CreateBatchChange(t, ctx, cstore, "test-request-reviewers", userID, batchSpec.ID)
+	otherBatchChange := ct.CreateBatchChange(t, ctx, cstore, "test-request-reviewers-other", userID, otherBatchSpec.ID)
+	repo, _ := ct.CreateTestRepo(t, ctx, db)
+	changeset := ct.CreateChangeset(t, ctx, cstore, ct.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      batchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ReconcilerState:  btypes.ReconcilerStateCompleted,
+		ExternalState:    btypes.ChangesetExternalStateOpen,
+	})
+	otherChangeset := ct.CreateChangeset(t, ctx, cstore, ct.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      otherBatchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ReconcilerState:  btypes.ReconcilerStateCompleted,
+		ExternalState:    btypes.ChangesetExternalStateOpen,
+	})
+	mergedChangeset := ct.CreateChangeset(t, ctx, cstore, ct.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      batchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ReconcilerState:  btypes.ReconcilerStateCompleted,
+		ExternalState:    btypes.ChangesetExternalStateMerged,
+	})
+
+	r := &Resolver{store: cstore}
+	s, err := graphqlbackend.NewSchema(database.NewDB(db), r, nil, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generateInput := func() map[string]interface{} {
+		return map[string]interface{}{
+			"batchChange":   marshalBatchChangeID(batchChange.ID),
+			"changesets":    []string{string(marshalChangesetID(changeset.ID))},
+			"reviewers":     []string{"alice"},
+			"teamReviewers": []string{"sourcegraph/batchers"},
+		}
+	}
+
+	var response struct {
+		RequestReviewersOnChangesets apitest.BulkOperation
+	}
+	actorCtx := actor.WithActor(ctx, actor.FromUser(userID))
+
+	t.Run("no reviewers fails", func(t *testing.T) {
+		input := generateInput()
+		input["reviewers"] = []string{}
+		input["teamReviewers"] = []string{}
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationRequestReviewersOnChangesets)
+
+		if len(errs) != 1 {
+			t.Fatalf("expected single errors, but got none")
+		}
+		if have, want := errs[0].Message, "no reviewers specified"; have != want {
+			t.Fatalf("wrong error. want=%q, have=%q", want, have)
+		}
+	})
+
+	t.Run("changeset in different batch change fails", func(t *testing.T) {
+		input := generateInput()
+		input["changesets"] = []string{string(marshalChangesetID(otherChangeset.ID))}
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationRequestReviewersOnChangesets)
+
+		if len(errs) != 1 {
+			t.Fatalf("expected single errors, but got none")
+		}
+		if have, want := errs[0].Message, "some changesets could not be found"; have != want {
+			t.Fatalf("wrong error. want=%q, have=%q", want, have)
+		}
+	})
+
+	t.Run("merged changeset fails", func(t *testing.T) {
+		input := generateInput()
+		input["changesets"] = []string{string(marshalChangesetID(mergedChangeset.ID))}
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationRequestReviewersOnChangesets)
+
+		if len(errs) != 1 {
+			t.Fatalf("expected single errors, but got none")
+		}
+		if have, want := errs[0].Message, "some changesets could not be found"; have != want {
+			t.Fatalf("wrong error. want=%q, have=%q", want, have)
+		}
+	})
+
+	t.Run("runs successfully", func(t *testing.T) {
+		input := generateInput()
+		apitest.MustExec(actorCtx, t, s, input, &response, mutationRequestReviewersOnChangesets)
+
+		if response.RequestReviewersOnChangesets.ID == "" {
+			t.Fatalf("expected bulk operation to be created, but was not")
+		}
+	})
+}
+
+const mutationRequestReviewersOnChangesets = `
+mutation($batchChange: ID!, $changesets: [ID!]!, $reviewers: [String!]!, $teamReviewers: [String!]) {
+    requestReviewersOnChangesets(batchChange: $batchChange, changesets: $changesets, reviewers: $reviewers, teamReviewers: $teamReviewers) { id }
+}
+`
+
+func TestAddLabelsToChangesets(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	db := dbtest.NewDB(t)
+	cstore := store.New(db, &observation.TestContext, nil)
+
+	userID := ct.CreateTestUser(t, db, true).ID
+	batchSpec := ct.CreateBatchSpec(t, ctx, cstore, "test-add-labels", userID)
+	otherBatchSpec := ct.CreateBatchSpec(t, ctx, cstore, "test-add-labels-other", userID)
+	batchChange := ct.CreateBatchChange(t, ctx, cstore, "test-add-labels", userID, batchSpec.ID)
+	otherBatchChange := ct.CreateBatchChange(t, ctx, cstore, "test-add-labels-other", userID, otherBatchSpec.ID)
+	repo, _ := ct.CreateTestRepo(t, ctx, db)
+	changeset := ct.CreateChangeset(t, ctx, cstore, ct.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      batchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ReconcilerState:  btypes.ReconcilerStateCompleted,
+		ExternalState:    btypes.ChangesetExternalStateOpen,
+	})
+	otherChangeset := ct.CreateChangeset(t, ctx, cstore, ct.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      otherBatchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ReconcilerState:  btypes.ReconcilerStateCompleted,
+		ExternalState:    btypes.ChangesetExternalStateOpen,
+	})
+	mergedChangeset := ct.CreateChangeset(t, ctx, cstore, ct.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      batchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ReconcilerState:  btypes.ReconcilerStateCompleted,
+		ExternalState:    btypes.ChangesetExternalStateMerged,
+	})
+
+	r := &Resolver{store: cstore}
+	s, err := graphqlbackend.NewSchema(database.NewDB(db), r, nil, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generateInput := func() map[string]interface{} {
+		return map[string]interface{}{
+			"batchChange": marshalBatchChangeID(batchChange.ID),
+			"changesets":  []string{string(marshalChangesetID(changeset.ID))},
+			"labels":      []string{"batch-change"},
+		}
+	}
+
+	var response struct {
+		AddLabelsToChangesets apitest.BulkOperation
+	}
+	actorCtx := actor.WithActor(ctx, actor.FromUser(userID))
+
+	t.Run("no labels fails", func(t *testing.T) {
+		input := generateInput()
+		input["labels"] = []string{}
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationAddLabelsToChangesets)
+
+		if len(errs) != 1 {
+			t.Fatalf("expected single errors, but got none")
+		}
+		if have, want := errs[0].Message, "no labels specified"; have != want {
+			t.Fatalf("wrong error. want=%q, have=%q", want, have)
+		}
+	})
+
+	t.Run("changeset in different batch change fails", func(t *testing.T) {
+		input := generateInput()
+		input["changesets"] = []string{string(marshalChangesetID(otherChangeset.ID))}
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationAddLabelsToChangesets)
+
+		if len(errs) != 1 {
+			t.Fatalf("expected single errors, but got none")
+		}
+		if have, want := errs[0].Message, "some changesets could not be found"; have != want {
+			t.Fatalf("wrong error. want=%q, have=%q", want, have)
+		}
+	})
+
+	t.Run("merged changeset fails", func(t *testing.T) {
+		input := generateInput()
+		input["changesets"] = []string{string(marshalChangesetID(mergedChangeset.ID))}
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationAddLabelsToChangesets)
+
+		if len(errs) != 1 {
+			t.Fatalf("expected single errors, but got none")
+		}
+		if have, want := errs[0].Message, "some changesets could not be found"; have != want {
+			t.Fatalf("wrong error. want=%q, have=%q", want, have)
+		}
+	})
+
+	t.Run("runs successfully", func(t *testing.T) {
+		input := generateInput()
+		apitest.MustExec(actorCtx, t, s, input, &response, mutationAddLabelsToChangesets)
+
+		if response.AddLabelsToChangesets.ID == "" {
+			t.Fatalf("expected bulk operation to be created, but was not")
+		}
+	})
+}
+
+const mutationAddLabelsToChangesets = `
+mutation($batchChange: ID!, $changesets: [ID!]!, $labels: [String!]!) {
+    addLabelsToChangesets(batchChange: $batchChange, changesets: $changesets, labels: $labels) { id }
+}
+`
+
 func stringPtr(s string) *string { return &s }
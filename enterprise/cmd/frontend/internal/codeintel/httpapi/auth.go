@@ -7,9 +7,13 @@ import (
 
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 func isSiteAdmin(ctx context.Context, db dbutil.DB) bool {
@@ -32,7 +36,16 @@ var DefaultValidatorByCodeHost = map[string]func(context.Context, *http.Request,
 
 type AuthValidatorMap = map[string]func(context.Context, *http.Request, string) (int, error)
 
-func enforceAuth(ctx context.Context, w http.ResponseWriter, r *http.Request, repoName string, validators AuthValidatorMap) bool {
+// DefaultValidatorByServiceType maps external service types to validators for repositories on
+// self-hosted code hosts, whose names cannot be matched by a fixed code host prefix.
+var DefaultValidatorByServiceType = RepoAuthValidatorMap{
+	extsvc.TypeGitLab:          enforceAuthViaGitLab,
+	extsvc.TypeBitbucketServer: enforceAuthViaBitbucketServer,
+}
+
+type RepoAuthValidatorMap = map[string]func(context.Context, *http.Request, *types.Repo) (int, error)
+
+func enforceAuth(ctx context.Context, w http.ResponseWriter, r *http.Request, db dbutil.DB, repoName string, validators AuthValidatorMap, repoValidators RepoAuthValidatorMap) bool {
 	for codeHost, validator := range validators {
 		if !strings.HasPrefix(repoName, codeHost) {
 			continue
@@ -46,6 +59,29 @@ func enforceAuth(ctx context.Context, w http.ResponseWriter, r *http.Request, re
 		return true
 	}
 
+	if len(repoValidators) > 0 {
+		// 🚨 SECURITY: We look up the repository as an internal actor as the uploader does not
+		// necessarily have a Sourcegraph account with access to it. Whether the repository exists
+		// is not revealed: unknown repositories fall through to the unsupported code host error.
+		repo, err := database.Repos(db).GetByName(actor.WithInternalActor(ctx), api.RepoName(repoName))
+		if err != nil && !errcode.IsNotFound(err) {
+			log15.Error("precise-code-intel proxy: failed to get repository", "error", err)
+			http.Error(w, "failed to get repository", http.StatusInternalServerError)
+			return false
+		}
+
+		if repo != nil {
+			if validator, ok := repoValidators[repo.ExternalRepo.ServiceType]; ok {
+				if status, err := validator(ctx, r, repo); err != nil {
+					http.Error(w, err.Error(), status)
+					return false
+				}
+
+				return true
+			}
+		}
+	}
+
 	http.Error(w, "verification not supported for code host - see https://github.com/sourcegraph/sourcegraph/issues/4967", http.StatusUnprocessableEntity)
 	return false
 }
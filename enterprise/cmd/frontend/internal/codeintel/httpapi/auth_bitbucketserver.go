@@ -0,0 +1,80 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+var (
+	ErrBitbucketServerMissingToken = errors.New("must provide bitbucket_server_token")
+	ErrBitbucketServerUnauthorized = errors.New("you do not have write permission to this Bitbucket Server repository")
+)
+
+func enforceAuthViaBitbucketServer(ctx context.Context, r *http.Request, repo *types.Repo) (int, error) {
+	bitbucketServerToken := r.URL.Query().Get("bitbucket_server_token")
+	if bitbucketServerToken == "" {
+		return http.StatusUnauthorized, ErrBitbucketServerMissingToken
+	}
+
+	bitbucketServerRepo, ok := repo.Metadata.(*bitbucketserver.Repo)
+	if !ok {
+		return http.StatusInternalServerError, errors.Errorf("invalid Bitbucket Server repository metadata: %T", repo.Metadata)
+	}
+
+	client, err := bitbucketserver.NewClient(&schema.BitbucketServerConnection{Url: repo.ExternalRepo.ServiceID}, nil)
+	if err != nil {
+		return http.StatusInternalServerError, errors.Wrap(err, "invalid Bitbucket Server URL")
+	}
+	client = client.WithAuthenticator(&auth.OAuthBearerToken{Token: bitbucketServerToken})
+
+	if author, err := checkBitbucketServerPermissions(ctx, bitbucketServerRepo, client); err != nil {
+		return http.StatusInternalServerError, err
+	} else if !author {
+		return http.StatusUnauthorized, ErrBitbucketServerUnauthorized
+	}
+
+	return 0, nil
+}
+
+// checkBitbucketServerPermissions returns true when the given repository is among the repositories
+// to which the user (or repository or project access token) initiating the current upload request
+// has write permission.
+func checkBitbucketServerPermissions(ctx context.Context, repo *bitbucketserver.Repo, client BitbucketServerClient) (bool, error) {
+	query := url.Values{
+		"name":       []string{repo.Name},
+		"permission": []string{string(bitbucketserver.PermRepoWrite)},
+	}
+	if repo.Project != nil {
+		query.Set("projectname", repo.Project.Name)
+	}
+
+	var pageToken *bitbucketserver.PageToken
+	for pageToken.HasMore() {
+		repos, next, err := client.Repos(ctx, pageToken, query.Encode())
+		if err != nil {
+			if bitbucketserver.IsUnauthorized(err) {
+				return false, nil
+			}
+
+			return false, errors.Wrap(err, "bitbucketServerClient.Repos")
+		}
+
+		for _, r := range repos {
+			if r.ID == repo.ID {
+				return true, nil
+			}
+		}
+
+		pageToken = next
+	}
+
+	return false, nil
+}
@@ -0,0 +1,106 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+)
+
+func TestCheckBitbucketServerPermissions(t *testing.T) {
+	type testCase struct {
+		description    string
+		expectedAuthor bool
+		expectedErr    error
+		reposHook      func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error)
+	}
+
+	testErr := fmt.Errorf("uh-oh")
+
+	reposHookMatchingRepository := func(ctx context.Context, pageToken *bitbucketserver.PageToken, searchQueries ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+		return []*bitbucketserver.Repo{{ID: 41}, {ID: 42}}, &bitbucketserver.PageToken{IsLastPage: true}, nil
+	}
+
+	reposHookMatchingRepositoryOnSecondPage := func(ctx context.Context, pageToken *bitbucketserver.PageToken, searchQueries ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+		if pageToken == nil {
+			return []*bitbucketserver.Repo{{ID: 41}}, &bitbucketserver.PageToken{NextPageStart: 1}, nil
+		}
+		return []*bitbucketserver.Repo{{ID: 42}}, &bitbucketserver.PageToken{IsLastPage: true}, nil
+	}
+
+	reposHookNonMatchingRepository := func(ctx context.Context, pageToken *bitbucketserver.PageToken, searchQueries ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+		return []*bitbucketserver.Repo{{ID: 41}}, &bitbucketserver.PageToken{IsLastPage: true}, nil
+	}
+
+	reposHookUnauthorized := func(ctx context.Context, pageToken *bitbucketserver.PageToken, searchQueries ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+		return nil, nil, unauthorizedError{}
+	}
+
+	reposHookError := func(ctx context.Context, pageToken *bitbucketserver.PageToken, searchQueries ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+		return nil, nil, testErr
+	}
+
+	testCases := []testCase{
+		{
+			description:    "writable repo",
+			expectedAuthor: true,
+			reposHook:      reposHookMatchingRepository,
+		},
+		{
+			description:    "writable repo on second page",
+			expectedAuthor: true,
+			reposHook:      reposHookMatchingRepositoryOnSecondPage,
+		},
+		{
+			description:    "non-writable repo",
+			expectedAuthor: false,
+			reposHook:      reposHookNonMatchingRepository,
+		},
+		{
+			description:    "invalid token",
+			expectedAuthor: false,
+			reposHook:      reposHookUnauthorized,
+		},
+		{
+			description:    "unexpected Repos error",
+			expectedAuthor: false,
+			expectedErr:    errors.Wrap(testErr, "bitbucketServerClient.Repos"),
+			reposHook:      reposHookError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			client := NewMockBitbucketServerClient()
+			client.ReposFunc.SetDefaultHook(testCase.reposHook)
+
+			repo := &bitbucketserver.Repo{ID: 42, Slug: "sourcegraph", Name: "Sourcegraph", Project: &bitbucketserver.Project{Key: "SG", Name: "Sourcegraph Project"}}
+			author, err := checkBitbucketServerPermissions(context.Background(), repo, client)
+			if author != testCase.expectedAuthor {
+				t.Errorf("unexpected status. want=%v have=%v", testCase.expectedAuthor, author)
+			}
+			if ((err == nil) != (testCase.expectedErr == nil)) || (err != nil && testCase.expectedErr != nil && err.Error() != testCase.expectedErr.Error()) {
+				t.Errorf("unexpected error. want=%s have=%s", testCase.expectedErr, err)
+			}
+
+			for _, call := range client.ReposFunc.History() {
+				query, err := url.ParseQuery(call.Arg2[0])
+				if err != nil {
+					t.Fatalf("unexpected error parsing query: %s", err)
+				}
+				if want := (url.Values{"name": {"Sourcegraph"}, "projectname": {"Sourcegraph Project"}, "permission": {"REPO_WRITE"}}); query.Encode() != want.Encode() {
+					t.Errorf("unexpected query. want=%s have=%s", want.Encode(), query.Encode())
+				}
+			}
+		})
+	}
+}
+
+type unauthorizedError struct{}
+
+func (unauthorizedError) Error() string      { return "unauthorized" }
+func (unauthorizedError) Unauthorized() bool { return true }
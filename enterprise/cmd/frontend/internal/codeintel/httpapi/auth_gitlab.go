@@ -0,0 +1,95 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+var (
+	ErrGitLabMissingToken = errors.New("must provide gitlab_token")
+	ErrGitLabUnauthorized = errors.New("you do not have write permission to this GitLab project")
+)
+
+func enforceAuthViaGitLab(ctx context.Context, r *http.Request, repo *types.Repo) (int, error) {
+	gitlabToken := r.URL.Query().Get("gitlab_token")
+	if gitlabToken == "" {
+		return http.StatusUnauthorized, ErrGitLabMissingToken
+	}
+
+	project, ok := repo.Metadata.(*gitlab.Project)
+	if !ok {
+		return http.StatusInternalServerError, errors.Errorf("invalid GitLab repository metadata: %T", repo.Metadata)
+	}
+
+	baseURL, err := url.Parse(repo.ExternalRepo.ServiceID)
+	if err != nil {
+		return http.StatusInternalServerError, errors.Wrap(err, "invalid GitLab URL")
+	}
+
+	provider := gitlab.NewClientProvider(baseURL, nil)
+	jobTokenClient := provider.GetAuthenticatorClient(&gitlab.JobToken{Token: gitlabToken})
+	personalAccessTokenClient := provider.GetPATClient(gitlabToken, "")
+
+	if author, err := checkGitLabPermissions(ctx, project.ID, jobTokenClient, personalAccessTokenClient); err != nil {
+		return http.StatusInternalServerError, err
+	} else if !author {
+		return http.StatusUnauthorized, ErrGitLabUnauthorized
+	}
+
+	return 0, nil
+}
+
+// checkGitLabPermissions returns true if the token used by the given clients is either a CI job
+// token issued for a job of the given project, or a personal access token of a user that can push
+// to the given project. The same token is supplied to both clients, which only differ in the way
+// they present it to GitLab.
+func checkGitLabPermissions(ctx context.Context, projectID int, jobTokenClient, personalAccessTokenClient GitLabClient) (bool, error) {
+	if author, wrongTokenType, err := checkGitLabJobTokenPermissions(ctx, projectID, jobTokenClient); !wrongTokenType {
+		return author, err
+	}
+
+	return checkGitLabUserProjectPermissions(ctx, projectID, personalAccessTokenClient)
+}
+
+// checkGitLabJobTokenPermissions attempts to use the given client as if it's authorized with a CI
+// job token. If GitLab does not accept the token as a job token, then wrongTokenType will be true.
+// Otherwise, we check if the job the token was issued for runs in a pipeline of the given project.
+func checkGitLabJobTokenPermissions(ctx context.Context, projectID int, client GitLabClient) (author bool, wrongTokenType bool, _ error) {
+	job, err := client.GetCurrentJob(ctx)
+	if err != nil {
+		// A 401 indicates that the supplied token is not a (valid) job token. We'll send back
+		// a special flag to the caller to inform them that they should fall back to checking
+		// the project permissions as a user.
+		if gitlab.HTTPErrorCode(err) == http.StatusUnauthorized {
+			return false, true, nil
+		}
+
+		return false, false, errors.Wrap(err, "gitlabClient.GetCurrentJob")
+	}
+
+	return job.Pipeline.ProjectID == projectID, false, nil
+}
+
+// checkGitLabUserProjectPermissions attempts to use the given client as if it's authorized as a
+// user. This method returns true when the given project is visible to the user initiating the
+// current upload request and that user has at least developer access to it.
+func checkGitLabUserProjectPermissions(ctx context.Context, projectID int, client GitLabClient) (bool, error) {
+	permissions, err := client.GetProjectPermissions(ctx, projectID)
+	if err != nil {
+		switch gitlab.HTTPErrorCode(err) {
+		case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "gitlabClient.GetProjectPermissions")
+	}
+
+	// Developers and above can push to the project
+	return permissions.AccessLevel() >= gitlab.AccessLevelDeveloper, nil
+}
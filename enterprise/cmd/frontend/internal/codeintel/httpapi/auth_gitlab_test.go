@@ -0,0 +1,126 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+)
+
+func TestCheckGitLabPermissions(t *testing.T) {
+	type testCase struct {
+		description               string
+		expectedAuthor            bool
+		expectedErr               error
+		getCurrentJobHook         func(context.Context) (*gitlab.Job, error)
+		getProjectPermissionsHook func(context.Context, int) (*gitlab.ProjectPermissions, error)
+	}
+
+	testErr := fmt.Errorf("uh-oh")
+
+	getCurrentJobHookMatchingProject := func(ctx context.Context) (*gitlab.Job, error) {
+		return &gitlab.Job{Pipeline: gitlab.JobPipeline{ProjectID: 42}}, nil
+	}
+
+	getCurrentJobHookNonMatchingProject := func(ctx context.Context) (*gitlab.Job, error) {
+		return &gitlab.Job{Pipeline: gitlab.JobPipeline{ProjectID: 43}}, nil
+	}
+
+	getCurrentJobHookCalledWithPersonalAccessToken := func(ctx context.Context) (*gitlab.Job, error) {
+		// This error occurs when a token that is not a job token is supplied to the job endpoint
+		return nil, gitlab.NewHTTPError(http.StatusUnauthorized, []byte(`{"message":"401 Unauthorized"}`))
+	}
+
+	getCurrentJobHookError := func(ctx context.Context) (*gitlab.Job, error) {
+		return nil, testErr
+	}
+
+	getProjectPermissionsHookDeveloper := func(ctx context.Context, id int) (*gitlab.ProjectPermissions, error) {
+		return &gitlab.ProjectPermissions{GroupAccess: &gitlab.ProjectAccess{AccessLevel: gitlab.AccessLevelDeveloper}}, nil
+	}
+
+	getProjectPermissionsHookReporter := func(ctx context.Context, id int) (*gitlab.ProjectPermissions, error) {
+		return &gitlab.ProjectPermissions{ProjectAccess: &gitlab.ProjectAccess{AccessLevel: gitlab.AccessLevelReporter}}, nil
+	}
+
+	getProjectPermissionsHookNotFound := func(ctx context.Context, id int) (*gitlab.ProjectPermissions, error) {
+		return nil, gitlab.NewHTTPError(http.StatusNotFound, []byte(`{"message":"404 Project Not Found"}`))
+	}
+
+	getProjectPermissionsHookError := func(ctx context.Context, id int) (*gitlab.ProjectPermissions, error) {
+		return nil, testErr
+	}
+
+	testCases := []testCase{
+		{
+			description:               "accessible project; job token",
+			expectedAuthor:            true,
+			expectedErr:               nil,
+			getCurrentJobHook:         getCurrentJobHookMatchingProject,
+			getProjectPermissionsHook: getProjectPermissionsHookNotFound,
+		},
+		{
+			description:               "accessible project; personal access token",
+			expectedAuthor:            true,
+			expectedErr:               nil,
+			getCurrentJobHook:         getCurrentJobHookCalledWithPersonalAccessToken,
+			getProjectPermissionsHook: getProjectPermissionsHookDeveloper,
+		},
+		{
+			description:               "inaccessible project; job token",
+			expectedAuthor:            false,
+			expectedErr:               nil,
+			getCurrentJobHook:         getCurrentJobHookNonMatchingProject,
+			getProjectPermissionsHook: getProjectPermissionsHookDeveloper,
+		},
+		{
+			description:               "read-only project; personal access token",
+			expectedAuthor:            false,
+			expectedErr:               nil,
+			getCurrentJobHook:         getCurrentJobHookCalledWithPersonalAccessToken,
+			getProjectPermissionsHook: getProjectPermissionsHookReporter,
+		},
+		{
+			description:               "inaccessible project; personal access token",
+			expectedAuthor:            false,
+			expectedErr:               nil,
+			getCurrentJobHook:         getCurrentJobHookCalledWithPersonalAccessToken,
+			getProjectPermissionsHook: getProjectPermissionsHookNotFound,
+		},
+		{
+			description:               "unexpected GetCurrentJob error",
+			expectedAuthor:            false,
+			expectedErr:               errors.Wrap(testErr, "gitlabClient.GetCurrentJob"),
+			getCurrentJobHook:         getCurrentJobHookError,
+			getProjectPermissionsHook: getProjectPermissionsHookDeveloper,
+		},
+		{
+			description:               "unexpected GetProjectPermissions error",
+			expectedAuthor:            false,
+			expectedErr:               errors.Wrap(testErr, "gitlabClient.GetProjectPermissions"),
+			getCurrentJobHook:         getCurrentJobHookCalledWithPersonalAccessToken,
+			getProjectPermissionsHook: getProjectPermissionsHookError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			jobTokenClient := NewMockGitLabClient()
+			jobTokenClient.GetCurrentJobFunc.SetDefaultHook(testCase.getCurrentJobHook)
+			personalAccessTokenClient := NewMockGitLabClient()
+			personalAccessTokenClient.GetProjectPermissionsFunc.SetDefaultHook(testCase.getProjectPermissionsHook)
+
+			author, err := checkGitLabPermissions(context.Background(), 42, jobTokenClient, personalAccessTokenClient)
+			if author != testCase.expectedAuthor {
+				t.Errorf("unexpected status. want=%v have=%v", testCase.expectedAuthor, author)
+			}
+			if ((err == nil) != (testCase.expectedErr == nil)) || (err != nil && testCase.expectedErr != nil && err.Error() != testCase.expectedErr.Error()) {
+				t.Errorf("unexpected error. want=%s have=%s", testCase.expectedErr, err)
+			}
+		})
+	}
+}
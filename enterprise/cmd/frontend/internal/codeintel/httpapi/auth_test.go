@@ -0,0 +1,66 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestEnforceAuthRepoValidators(t *testing.T) {
+	t.Cleanup(func() { database.Mocks.Repos.GetByName = nil })
+
+	database.Mocks.Repos.GetByName = func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+		switch name {
+		case "gitlab.example.com/owned/repo", "gitlab.example.com/other/repo":
+			return &types.Repo{Name: name, ExternalRepo: api.ExternalRepoSpec{ServiceType: extsvc.TypeGitLab}}, nil
+		case "phabricator.example.com/repo":
+			return &types.Repo{Name: name, ExternalRepo: api.ExternalRepoSpec{ServiceType: extsvc.TypePhabricator}}, nil
+		case "git.example.com/broken":
+			return nil, errors.New("uh-oh")
+		}
+		return nil, &database.RepoNotFoundErr{Name: name}
+	}
+
+	repoValidators := RepoAuthValidatorMap{
+		extsvc.TypeGitLab: func(ctx context.Context, r *http.Request, repo *types.Repo) (int, error) {
+			if repo.Name != "gitlab.example.com/owned/repo" {
+				return http.StatusUnauthorized, ErrGitLabUnauthorized
+			}
+			return 0, nil
+		},
+	}
+
+	testCases := []struct {
+		repoName   string
+		allowed    bool
+		statusCode int
+	}{
+		{repoName: "gitlab.example.com/owned/repo", allowed: true, statusCode: http.StatusOK},
+		{repoName: "gitlab.example.com/other/repo", allowed: false, statusCode: http.StatusUnauthorized},
+		{repoName: "phabricator.example.com/repo", allowed: false, statusCode: http.StatusUnprocessableEntity},
+		{repoName: "gitlab.example.com/missing/repo", allowed: false, statusCode: http.StatusUnprocessableEntity},
+		{repoName: "git.example.com/broken", allowed: false, statusCode: http.StatusInternalServerError},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.repoName, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/upload", nil)
+
+			if allowed := enforceAuth(context.Background(), w, r, nil, testCase.repoName, nil, repoValidators); allowed != testCase.allowed {
+				t.Errorf("unexpected result. want=%v have=%v", testCase.allowed, allowed)
+			}
+			if w.Code != testCase.statusCode {
+				t.Errorf("unexpected status code. want=%d have=%d", testCase.statusCode, w.Code)
+			}
+		})
+	}
+}
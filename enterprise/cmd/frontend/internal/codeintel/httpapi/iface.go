@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 )
 
 type DBStore interface {
@@ -35,3 +37,12 @@ type GitHubClient interface {
 	GetRepository(ctx context.Context, owner string, name string) (*github.Repository, error)
 	ListInstallationRepositories(ctx context.Context) ([]*github.Repository, error)
 }
+
+type GitLabClient interface {
+	GetCurrentJob(ctx context.Context) (*gitlab.Job, error)
+	GetProjectPermissions(ctx context.Context, id int) (*gitlab.ProjectPermissions, error)
+}
+
+type BitbucketServerClient interface {
+	Repos(ctx context.Context, pageToken *bitbucketserver.PageToken, searchQueries ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error)
+}
@@ -7,9 +7,167 @@ import (
 	"sync"
 
 	dbstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	bitbucketserver "github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	github "github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	gitlab "github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 )
 
+// MockBitbucketServerClient is a mock implementation of the
+// BitbucketServerClient interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/httpapi)
+// used for unit testing.
+type MockBitbucketServerClient struct {
+	// ReposFunc is an instance of a mock function object controlling the
+	// behavior of the method Repos.
+	ReposFunc *BitbucketServerClientReposFunc
+}
+
+// NewMockBitbucketServerClient creates a new mock of the
+// BitbucketServerClient interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockBitbucketServerClient() *MockBitbucketServerClient {
+	return &MockBitbucketServerClient{
+		ReposFunc: &BitbucketServerClientReposFunc{
+			defaultHook: func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+				return nil, nil, nil
+			},
+		},
+	}
+}
+
+// NewMockBitbucketServerClientFrom creates a new mock of the
+// MockBitbucketServerClient interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockBitbucketServerClientFrom(i BitbucketServerClient) *MockBitbucketServerClient {
+	return &MockBitbucketServerClient{
+		ReposFunc: &BitbucketServerClientReposFunc{
+			defaultHook: i.Repos,
+		},
+	}
+}
+
+// BitbucketServerClientReposFunc describes the behavior when the Repos
+// method of the parent MockBitbucketServerClient instance is invoked.
+type BitbucketServerClientReposFunc struct {
+	defaultHook func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error)
+	hooks       []func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error)
+	history     []BitbucketServerClientReposFuncCall
+	mutex       sync.Mutex
+}
+
+// Repos delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockBitbucketServerClient) Repos(v0 context.Context, v1 *bitbucketserver.PageToken, v2 ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+	r0, r1, r2 := m.ReposFunc.nextHook()(v0, v1, v2...)
+	m.ReposFunc.appendCall(BitbucketServerClientReposFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the Repos method of the
+// parent MockBitbucketServerClient instance is invoked and the hook queue
+// is empty.
+func (f *BitbucketServerClientReposFunc) SetDefaultHook(hook func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Repos method of the parent MockBitbucketServerClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *BitbucketServerClientReposFunc) PushHook(hook func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *BitbucketServerClientReposFunc) SetDefaultReturn(r0 []*bitbucketserver.Repo, r1 *bitbucketserver.PageToken, r2 error) {
+	f.SetDefaultHook(func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *BitbucketServerClientReposFunc) PushReturn(r0 []*bitbucketserver.Repo, r1 *bitbucketserver.PageToken, r2 error) {
+	f.PushHook(func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *BitbucketServerClientReposFunc) nextHook() func(context.Context, *bitbucketserver.PageToken, ...string) ([]*bitbucketserver.Repo, *bitbucketserver.PageToken, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *BitbucketServerClientReposFunc) appendCall(r0 BitbucketServerClientReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of BitbucketServerClientReposFuncCall objects
+// describing the invocations of this function.
+func (f *BitbucketServerClientReposFunc) History() []BitbucketServerClientReposFuncCall {
+	f.mutex.Lock()
+	history := make([]BitbucketServerClientReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// BitbucketServerClientReposFuncCall is an object that describes an
+// invocation of method Repos on an instance of MockBitbucketServerClient.
+type BitbucketServerClientReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *bitbucketserver.PageToken
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*bitbucketserver.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 *bitbucketserver.PageToken
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c BitbucketServerClientReposFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c BitbucketServerClientReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // MockDBStore is a mock implementation of the DBStore interface (from the
 // package
 // github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/httpapi)
@@ -1127,3 +1285,265 @@ func (c GitHubClientListInstallationRepositoriesFuncCall) Args() []interface{} {
 func (c GitHubClientListInstallationRepositoriesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// MockGitLabClient is a mock implementation of the GitLabClient interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/httpapi)
+// used for unit testing.
+type MockGitLabClient struct {
+	// GetCurrentJobFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCurrentJob.
+	GetCurrentJobFunc *GitLabClientGetCurrentJobFunc
+	// GetProjectPermissionsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetProjectPermissions.
+	GetProjectPermissionsFunc *GitLabClientGetProjectPermissionsFunc
+}
+
+// NewMockGitLabClient creates a new mock of the GitLabClient interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockGitLabClient() *MockGitLabClient {
+	return &MockGitLabClient{
+		GetCurrentJobFunc: &GitLabClientGetCurrentJobFunc{
+			defaultHook: func(context.Context) (*gitlab.Job, error) {
+				return nil, nil
+			},
+		},
+		GetProjectPermissionsFunc: &GitLabClientGetProjectPermissionsFunc{
+			defaultHook: func(context.Context, int) (*gitlab.ProjectPermissions, error) {
+				return nil, nil
+			},
+		},
+	}
+}
+
+// NewMockGitLabClientFrom creates a new mock of the MockGitLabClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockGitLabClientFrom(i GitLabClient) *MockGitLabClient {
+	return &MockGitLabClient{
+		GetCurrentJobFunc: &GitLabClientGetCurrentJobFunc{
+			defaultHook: i.GetCurrentJob,
+		},
+		GetProjectPermissionsFunc: &GitLabClientGetProjectPermissionsFunc{
+			defaultHook: i.GetProjectPermissions,
+		},
+	}
+}
+
+// GitLabClientGetCurrentJobFunc describes the behavior when the
+// GetCurrentJob method of the parent MockGitLabClient instance is invoked.
+type GitLabClientGetCurrentJobFunc struct {
+	defaultHook func(context.Context) (*gitlab.Job, error)
+	hooks       []func(context.Context) (*gitlab.Job, error)
+	history     []GitLabClientGetCurrentJobFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCurrentJob delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitLabClient) GetCurrentJob(v0 context.Context) (*gitlab.Job, error) {
+	r0, r1 := m.GetCurrentJobFunc.nextHook()(v0)
+	m.GetCurrentJobFunc.appendCall(GitLabClientGetCurrentJobFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCurrentJob method
+// of the parent MockGitLabClient instance is invoked and the hook queue is
+// empty.
+func (f *GitLabClientGetCurrentJobFunc) SetDefaultHook(hook func(context.Context) (*gitlab.Job, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCurrentJob method of the parent MockGitLabClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitLabClientGetCurrentJobFunc) PushHook(hook func(context.Context) (*gitlab.Job, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *GitLabClientGetCurrentJobFunc) SetDefaultReturn(r0 *gitlab.Job, r1 error) {
+	f.SetDefaultHook(func(context.Context) (*gitlab.Job, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *GitLabClientGetCurrentJobFunc) PushReturn(r0 *gitlab.Job, r1 error) {
+	f.PushHook(func(context.Context) (*gitlab.Job, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitLabClientGetCurrentJobFunc) nextHook() func(context.Context) (*gitlab.Job, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitLabClientGetCurrentJobFunc) appendCall(r0 GitLabClientGetCurrentJobFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitLabClientGetCurrentJobFuncCall objects
+// describing the invocations of this function.
+func (f *GitLabClientGetCurrentJobFunc) History() []GitLabClientGetCurrentJobFuncCall {
+	f.mutex.Lock()
+	history := make([]GitLabClientGetCurrentJobFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitLabClientGetCurrentJobFuncCall is an object that describes an
+// invocation of method GetCurrentJob on an instance of MockGitLabClient.
+type GitLabClientGetCurrentJobFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitlab.Job
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitLabClientGetCurrentJobFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitLabClientGetCurrentJobFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitLabClientGetProjectPermissionsFunc describes the behavior when the
+// GetProjectPermissions method of the parent MockGitLabClient instance is
+// invoked.
+type GitLabClientGetProjectPermissionsFunc struct {
+	defaultHook func(context.Context, int) (*gitlab.ProjectPermissions, error)
+	hooks       []func(context.Context, int) (*gitlab.ProjectPermissions, error)
+	history     []GitLabClientGetProjectPermissionsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetProjectPermissions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGitLabClient) GetProjectPermissions(v0 context.Context, v1 int) (*gitlab.ProjectPermissions, error) {
+	r0, r1 := m.GetProjectPermissionsFunc.nextHook()(v0, v1)
+	m.GetProjectPermissionsFunc.appendCall(GitLabClientGetProjectPermissionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetProjectPermissions method of the parent MockGitLabClient instance is
+// invoked and the hook queue is empty.
+func (f *GitLabClientGetProjectPermissionsFunc) SetDefaultHook(hook func(context.Context, int) (*gitlab.ProjectPermissions, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetProjectPermissions method of the parent MockGitLabClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitLabClientGetProjectPermissionsFunc) PushHook(hook func(context.Context, int) (*gitlab.ProjectPermissions, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *GitLabClientGetProjectPermissionsFunc) SetDefaultReturn(r0 *gitlab.ProjectPermissions, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (*gitlab.ProjectPermissions, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *GitLabClientGetProjectPermissionsFunc) PushReturn(r0 *gitlab.ProjectPermissions, r1 error) {
+	f.PushHook(func(context.Context, int) (*gitlab.ProjectPermissions, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitLabClientGetProjectPermissionsFunc) nextHook() func(context.Context, int) (*gitlab.ProjectPermissions, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitLabClientGetProjectPermissionsFunc) appendCall(r0 GitLabClientGetProjectPermissionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitLabClientGetProjectPermissionsFuncCall
+// objects describing the invocations of this function.
+func (f *GitLabClientGetProjectPermissionsFunc) History() []GitLabClientGetProjectPermissionsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitLabClientGetProjectPermissionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitLabClientGetProjectPermissionsFuncCall is an object that describes an
+// invocation of method GetProjectPermissions on an instance of
+// MockGitLabClient.
+type GitLabClientGetProjectPermissionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitlab.ProjectPermissions
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitLabClientGetProjectPermissionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitLabClientGetProjectPermissionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -28,20 +28,22 @@ import (
 )
 
 type UploadHandler struct {
-	db          dbutil.DB
-	dbStore     DBStore
-	uploadStore uploadstore.Store
-	validators  AuthValidatorMap
-	internal    bool
+	db             dbutil.DB
+	dbStore        DBStore
+	uploadStore    uploadstore.Store
+	validators     AuthValidatorMap
+	repoValidators RepoAuthValidatorMap
+	internal       bool
 }
 
-func NewUploadHandler(db dbutil.DB, dbStore DBStore, uploadStore uploadstore.Store, internal bool, authValidators AuthValidatorMap) http.Handler {
+func NewUploadHandler(db dbutil.DB, dbStore DBStore, uploadStore uploadstore.Store, internal bool, authValidators AuthValidatorMap, repoAuthValidators RepoAuthValidatorMap) http.Handler {
 	handler := &UploadHandler{
-		db:          db,
-		dbStore:     dbStore,
-		uploadStore: uploadStore,
-		internal:    internal,
-		validators:  authValidators,
+		db:             db,
+		dbStore:        dbStore,
+		uploadStore:    uploadStore,
+		internal:       internal,
+		validators:     authValidators,
+		repoValidators: repoAuthValidators,
 	}
 
 	return http.HandlerFunc(handler.handleEnqueue)
@@ -66,7 +68,7 @@ func (h *UploadHandler) handleEnqueue(w http.ResponseWriter, r *http.Request) {
 		// 🚨 SECURITY: Ensure we return before proxying to the precise-code-intel-api-server upload
 		// endpoint. This endpoint is unprotected, so we need to make sure the user provides a valid
 		// token proving contributor access to the repository.
-		if !h.internal && conf.Get().LsifEnforceAuth && !isSiteAdmin(ctx, h.db) && !enforceAuth(ctx, w, r, repoName, h.validators) {
+		if !h.internal && conf.Get().LsifEnforceAuth && !isSiteAdmin(ctx, h.db) && !enforceAuth(ctx, w, r, h.db, repoName, h.validators, h.repoValidators) {
 			return
 		}
 
@@ -350,8 +352,9 @@ func (h *UploadHandler) markUploadAsFailed(ctx context.Context, tx DBStore, uplo
 }
 
 // inferIndexer returns the tool name from the metadata vertex at the start of the the given
-// input stream. This method must destructively read the request body, but will re-assign the
-// Body field with a reader that holds the same information as the original request.
+// input stream (or from the leading metadata message of a SCIP index). This method must
+// destructively read the request body, but will re-assign the Body field with a reader that
+// holds the same information as the original request.
 //
 // Newer versions of src-cli will do this same check before uploading the file. However, older
 // versions of src-cli will not guarantee that the index name query parameter is sent. Requiring
@@ -27,6 +27,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip/sciptest"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -189,6 +191,71 @@ func TestHandleEnqueueSinglePayloadNoIndexerName(t *testing.T) {
 	}
 }
 
+func TestHandleEnqueueSinglePayloadSCIPNoIndexerName(t *testing.T) {
+	setupRepoMocks(t)
+
+	mockDBStore := NewMockDBStore()
+	mockUploadStore := uploadstoremocks.NewMockStore()
+
+	mockDBStore.TransactFunc.SetDefaultReturn(mockDBStore, nil)
+	mockDBStore.DoneFunc.SetDefaultHook(func(err error) error { return err })
+	mockDBStore.InsertUploadFunc.SetDefaultReturn(42, nil)
+
+	testURL, err := url.Parse("http://test.com/upload")
+	if err != nil {
+		t.Fatalf("unexpected error constructing url: %s", err)
+	}
+	testURL.RawQuery = (url.Values{
+		"commit":     []string{testCommit},
+		"root":       []string{"proj/"},
+		"repository": []string{"github.com/test/test"},
+	}).Encode()
+
+	metadata := &scip.Metadata{ToolInfo: &scip.ToolInfo{Name: "scip-go"}, ProjectRoot: "file:///proj"}
+	documents := []*scip.Document{{RelativePath: "main.go"}}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	_ = sciptest.WriteIndex(gzipWriter, metadata, documents, nil)
+	gzipWriter.Close()
+	expectedContents := buf.Bytes()
+
+	w := httptest.NewRecorder()
+	r, err := http.NewRequest("POST", testURL.String(), bytes.NewReader(expectedContents))
+	if err != nil {
+		t.Fatalf("unexpected error constructing request: %s", err)
+	}
+
+	h := &UploadHandler{
+		dbStore:     mockDBStore,
+		uploadStore: mockUploadStore,
+	}
+	h.handleEnqueue(w, r)
+
+	if w.Code != http.StatusAccepted {
+		t.Errorf("unexpected status code. want=%d have=%d", http.StatusAccepted, w.Code)
+	}
+
+	if len(mockDBStore.InsertUploadFunc.History()) != 1 {
+		t.Errorf("unexpected number of InsertUpload calls. want=%d have=%d", 1, len(mockDBStore.InsertUploadFunc.History()))
+	} else if indexer := mockDBStore.InsertUploadFunc.History()[0].Arg1.Indexer; indexer != "scip-go" {
+		t.Errorf("unexpected indexer name. want=%s have=%s", "scip-go", indexer)
+	}
+
+	if len(mockUploadStore.UploadFunc.History()) != 1 {
+		t.Errorf("unexpected number of Upload calls. want=%d have=%d", 1, len(mockUploadStore.UploadFunc.History()))
+	} else {
+		contents, err := io.ReadAll(mockUploadStore.UploadFunc.History()[0].Arg2)
+		if err != nil {
+			t.Fatalf("unexpected error reading payload: %s", err)
+		}
+
+		if diff := cmp.Diff(expectedContents, contents); diff != "" {
+			t.Errorf("unexpected file contents (-want +got):\n%s", diff)
+		}
+	}
+}
+
 func TestHandleEnqueueMultipartSetup(t *testing.T) {
 	setupRepoMocks(t)
 
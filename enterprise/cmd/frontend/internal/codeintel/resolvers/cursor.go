@@ -13,6 +13,8 @@ type localCursor struct {
 type remoteCursor struct {
 	UploadOffset   int   `json:"batchOffset"`
 	UploadBatchIDs []int `json:"uploadBatchIDs"`
+	// The package version referenced by each upload in the current batch.
+	UploadBatchVersions map[int]string `json:"uploadBatchVersions,omitempty"`
 	// The location offset within the associated batch of uploads.
 	LocationOffset int `json:"locationOffset"`
 }
@@ -6,6 +6,7 @@ import (
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 )
 
 type LocationConnectionResolver struct {
@@ -29,3 +30,58 @@ func (r *LocationConnectionResolver) Nodes(ctx context.Context) ([]gql.LocationR
 func (r *LocationConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
 	return graphqlutil.EncodeCursor(r.cursor), nil
 }
+
+// Groups returns the locations of this page grouped by repository and referenced package version.
+// Groups are ordered by the first appearance of a matching location. Groups belonging to a repository
+// that is no longer known are omitted.
+func (r *LocationConnectionResolver) Groups(ctx context.Context) ([]gql.LocationGroupResolver, error) {
+	type groupKey struct {
+		repositoryID int
+		version      string
+	}
+
+	var keys []groupKey
+	locationsByKey := map[groupKey][]resolvers.AdjustedLocation{}
+	for _, location := range r.locations {
+		key := groupKey{location.Dump.RepositoryID, location.PackageVersion}
+		if _, ok := locationsByKey[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		locationsByKey[key] = append(locationsByKey[key], location)
+	}
+
+	groups := make([]gql.LocationGroupResolver, 0, len(keys))
+	for _, key := range keys {
+		repositoryResolver, err := r.locationResolver.Repository(ctx, api.RepoID(key.repositoryID))
+		if err != nil {
+			return nil, err
+		}
+		if repositoryResolver == nil {
+			continue
+		}
+
+		nodes, err := resolveLocations(ctx, r.locationResolver, locationsByKey[key])
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, &LocationGroupResolver{
+			repository: repositoryResolver,
+			version:    key.version,
+			nodes:      nodes,
+		})
+	}
+
+	return groups, nil
+}
+
+type LocationGroupResolver struct {
+	repository *gql.RepositoryResolver
+	version    string
+	nodes      []gql.LocationResolver
+}
+
+func (r *LocationGroupResolver) Repository() *gql.RepositoryResolver { return r.repository }
+func (r *LocationGroupResolver) Version() *string                    { return strPtr(r.version) }
+func (r *LocationGroupResolver) Nodes() []gql.LocationResolver       { return r.nodes }
@@ -0,0 +1,86 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git/gitapi"
+)
+
+func TestLocationConnectionGroups(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	t.Cleanup(func() {
+		database.Mocks.Repos.Get = nil
+		git.Mocks.ResolveRevision = nil
+		backend.Mocks.Repos.GetCommit = nil
+	})
+
+	database.Mocks.Repos.Get = func(v0 context.Context, id api.RepoID) (*types.Repo, error) {
+		return &types.Repo{ID: id, Name: api.RepoName(fmt.Sprintf("repo%d", id))}, nil
+	}
+
+	git.Mocks.ResolveRevision = func(spec string, opt git.ResolveRevisionOptions) (api.CommitID, error) {
+		return api.CommitID(spec), nil
+	}
+
+	backend.Mocks.Repos.GetCommit = func(v0 context.Context, repo *types.Repo, commitID api.CommitID) (*gitapi.Commit, error) {
+		return &gitapi.Commit{ID: commitID}, nil
+	}
+
+	r1 := lsifstore.Range{Start: lsifstore.Position{Line: 11, Character: 12}, End: lsifstore.Position{Line: 13, Character: 14}}
+	r2 := lsifstore.Range{Start: lsifstore.Position{Line: 21, Character: 22}, End: lsifstore.Position{Line: 23, Character: 24}}
+
+	resolver := NewLocationConnectionResolver([]resolvers.AdjustedLocation{
+		{Dump: store.Dump{RepositoryID: 50}, AdjustedCommit: "deadbeef1", AdjustedRange: r1, Path: "p1"},
+		{Dump: store.Dump{RepositoryID: 51}, AdjustedCommit: "deadbeef2", AdjustedRange: r1, Path: "p2", PackageVersion: "1.2.0"},
+		{Dump: store.Dump{RepositoryID: 52}, AdjustedCommit: "deadbeef3", AdjustedRange: r1, Path: "p3", PackageVersion: "1.0.0"},
+		{Dump: store.Dump{RepositoryID: 51}, AdjustedCommit: "deadbeef2", AdjustedRange: r2, Path: "p4", PackageVersion: "1.2.0"},
+		{Dump: store.Dump{RepositoryID: 51}, AdjustedCommit: "deadbeef4", AdjustedRange: r2, Path: "p5", PackageVersion: "1.1.0"},
+	}, nil, NewCachedLocationResolver(db))
+
+	groups, err := resolver.Groups(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	type groupSummary struct {
+		Repository string
+		Version    string
+		URLs       []string
+	}
+	var summaries []groupSummary
+	for _, group := range groups {
+		summary := groupSummary{Repository: group.Repository().Name()}
+		if version := group.Version(); version != nil {
+			summary.Version = *version
+		}
+		for _, node := range group.Nodes() {
+			summary.URLs = append(summary.URLs, node.CanonicalURL())
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	expected := []groupSummary{
+		{Repository: "repo50", URLs: []string{"/repo50@deadbeef1/-/tree/p1?L12:13-14:15"}},
+		{Repository: "repo51", Version: "1.2.0", URLs: []string{"/repo51@deadbeef2/-/tree/p2?L12:13-14:15", "/repo51@deadbeef2/-/tree/p4?L22:23-24:25"}},
+		{Repository: "repo52", Version: "1.0.0", URLs: []string{"/repo52@deadbeef3/-/tree/p3?L12:13-14:15"}},
+		{Repository: "repo51", Version: "1.1.0", URLs: []string{"/repo51@deadbeef4/-/tree/p5?L22:23-24:25"}},
+	}
+	if diff := cmp.Diff(expected, summaries); diff != "" {
+		t.Errorf("unexpected groups (-want +got):\n%s", diff)
+	}
+}
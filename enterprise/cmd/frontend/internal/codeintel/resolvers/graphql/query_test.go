@@ -65,13 +65,18 @@ func TestReferences(t *testing.T) {
 	offset := int32(25)
 	cursor := base64.StdEncoding.EncodeToString([]byte("test-cursor"))
 
-	args := &gql.LSIFPagedQueryPositionArgs{
-		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
-			Line:      10,
-			Character: 15,
+	versionRange := "^1.0.0"
+
+	args := &gql.LSIFPagedQueryReferencesArgs{
+		LSIFPagedQueryPositionArgs: gql.LSIFPagedQueryPositionArgs{
+			LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+				Line:      10,
+				Character: 15,
+			},
+			ConnectionArgs: graphqlutil.ConnectionArgs{First: &offset},
+			After:          &cursor,
 		},
-		ConnectionArgs: graphqlutil.ConnectionArgs{First: &offset},
-		After:          &cursor,
+		VersionRange: &versionRange,
 	}
 
 	if _, err := resolver.References(context.Background(), args); err != nil {
@@ -93,6 +98,9 @@ func TestReferences(t *testing.T) {
 	if val := mockResolver.ReferencesFunc.History()[0].Arg4; val != "test-cursor" {
 		t.Fatalf("unexpected character. want=%s have=%s", "test-cursor", val)
 	}
+	if val := mockResolver.ReferencesFunc.History()[0].Arg5; val != "^1.0.0" {
+		t.Fatalf("unexpected version range. want=%s have=%s", "^1.0.0", val)
+	}
 }
 
 func TestReferencesDefaultLimit(t *testing.T) {
@@ -101,12 +109,14 @@ func TestReferencesDefaultLimit(t *testing.T) {
 	mockResolver := resolvermocks.NewMockQueryResolver()
 	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
 
-	args := &gql.LSIFPagedQueryPositionArgs{
-		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
-			Line:      10,
-			Character: 15,
+	args := &gql.LSIFPagedQueryReferencesArgs{
+		LSIFPagedQueryPositionArgs: gql.LSIFPagedQueryPositionArgs{
+			LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+				Line:      10,
+				Character: 15,
+			},
+			ConnectionArgs: graphqlutil.ConnectionArgs{},
 		},
-		ConnectionArgs: graphqlutil.ConnectionArgs{},
 	}
 
 	if _, err := resolver.References(context.Background(), args); err != nil {
@@ -128,12 +138,14 @@ func TestReferencesDefaultIllegalLimit(t *testing.T) {
 	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
 
 	offset := int32(-1)
-	args := &gql.LSIFPagedQueryPositionArgs{
-		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
-			Line:      10,
-			Character: 15,
+	args := &gql.LSIFPagedQueryReferencesArgs{
+		LSIFPagedQueryPositionArgs: gql.LSIFPagedQueryPositionArgs{
+			LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+				Line:      10,
+				Character: 15,
+			},
+			ConnectionArgs: graphqlutil.ConnectionArgs{First: &offset},
 		},
-		ConnectionArgs: graphqlutil.ConnectionArgs{First: &offset},
 	}
 
 	if _, err := resolver.References(context.Background(), args); err != ErrIllegalLimit {
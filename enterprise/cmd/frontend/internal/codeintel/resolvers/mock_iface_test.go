@@ -95,6 +95,10 @@ type MockDBStore struct {
 	// ReferenceIDsAndFiltersFunc is an instance of a mock function object
 	// controlling the behavior of the method ReferenceIDsAndFilters.
 	ReferenceIDsAndFiltersFunc *DBStoreReferenceIDsAndFiltersFunc
+	// ReferencedPackageVersionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ReferencedPackageVersions.
+	ReferencedPackageVersionsFunc *DBStoreReferencedPackageVersionsFunc
 	// RepoIDsByGlobPatternFunc is an instance of a mock function object
 	// controlling the behavior of the method RepoIDsByGlobPattern.
 	RepoIDsByGlobPatternFunc *DBStoreRepoIDsByGlobPatternFunc
@@ -225,6 +229,11 @@ func NewMockDBStore() *MockDBStore {
 				return nil, 0, nil
 			},
 		},
+		ReferencedPackageVersionsFunc: &DBStoreReferencedPackageVersionsFunc{
+			defaultHook: func(context.Context, string, string) ([]string, error) {
+				return nil, nil
+			},
+		},
 		RepoIDsByGlobPatternFunc: &DBStoreRepoIDsByGlobPatternFunc{
 			defaultHook: func(context.Context, string) ([]int, error) {
 				return nil, nil
@@ -318,6 +327,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		ReferenceIDsAndFiltersFunc: &DBStoreReferenceIDsAndFiltersFunc{
 			defaultHook: i.ReferenceIDsAndFilters,
 		},
+		ReferencedPackageVersionsFunc: &DBStoreReferencedPackageVersionsFunc{
+			defaultHook: i.ReferencedPackageVersions,
+		},
 		RepoIDsByGlobPatternFunc: &DBStoreRepoIDsByGlobPatternFunc{
 			defaultHook: i.RepoIDsByGlobPattern,
 		},
@@ -2827,6 +2839,121 @@ func (c DBStoreReferenceIDsAndFiltersFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// DBStoreReferencedPackageVersionsFunc describes the behavior when the
+// ReferencedPackageVersions method of the parent MockDBStore instance is
+// invoked.
+type DBStoreReferencedPackageVersionsFunc struct {
+	defaultHook func(context.Context, string, string) ([]string, error)
+	hooks       []func(context.Context, string, string) ([]string, error)
+	history     []DBStoreReferencedPackageVersionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ReferencedPackageVersions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) ReferencedPackageVersions(v0 context.Context, v1 string, v2 string) ([]string, error) {
+	r0, r1 := m.ReferencedPackageVersionsFunc.nextHook()(v0, v1, v2)
+	m.ReferencedPackageVersionsFunc.appendCall(DBStoreReferencedPackageVersionsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ReferencedPackageVersions method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreReferencedPackageVersionsFunc) SetDefaultHook(hook func(context.Context, string, string) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReferencedPackageVersions method of the parent MockDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DBStoreReferencedPackageVersionsFunc) PushHook(hook func(context.Context, string, string) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreReferencedPackageVersionsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreReferencedPackageVersionsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, string, string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreReferencedPackageVersionsFunc) nextHook() func(context.Context, string, string) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreReferencedPackageVersionsFunc) appendCall(r0 DBStoreReferencedPackageVersionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreReferencedPackageVersionsFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreReferencedPackageVersionsFunc) History() []DBStoreReferencedPackageVersionsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreReferencedPackageVersionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreReferencedPackageVersionsFuncCall is an object that describes an
+// invocation of method ReferencedPackageVersions on an instance of
+// MockDBStore.
+type DBStoreReferencedPackageVersionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreReferencedPackageVersionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreReferencedPackageVersionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // DBStoreRepoIDsByGlobPatternFunc describes the behavior when the
 // RepoIDsByGlobPattern method of the parent MockDBStore instance is
 // invoked.
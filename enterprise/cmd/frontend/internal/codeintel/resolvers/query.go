@@ -15,6 +15,9 @@ type AdjustedLocation struct {
 	Path           string
 	AdjustedCommit string
 	AdjustedRange  lsifstore.Range
+	// PackageVersion is the version of the target package referenced by the location's upload.
+	// This is only populated for remote references.
+	PackageVersion string
 }
 
 // AdjustedDiagnostic is a diagnostic from within a particular upload. The adjusted commit denotes
@@ -53,7 +56,7 @@ type QueryResolver interface {
 	Stencil(ctx context.Context) ([]lsifstore.Range, error)
 	Ranges(ctx context.Context, startLine, endLine int) ([]AdjustedCodeIntelligenceRange, error)
 	Definitions(ctx context.Context, line, character int) ([]AdjustedLocation, error)
-	References(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedLocation, string, error)
+	References(ctx context.Context, line, character, limit int, rawCursor, versionRange string) ([]AdjustedLocation, string, error)
 	Implementations(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedLocation, string, error)
 	Hover(ctx context.Context, line, character int) (string, lsifstore.Range, bool, error)
 	Diagnostics(ctx context.Context, limit int) ([]AdjustedDiagnostic, int, error)
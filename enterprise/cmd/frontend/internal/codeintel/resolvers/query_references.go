@@ -21,7 +21,9 @@ import (
 const slowReferencesRequestThreshold = time.Second
 
 // References returns the list of source locations that reference the symbol at the given position.
-func (r *queryResolver) References(ctx context.Context, line, character, limit int, rawCursor string) (_ []AdjustedLocation, _ string, err error) {
+// If a version range is supplied, remote references are matched against every referenced version
+// of the symbol's package that satisfies the range rather than only the exact indexed version.
+func (r *queryResolver) References(ctx context.Context, line, character, limit int, rawCursor, versionRange string) (_ []AdjustedLocation, _ string, err error) {
 	ctx, traceLog, endObservation := observeResolver(ctx, &err, "References", r.operations.references, slowReferencesRequestThreshold, observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", r.repositoryID),
@@ -31,6 +33,7 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 			log.String("uploads", uploadIDsToString(r.uploads)),
 			log.Int("line", line),
 			log.Int("character", character),
+			log.String("versionRange", versionRange),
 		},
 	})
 	defer endObservation()
@@ -70,10 +73,22 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 		log.String("monikers", monikersToString(cursor.OrderedMonikers)),
 	)
 
+	// Expand the monikers to cover each referenced version of their packages that fall within the
+	// requested version range. These are only used to search for remote references; definitions are
+	// still resolved using the exact package versions attached to the monikers above.
+
+	if cursor.VersionedMonikers == nil {
+		if cursor.VersionedMonikers, err = r.expandMonikerVersions(ctx, cursor.OrderedMonikers, versionRange); err != nil {
+			return nil, "", err
+		}
+	}
+	traceLog(log.Int("numVersionedMonikers", len(cursor.VersionedMonikers)))
+
 	// Phase 1: Gather all "local" locations via LSIF graph traversal. We'll continue to request additional
 	// locations until we fill an entire page (the size of which is denoted by the given limit) or there are
 	// no more local results remaining.
 	var locations []lsifstore.Location
+	packageVersions := map[int]string{}
 	if cursor.Phase == "local" {
 		localLocations, hasMore, err := r.pageLocalLocations(
 			ctx,
@@ -119,12 +134,18 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 		}
 
 		for len(locations) < limit {
-			remoteLocations, hasMore, err := r.pageRemoteLocations(ctx, "references", adjustedUploads, cursor.OrderedMonikers, &cursor.RemoteCursor, limit-len(locations), traceLog)
+			remoteLocations, hasMore, err := r.pageRemoteLocations(ctx, "references", adjustedUploads, cursor.VersionedMonikers, &cursor.RemoteCursor, limit-len(locations), traceLog)
 			if err != nil {
 				return nil, "", err
 			}
 			locations = append(locations, remoteLocations...)
 
+			// Remember the package version referenced by each upload in the current batch so that
+			// remote locations can be attributed to the version of the package they consume.
+			for id, version := range cursor.RemoteCursor.UploadBatchVersions {
+				packageVersions[id] = version
+			}
+
 			if !hasMore {
 				cursor.Phase = "done"
 				break
@@ -144,6 +165,10 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 	}
 	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
 
+	for i := range adjustedLocations {
+		adjustedLocations[i].PackageVersion = packageVersions[adjustedLocations[i].Dump.ID]
+	}
+
 	nextCursor := ""
 	if cursor.Phase != "done" {
 		nextCursor = encodeReferencesCursor(cursor)
@@ -285,7 +310,7 @@ func (r *queryResolver) pageRemoteLocations(
 		}
 
 		// Find the next batch of indexes to perform a moniker search over
-		referenceUploadIDs, recordsScanned, totalRecords, err := r.uploadIDsWithReferences(
+		referenceUploadIDs, referenceVersions, recordsScanned, totalRecords, err := r.uploadIDsWithReferences(
 			ctx,
 			orderedMonikers,
 			ignoreIDs,
@@ -298,6 +323,7 @@ func (r *queryResolver) pageRemoteLocations(
 		}
 
 		cursor.UploadBatchIDs = referenceUploadIDs
+		cursor.UploadBatchVersions = referenceVersions
 		cursor.UploadOffset += recordsScanned
 
 		if cursor.UploadOffset >= totalRecords {
@@ -383,9 +409,10 @@ func rangeContainsPosition(r lsifstore.Range, pos lsifstore.Position) bool {
 // uploadIDsWithReferences returns uploads that probably contain an import
 // or implementation moniker whose identifier matches any of the given monikers' identifiers. This method
 // will not return uploads for commits which are unknown to gitserver, nor will it return uploads which
-// are listed in the given ignored identifier slice. This method also returns the number of records
-// scanned (but possibly filtered out from the return slice) from the database (the offset for the
-// subsequent request) and the total number of records in the database.
+// are listed in the given ignored identifier slice. This method also returns the package version that
+// each returned upload references, the number of records scanned (but possibly filtered out from the
+// return slice) from the database (the offset for the subsequent request) and the total number of
+// records in the database.
 func (r *queryResolver) uploadIDsWithReferences(
 	ctx context.Context,
 	orderedMonikers []precise.QualifiedMonikerData,
@@ -393,10 +420,10 @@ func (r *queryResolver) uploadIDsWithReferences(
 	limit int,
 	offset int,
 	traceLog observation.TraceLogger,
-) (ids []int, recordsScanned int, totalCount int, err error) {
+) (ids []int, versions map[int]string, recordsScanned int, totalCount int, err error) {
 	scanner, totalCount, err := r.dbStore.ReferenceIDsAndFilters(ctx, r.repositoryID, r.commit, orderedMonikers, limit, offset)
 	if err != nil {
-		return nil, 0, 0, errors.Wrap(err, "dbstore.ReferenceIDsAndFilters")
+		return nil, nil, 0, 0, errors.Wrap(err, "dbstore.ReferenceIDsAndFilters")
 	}
 
 	defer func() {
@@ -411,11 +438,12 @@ func (r *queryResolver) uploadIDsWithReferences(
 	}
 
 	filtered := map[int]struct{}{}
+	versions = map[int]string{}
 
 	for len(filtered) < limit {
 		packageReference, exists, err := scanner.Next()
 		if err != nil {
-			return nil, 0, 0, errors.Wrap(err, "dbstore.ReferenceIDsAndFilters.Next")
+			return nil, nil, 0, 0, errors.Wrap(err, "dbstore.ReferenceIDsAndFilters.Next")
 		}
 		if !exists {
 			break
@@ -439,11 +467,12 @@ func (r *queryResolver) uploadIDsWithReferences(
 
 		ok, err := testFilter(packageReference.Filter, orderedMonikers)
 		if err != nil {
-			return nil, 0, 0, err
+			return nil, nil, 0, 0, err
 		}
 		if ok {
 			// Probably imports or implements at least one of the monikers' identifiers
 			filtered[packageReference.DumpID] = struct{}{}
+			versions[packageReference.DumpID] = packageReference.Version
 		}
 	}
 
@@ -458,7 +487,7 @@ func (r *queryResolver) uploadIDsWithReferences(
 	}
 	sort.Ints(flattened)
 
-	return flattened, recordsScanned, totalCount, nil
+	return flattened, versions, recordsScanned, totalCount, nil
 }
 
 // testFilter returns true if the set underlying the given encoded bloom filter probably includes any of
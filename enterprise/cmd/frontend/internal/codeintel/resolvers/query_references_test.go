@@ -52,7 +52,7 @@ func TestReferences(t *testing.T) {
 		uploads,
 		newOperations(&observation.TestContext),
 	)
-	adjustedLocations, _, err := resolver.References(context.Background(), 10, 20, 50, "")
+	adjustedLocations, _, err := resolver.References(context.Background(), 10, 20, 50, "", "")
 	if err != nil {
 		t.Fatalf("unexpected error querying references: %s", err)
 	}
@@ -173,7 +173,7 @@ func TestReferencesRemote(t *testing.T) {
 		uploads,
 		newOperations(&observation.TestContext),
 	)
-	adjustedLocations, _, err := resolver.References(context.Background(), 10, 20, 50, "")
+	adjustedLocations, _, err := resolver.References(context.Background(), 10, 20, 50, "", "")
 	if err != nil {
 		t.Fatalf("unexpected error querying references: %s", err)
 	}
@@ -265,7 +265,7 @@ func TestIgnoredIDs(t *testing.T) {
 		scanner := dbstore.PackageReferenceScannerFromSlice(pkg)
 		mockDBStore.ReferenceIDsAndFiltersFunc.PushReturn(scanner, 1, nil)
 
-		gotDumps, scanned, totalCount, err := resolver.uploadIDsWithReferences(
+		gotDumps, _, scanned, totalCount, err := resolver.uploadIDsWithReferences(
 			context.Background(),
 			[]precise.QualifiedMonikerData{{MonikerData: precise.MonikerData{Identifier: "padLeft"}}},
 			ignoreIDs,
@@ -300,3 +300,141 @@ func TestIgnoredIDs(t *testing.T) {
 		[]int{},          // wanted dumps
 	)
 }
+
+func TestReferencesVersionRange(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+
+	moniker := precise.MonikerData{Kind: "import", Scheme: "npm", Identifier: "padLeft", PackageInformationID: "51"}
+	packageInformation := precise.PackageInformationData{Name: "leftpad", Version: "1.0.0"}
+	mockLSIFStore.MonikersByPositionFunc.PushReturn([][]precise.MonikerData{{moniker}}, nil)
+	mockLSIFStore.PackageInformationFunc.PushReturn(packageInformation, true, nil)
+	mockDBStore.ReferencedPackageVersionsFunc.PushReturn([]string{"1.0.0", "1.2.0", "2.0.0", "latest"}, nil)
+
+	filter, err := bloomfilter.CreateFilter([]string{"padLeft"})
+	if err != nil {
+		t.Fatalf("unexpected error encoding bloom filter: %s", err)
+	}
+	mockDBStore.ReferenceIDsAndFiltersFunc.PushReturn(dbstore.PackageReferenceScannerFromSlice(
+		shared.PackageReference{Package: shared.Package{DumpID: 250, Scheme: "npm", Name: "leftpad", Version: "1.2.0"}, Filter: filter},
+		shared.PackageReference{Package: shared.Package{DumpID: 251, Scheme: "npm", Name: "leftpad", Version: "1.0.0"}, Filter: filter},
+	), 2, nil)
+
+	referenceUploads := []dbstore.Dump{
+		{ID: 250, Commit: "deadbeef1", Root: "sub1/", RepositoryID: 43},
+		{ID: 251, Commit: "deadbeef2", Root: "sub2/", RepositoryID: 44},
+	}
+	mockDBStore.GetDumpsByIDsFunc.PushReturn(referenceUploads, nil)
+
+	mockLSIFStore.BulkMonikerResultsFunc.PushReturn([]lsifstore.Location{
+		{DumpID: 250, Path: "a.go", Range: testRange1},
+		{DumpID: 251, Path: "b.go", Range: testRange2},
+	}, 2, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/", RepositoryID: 42},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, _, err := resolver.References(context.Background(), 10, 20, 50, "", "^1.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error querying references: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: referenceUploads[0], Path: "sub1/a.go", AdjustedCommit: "deadbeef1", AdjustedRange: testRange1, PackageVersion: "1.2.0"},
+		{Dump: referenceUploads[1], Path: "sub2/b.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange2, PackageVersion: "1.0.0"},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	if history := mockDBStore.DefinitionDumpsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count for dbstore.DefinitionDumps. want=%d have=%d", 1, len(history))
+	} else {
+		expectedMonikers := []precise.QualifiedMonikerData{
+			{MonikerData: moniker, PackageInformationData: packageInformation},
+		}
+		if diff := cmp.Diff(expectedMonikers, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+		}
+	}
+
+	if history := mockDBStore.ReferenceIDsAndFiltersFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count for dbstore.ReferenceIDsAndFilters. want=%d have=%d", 1, len(history))
+	} else {
+		expectedMonikers := []precise.QualifiedMonikerData{
+			{MonikerData: moniker, PackageInformationData: packageInformation},
+			{MonikerData: moniker, PackageInformationData: precise.PackageInformationData{Name: "leftpad", Version: "1.2.0"}},
+		}
+		if diff := cmp.Diff(expectedMonikers, history[0].Arg3); diff != "" {
+			t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestReferencesInvalidVersionRange(t *testing.T) {
+	mockLSIFStore := NewMockLSIFStore()
+	mockLSIFStore.MonikersByPositionFunc.PushReturn([][]precise.MonikerData{{
+		{Kind: "import", Scheme: "npm", Identifier: "padLeft", PackageInformationID: "51"},
+	}}, nil)
+	mockLSIFStore.PackageInformationFunc.PushReturn(precise.PackageInformationData{Name: "leftpad", Version: "1.0.0"}, true, nil)
+
+	resolver := newQueryResolver(
+		NewMockDBStore(),
+		mockLSIFStore,
+		newCachedCommitChecker(NewMockGitserverClient()),
+		noopPositionAdjuster(),
+		42,
+		"deadbeef",
+		"s1/main.go",
+		[]dbstore.Dump{{ID: 50, Commit: "deadbeef", Root: "sub1/"}},
+		newOperations(&observation.TestContext),
+	)
+	if _, _, err := resolver.References(context.Background(), 10, 20, 50, "", "not a range"); err == nil {
+		t.Fatalf("expected error querying references with invalid version range")
+	}
+}
+
+func TestParseVersionRange(t *testing.T) {
+	testCases := []struct {
+		versionRange string
+		matches      []string
+		excludes     []string
+	}{
+		{versionRange: "*", matches: []string{"1.0.0", "v2.3.4", "latest"}},
+		{versionRange: "any", matches: []string{"0.0.1", "master"}},
+		{versionRange: "^1.2.0", matches: []string{"1.2.0", "v1.9.1"}, excludes: []string{"1.1.9", "2.0.0", "latest"}},
+		{versionRange: ">= 1.0, < 3.0", matches: []string{"1.0.0", "2.9.9"}, excludes: []string{"0.9.0", "3.0.0"}},
+	}
+
+	for _, testCase := range testCases {
+		matches, err := parseVersionRange(testCase.versionRange)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", testCase.versionRange, err)
+		}
+
+		for _, version := range testCase.matches {
+			if !matches(version) {
+				t.Errorf("expected %q to match %q", testCase.versionRange, version)
+			}
+		}
+		for _, version := range testCase.excludes {
+			if matches(version) {
+				t.Errorf("expected %q not to match %q", testCase.versionRange, version)
+			}
+		}
+	}
+}
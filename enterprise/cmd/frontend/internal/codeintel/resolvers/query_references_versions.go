@@ -0,0 +1,102 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver"
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+// anyVersionRanges are the version ranges that match every referenced version of a package,
+// including versions that are not valid semantic versions.
+var anyVersionRanges = map[string]struct{}{
+	"*":   {},
+	"any": {},
+}
+
+// versionMatcher returns true if the given package version should be included in a references
+// request.
+type versionMatcher func(version string) bool
+
+// parseVersionRange returns a matcher for the given version range. The range may be the string
+// "*" or "any", which matches all versions, or a semantic version constraint such as "^1.2.0" or
+// ">= 1.0, < 3.0". Versions that cannot be parsed as a semantic version never satisfy a constraint.
+func parseVersionRange(versionRange string) (versionMatcher, error) {
+	if _, ok := anyVersionRanges[versionRange]; ok {
+		return func(version string) bool { return true }, nil
+	}
+
+	constraint, err := semver.NewConstraint(versionRange)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid version range: %q", versionRange))
+	}
+
+	return func(version string) bool {
+		v, err := semver.NewVersion(version)
+		if err != nil {
+			return false
+		}
+
+		return constraint.Check(v)
+	}, nil
+}
+
+// expandMonikerVersions returns a copy of the given monikers for each version of the moniker's
+// package that is referenced by some upload and satisfies the given version range. The input
+// monikers (at their exact version) are always included so that an empty range or a range that
+// excludes the current version does not hide references to the package being browsed.
+func (r *queryResolver) expandMonikerVersions(ctx context.Context, orderedMonikers []precise.QualifiedMonikerData, versionRange string) ([]precise.QualifiedMonikerData, error) {
+	if versionRange == "" {
+		return orderedMonikers, nil
+	}
+
+	matches, err := parseVersionRange(versionRange)
+	if err != nil {
+		return nil, err
+	}
+
+	type packageKey struct{ scheme, name string }
+	versionsByPackage := map[packageKey][]string{}
+
+	type monikerKey struct{ kind, scheme, identifier, name, version string }
+	seen := map[monikerKey]struct{}{}
+
+	expanded := make([]precise.QualifiedMonikerData, 0, len(orderedMonikers))
+	add := func(moniker precise.QualifiedMonikerData) {
+		key := monikerKey{moniker.Kind, moniker.Scheme, moniker.Identifier, moniker.Name, moniker.Version}
+		if _, ok := seen[key]; ok {
+			return
+		}
+
+		seen[key] = struct{}{}
+		expanded = append(expanded, moniker)
+	}
+
+	for _, moniker := range orderedMonikers {
+		add(moniker)
+
+		key := packageKey{moniker.Scheme, moniker.Name}
+		versions, ok := versionsByPackage[key]
+		if !ok {
+			if versions, err = r.dbStore.ReferencedPackageVersions(ctx, moniker.Scheme, moniker.Name); err != nil {
+				return nil, errors.Wrap(err, "dbstore.ReferencedPackageVersions")
+			}
+			versionsByPackage[key] = versions
+		}
+
+		for _, version := range versions {
+			if !matches(version) {
+				continue
+			}
+
+			versionedMoniker := moniker
+			versionedMoniker.Version = version
+			add(versionedMoniker)
+		}
+	}
+
+	return expanded, nil
+}
@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -13,8 +14,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	cm "github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 )
 
 // NewResolver returns a new Resolver that uses the given database
@@ -114,6 +117,14 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 	if err != nil {
 		return nil, err
 	}
+	for _, action := range args.Actions {
+		if action.Email == nil {
+			continue
+		}
+		if err = r.isAllowedToEmail(ctx, action.Email.Recipients); err != nil {
+			return nil, err
+		}
+	}
 	var mo *cm.Monitor
 	mo, err = r.store.CreateCodeMonitor(ctx, args)
 	if err != nil {
@@ -161,6 +172,15 @@ func (r *Resolver) UpdateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 		return nil, errors.Errorf("update namespace: %w", err)
 	}
 
+	for _, action := range args.Actions {
+		if action.Email == nil || action.Email.Update == nil {
+			continue
+		}
+		if err = r.isAllowedToEmail(ctx, action.Email.Update.Recipients); err != nil {
+			return nil, err
+		}
+	}
+
 	var monitorID int64
 	err = relay.UnmarshalSpec(args.Monitor.Id, &monitorID)
 	if err != nil {
@@ -229,9 +249,34 @@ func (r *Resolver) TriggerTestEmailAction(ctx context.Context, args *graphqlback
 	if err != nil {
 		return nil, err
 	}
+	if err := r.isAllowedToEmail(ctx, args.Email.Recipients); err != nil {
+		return nil, err
+	}
 
+	recs := make([]*cm.Recipient, 0, len(args.Email.Recipients))
 	for _, recipient := range args.Email.Recipients {
-		if err := sendTestEmail(ctx, recipient, args.Description); err != nil {
+		var (
+			userID int32
+			orgID  int32
+		)
+		if err := graphqlbackend.UnmarshalNamespaceID(recipient, &userID, &orgID); err != nil {
+			return nil, err
+		}
+		if orgID != 0 {
+			recs = append(recs, &cm.Recipient{NamespaceOrgID: &orgID})
+		} else {
+			recs = append(recs, &cm.Recipient{NamespaceUserID: &userID})
+		}
+	}
+
+	// The monitor might not exist yet, so there are no opt-outs to respect.
+	userIDs, err := cm.EmailRecipientUserIDs(ctx, r.store, 0, recs)
+	if err != nil {
+		return nil, err
+	}
+	data := email.NewTestTemplateDataForNewSearchResults(ctx, args.Description)
+	for _, userID := range userIDs {
+		if err := email.SendEmailForNewSearchResult(ctx, userID, data); err != nil {
 			return nil, err
 		}
 	}
@@ -239,21 +284,30 @@ func (r *Resolver) TriggerTestEmailAction(ctx context.Context, args *graphqlback
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
-func sendTestEmail(ctx context.Context, recipient graphql.ID, description string) error {
-	var (
-		userID int32
-		orgID  int32
-	)
-	err := graphqlbackend.UnmarshalNamespaceID(recipient, &userID, &orgID)
+func (r *Resolver) SetCodeMonitorEmailOptOut(ctx context.Context, args *graphqlbackend.SetCodeMonitorEmailOptOutArgs) (*graphqlbackend.EmptyResponse, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, backend.ErrNotAuthenticated
+	}
+	var monitorID int64
+	err := relay.UnmarshalSpec(args.Id, &monitorID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	// Only recipients of the monitor's emails can opt out of them. To everyone
+	// else, the monitor doesn't exist.
+	ok, err := r.isEmailRecipient(ctx, monitorID, a.UID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, &monitorNotFoundError{id: args.Id}
 	}
-	// TODO: Send test email to org members.
-	if orgID != 0 {
-		return nil
+	err = r.store.SetEmailOptOut(ctx, monitorID, a.UID, args.OptOut)
+	if err != nil {
+		return nil, err
 	}
-	data := email.NewTestTemplateDataForNewSearchResults(ctx, description)
-	return email.SendEmailForNewSearchResult(ctx, userID, data)
+	return &graphqlbackend.EmptyResponse{}, nil
 }
 
 func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int64) (actionIDs []graphql.ID, err error) {
@@ -395,6 +449,75 @@ func (r *Resolver) isAllowedToCreate(ctx context.Context, owner graphql.ID) erro
 	}
 }
 
+// isAllowedToEmail checks whether an actor is allowed to send emails to the
+// given recipients. Organizations can only be emailed by their members and by
+// site admins.
+func (r *Resolver) isAllowedToEmail(ctx context.Context, recipients []graphql.ID) error {
+	for _, recipient := range recipients {
+		var (
+			userID int32
+			orgID  int32
+		)
+		if err := graphqlbackend.UnmarshalNamespaceID(recipient, &userID, &orgID); err != nil {
+			return err
+		}
+		if orgID == 0 {
+			continue
+		}
+		if err := backend.CheckOrgAccessOrSiteAdmin(ctx, database.NewDB(r.store.Handle().DB()), orgID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isEmailRecipient returns true if the user with the given ID is a recipient
+// of an email action of the monitor, either directly or as a member of a
+// recipient organization.
+func (r *Resolver) isEmailRecipient(ctx context.Context, monitorID int64, userID int32) (bool, error) {
+	emailActions, err := r.store.ListEmailActions(ctx, cm.ListActionsOpts{
+		MonitorID: intPtr(int(monitorID)),
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, e := range emailActions {
+		recs, err := r.store.AllRecipientsForEmailIDInt64(ctx, e.Id)
+		if err != nil {
+			return false, err
+		}
+		for _, rec := range recs {
+			switch {
+			case rec.NamespaceUserID != nil:
+				if *rec.NamespaceUserID == userID {
+					return true, nil
+				}
+			case rec.NamespaceOrgID != nil:
+				_, err := database.OrgMembersWith(r.store).GetByOrgIDAndUserID(ctx, *rec.NamespaceOrgID, userID)
+				if err == nil {
+					return true, nil
+				}
+				if !errcode.IsNotFound(err) {
+					return false, err
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// monitorNotFoundError is returned if a code monitor doesn't exist or must not
+// be visible to the actor.
+type monitorNotFoundError struct {
+	id graphql.ID
+}
+
+func (e *monitorNotFoundError) Error() string {
+	return fmt.Sprintf("code monitor not found: %s", e.id)
+}
+
+func (e *monitorNotFoundError) NotFound() bool { return true }
+
 func (r *Resolver) ownerForID64(ctx context.Context, monitorID int64) (owner graphql.ID, err error) {
 	monitor, err := r.store.MonitorByIDInt64(ctx, monitorID)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 )
 
 func TestCreateCodeMonitor(t *testing.T) {
@@ -310,6 +311,141 @@ func TestIsAllowedToCreate(t *testing.T) {
 	}
 }
 
+func TestIsAllowedToEmail(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	db := dbtesting.GetDB(t)
+
+	// Setup users and org
+	member := insertTestUser(t, db, "cm-user1", false)
+	notMember := insertTestUser(t, db, "cm-user2", false)
+	siteAdmin := insertTestUser(t, db, "cm-user3", true)
+
+	admContext := actor.WithActor(context.Background(), actor.FromUser(siteAdmin))
+	org, err := database.Orgs(db).Create(admContext, "cm-test-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addUserToOrg(t, db, member, org.ID)
+
+	r := newTestResolver(t, db)
+
+	orgRecipient := relay.MarshalID("Org", org.ID)
+	tests := []struct {
+		user    int32
+		allowed bool
+	}{
+		{
+			user:    member,
+			allowed: true,
+		},
+		{
+			user:    notMember,
+			allowed: false,
+		},
+		{
+			user:    siteAdmin,
+			allowed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("user %d", tt.user), func(t *testing.T) {
+			ctx := actor.WithActor(context.Background(), actor.FromUser(tt.user))
+			recipients := []graphql.ID{relay.MarshalID("User", tt.user), orgRecipient}
+			if err := r.isAllowedToEmail(ctx, recipients); (err != nil) == tt.allowed {
+				t.Fatalf("unexpected permissions for user %d", tt.user)
+			}
+		})
+	}
+
+	t.Run("cannot send test emails to an org the caller is not a member of", func(t *testing.T) {
+		ctx := actor.WithActor(context.Background(), actor.FromUser(notMember))
+		namespaceID := relay.MarshalID("User", notMember)
+		_, err := r.TriggerTestEmailAction(ctx, &graphqlbackend.TriggerTestEmailActionArgs{
+			Namespace:   namespaceID,
+			Description: "A code monitor name",
+			Email: &graphqlbackend.CreateActionEmailArgs{
+				Enabled:    true,
+				Priority:   "NORMAL",
+				Recipients: []graphql.ID{orgRecipient},
+				Header:     "test header 1",
+			},
+		})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestSetCodeMonitorEmailOptOut(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	db := dbtesting.GetDB(t)
+
+	// Setup users and org
+	owner := insertTestUser(t, db, "cm-user1", false)
+	member := insertTestUser(t, db, "cm-user2", false)
+	notRecipient := insertTestUser(t, db, "cm-user3", false)
+
+	ownerContext := actor.WithActor(context.Background(), actor.FromUser(owner))
+	org, err := database.Orgs(db).Create(ownerContext, "cm-test-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addUserToOrg(t, db, owner, org.ID)
+	addUserToOrg(t, db, member, org.ID)
+
+	r := newTestResolver(t, db)
+
+	m, err := r.insertTestMonitorWithOpts(ownerContext, t, WithActions([]*graphqlbackend.CreateActionArgs{
+		{Email: &graphqlbackend.CreateActionEmailArgs{
+			Enabled:    true,
+			Priority:   "NORMAL",
+			Recipients: []graphql.ID{relay.MarshalID("User", owner), relay.MarshalID("Org", org.ID)},
+			Header:     "test header"}},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		user    int32
+		allowed bool
+	}{
+		{
+			user:    owner,
+			allowed: true,
+		},
+		{
+			user:    member,
+			allowed: true,
+		},
+		{
+			user:    notRecipient,
+			allowed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("user %d", tt.user), func(t *testing.T) {
+			ctx := actor.WithActor(context.Background(), actor.FromUser(tt.user))
+			_, err := r.SetCodeMonitorEmailOptOut(ctx, &graphqlbackend.SetCodeMonitorEmailOptOutArgs{Id: m.ID(), OptOut: true})
+			if tt.allowed {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !errcode.IsNotFound(err) {
+				t.Fatalf("expected not found error, got %v", err)
+			}
+		})
+	}
+}
+
 type testUser struct {
 	name    string
 	idInt32 int32
@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"bufio"
 	"compress/gzip"
 	"context"
 	"fmt"
@@ -25,7 +26,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/conversion"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/pathexistence"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
 )
 
 type handler struct {
@@ -115,9 +118,9 @@ func (h *handler) handle(ctx context.Context, upload store.Upload) (requeued boo
 	}
 
 	return false, withUploadData(ctx, h.uploadStore, upload.ID, func(r io.Reader) (err error) {
-		groupedBundleData, err := conversion.Correlate(ctx, r, upload.Root, getChildren)
+		groupedBundleData, err := correlate(ctx, r, upload.Root, getChildren)
 		if err != nil {
-			return errors.Wrap(err, "conversion.Correlate")
+			return err
 		}
 
 		// Note: this is writing to a different database than the block below, so we need to use a
@@ -229,6 +232,28 @@ func requeueIfCloning(ctx context.Context, workerStore dbworkerstore.Store, uplo
 	return true, nil
 }
 
+// correlate converts the given raw upload data into grouped bundle data. Protobuf-encoded SCIP
+// indexes are detected by their leading bytes; all other uploads are read as LSIF.
+func correlate(ctx context.Context, r io.Reader, root string, getChildren pathexistence.GetChildrenFunc) (*precise.GroupedBundleDataChans, error) {
+	br := bufio.NewReader(r)
+
+	if prefix, err := br.Peek(1); err == nil && scip.IsIndexPrefix(prefix) {
+		groupedBundleData, err := conversion.CorrelateSCIP(ctx, br, root, getChildren)
+		if err != nil {
+			return nil, errors.Wrap(err, "conversion.CorrelateSCIP")
+		}
+
+		return groupedBundleData, nil
+	}
+
+	groupedBundleData, err := conversion.Correlate(ctx, br, root, getChildren)
+	if err != nil {
+		return nil, errors.Wrap(err, "conversion.Correlate")
+	}
+
+	return groupedBundleData, nil
+}
+
 // withUploadData will invoke the given function with a reader of the upload's raw data. The
 // consumer should expect raw newline-delimited JSON content. If the function returns without
 // an error, the upload file will be deleted.
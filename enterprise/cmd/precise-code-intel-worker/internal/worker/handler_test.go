@@ -1,6 +1,8 @@
 package worker
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"io"
 	"os"
@@ -19,6 +21,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/bloomfilter"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip/sciptest"
 )
 
 func TestHandle(t *testing.T) {
@@ -157,6 +161,80 @@ func TestHandle(t *testing.T) {
 	}
 }
 
+func TestHandleSCIP(t *testing.T) {
+	setupRepoMocks(t)
+
+	upload := dbstore.Upload{
+		ID:           42,
+		Root:         "root/",
+		Commit:       "deadbeef",
+		RepositoryID: 50,
+		Indexer:      "scip-go",
+	}
+
+	mockWorkerStore := NewMockWorkerStore()
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockUploadStore := uploadstoremocks.NewMockStore()
+	gitserverClient := NewMockGitserverClient()
+
+	// Set default transaction behavior
+	mockDBStore.TransactFunc.SetDefaultReturn(mockDBStore, nil)
+	mockDBStore.DoneFunc.SetDefaultHook(func(err error) error { return err })
+
+	// Set default transaction behavior
+	mockLSIFStore.TransactFunc.SetDefaultReturn(mockLSIFStore, nil)
+	mockDBStore.DoneFunc.SetDefaultHook(func(err error) error { return err })
+
+	// Give correlation package a valid SCIP index
+	mockUploadStore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return generateTestSCIPIndex(t), nil
+	})
+
+	// Allowlist all files in dump
+	gitserverClient.DirectoryChildrenFunc.SetDefaultReturn(map[string][]string{
+		"": {"foo.go"},
+	}, nil)
+
+	gitserverClient.CommitDateFunc.SetDefaultReturn("deadbeef", time.Unix(1587396557, 0).UTC(), true, nil)
+
+	handler := &handler{
+		dbStore:         mockDBStore,
+		workerStore:     mockWorkerStore,
+		lsifStore:       mockLSIFStore,
+		uploadStore:     mockUploadStore,
+		gitserverClient: gitserverClient,
+	}
+
+	requeued, err := handler.handle(context.Background(), upload)
+	if err != nil {
+		t.Fatalf("unexpected error handling upload: %s", err)
+	} else if requeued {
+		t.Errorf("unexpected requeue")
+	}
+
+	expectedPackages := []precise.Package{
+		{
+			Scheme:  "scip-go",
+			Name:    "example",
+			Version: "v1.0.0",
+		},
+	}
+	if len(mockDBStore.UpdatePackagesFunc.History()) != 1 {
+		t.Errorf("unexpected number of UpdatePackages calls. want=%d have=%d", 1, len(mockDBStore.UpdatePackagesFunc.History()))
+	} else if diff := cmp.Diff(expectedPackages, mockDBStore.UpdatePackagesFunc.History()[0].Arg2); diff != "" {
+		t.Errorf("unexpected UpdatePackagesFunc args (-want +got):\n%s", diff)
+	}
+
+	if len(mockLSIFStore.WriteDocumentsFunc.History()) != 1 {
+		t.Errorf("unexpected number of WriteDocuments calls. want=%d have=%d", 1, len(mockLSIFStore.WriteDocumentsFunc.History()))
+	}
+
+	if len(mockUploadStore.DeleteFunc.History()) != 1 {
+		t.Errorf("unexpected number of Delete calls. want=%d have=%d", 1, len(mockUploadStore.DeleteFunc.History()))
+	}
+}
+
 func TestHandleError(t *testing.T) {
 	setupRepoMocks(t)
 
@@ -273,6 +351,36 @@ func copyTestDump(ctx context.Context, key string) (io.ReadCloser, error) {
 	return os.Open("../../testdata/dump1.lsif.gz")
 }
 
+func generateTestSCIPIndex(t *testing.T) io.ReadCloser {
+	metadata := &scip.Metadata{
+		ToolInfo:    &scip.ToolInfo{Name: "scip-go"},
+		ProjectRoot: "file:///test/root",
+	}
+	documents := []*scip.Document{
+		{
+			RelativePath: "foo.go",
+			Occurrences: []*scip.Occurrence{
+				{
+					Range:       []int32{1, 5, 8},
+					Symbol:      "scip-go gomod example v1.0.0 `example`/Foo().",
+					SymbolRoles: int32(scip.SymbolRole_Definition),
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	if err := sciptest.WriteIndex(gzipWriter, metadata, documents, nil); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("unexpected error closing gzip writer: %s", err)
+	}
+
+	return io.NopCloser(&buf)
+}
+
 func setupRepoMocks(t *testing.T) {
 	t.Cleanup(func() {
 		backend.Mocks.Repos.Get = nil
@@ -0,0 +1,126 @@
+package bitbucketcloud
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// NewAuthzProviders returns the set of Bitbucket Cloud authz providers derived from the connections.
+// It also returns any validation problems with the config, separating these into "serious problems" and
+// "warnings". "Serious problems" are those that should make Sourcegraph set authz.allowAccessByDefault
+// to false. "Warnings" are all other validation problems.
+func NewAuthzProviders(
+	conns []*types.BitbucketCloudConnection,
+	authProviders []schema.AuthProviders,
+) (ps []authz.Provider, problems []string, warnings []string) {
+	// Auth providers (i.e. login mechanisms)
+	bitbucketCloudAuthProviders := make(map[string]*schema.BitbucketCloudAuthProvider)
+	for _, p := range authProviders {
+		if p.Bitbucketcloud == nil {
+			continue
+		}
+		rawURL := p.Bitbucketcloud.Url
+		if rawURL == "" {
+			rawURL = "https://bitbucket.org/"
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			// error reporting for this should happen elsewhere
+			continue
+		}
+		bitbucketCloudAuthProviders[extsvc.NewCodeHost(u, extsvc.TypeBitbucketCloud).ServiceID] = p.Bitbucketcloud
+	}
+
+	for _, c := range conns {
+		authProvider := bitbucketCloudAuthProviders[serviceID(c.Url)]
+		p, err := newAuthzProvider(c, authProvider)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		} else if p == nil {
+			continue
+		}
+
+		// Permissions require a corresponding Bitbucket Cloud OAuth provider. Without one,
+		// users can't be linked to their Bitbucket Cloud accounts and repos with restricted
+		// permissions will not be visible to non-admins.
+		if authProvider == nil {
+			warnings = append(warnings,
+				fmt.Sprintf("Bitbucket Cloud config for %[1]s has `authorization` enabled, "+
+					"but no authentication provider matching %[1]q was found. "+
+					"Check the [**site configuration**](/site-admin/configuration) to "+
+					"verify an entry in [`auth.providers`](https://docs.sourcegraph.com/admin/auth) exists for %[1]s.",
+					p.ServiceID()))
+		}
+
+		for _, problem := range p.Validate() {
+			warnings = append(warnings, fmt.Sprintf("Bitbucket Cloud config for %s was invalid: %s", p.ServiceID(), problem))
+		}
+
+		ps = append(ps, p)
+	}
+
+	return ps, problems, warnings
+}
+
+func newAuthzProvider(
+	c *types.BitbucketCloudConnection,
+	authProvider *schema.BitbucketCloudAuthProvider,
+) (*Provider, error) {
+	if c.Authorization == nil {
+		return nil, nil
+	}
+
+	baseURL, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Errorf("Could not parse URL for Bitbucket Cloud external service config: %s", err)
+	}
+
+	rawAPIURL := c.ApiURL
+	if rawAPIURL == "" {
+		rawAPIURL = "https://api.bitbucket.org"
+	}
+	apiURL, err := url.Parse(rawAPIURL)
+	if err != nil {
+		return nil, errors.Errorf("Could not parse API URL for Bitbucket Cloud external service config: %s", err)
+	}
+
+	cli := bitbucketcloud.NewClient(apiURL, nil)
+	cli.Username = c.Username
+	cli.AppPassword = c.AppPassword
+
+	var oauth2Config *oauth2.Config
+	if authProvider != nil {
+		oauth2Config = &oauth2.Config{
+			ClientID:     authProvider.ClientKey,
+			ClientSecret: authProvider.ClientSecret,
+			Endpoint:     bitbucketcloud.OAuthEndpoint(baseURL),
+		}
+	}
+
+	return NewProvider(cli, c.URN, baseURL, oauth2Config), nil
+}
+
+// ValidateAuthz validates the authorization fields of the given Bitbucket Cloud external
+// service config.
+func ValidateAuthz(c *schema.BitbucketCloudConnection) error {
+	_, err := newAuthzProvider(&types.BitbucketCloudConnection{BitbucketCloudConnection: c}, nil)
+	return err
+}
+
+func serviceID(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return extsvc.NewCodeHost(u, extsvc.TypeBitbucketCloud).ServiceID
+}
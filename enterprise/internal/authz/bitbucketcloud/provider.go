@@ -0,0 +1,196 @@
+// Package bitbucketcloud contains an authorization provider for Bitbucket Cloud.
+package bitbucketcloud
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// Provider is an implementation of AuthzProvider that provides repository permissions as
+// determined from the Bitbucket Cloud API.
+//
+// User-centric permissions are fetched with the OAuth token of the user, which is stored
+// in their external account when they sign in with a Bitbucket Cloud authentication
+// provider. Repository-centric permissions are fetched with the credentials of the external
+// service, which must belong to an administrator of the workspace of the repository.
+type Provider struct {
+	urn      string
+	client   *bitbucketcloud.Client
+	codeHost *extsvc.CodeHost
+
+	// oauth2Config is the configuration of the OAuth consumer that users sign in with. It
+	// is used to refresh expired user tokens, and may be nil.
+	oauth2Config *oauth2.Config
+}
+
+var _ authz.Provider = (*Provider)(nil)
+
+// NewProvider returns a new Bitbucket Cloud authorization provider for the Bitbucket Cloud
+// at baseURL. The given bitbucketcloud.Client is used for repository-centric permissions
+// syncing and must be authenticated as a workspace administrator.
+func NewProvider(cli *bitbucketcloud.Client, urn string, baseURL *url.URL, oauth2Config *oauth2.Config) *Provider {
+	return &Provider{
+		urn:          urn,
+		client:       cli,
+		codeHost:     extsvc.NewCodeHost(baseURL, extsvc.TypeBitbucketCloud),
+		oauth2Config: oauth2Config,
+	}
+}
+
+// Validate validates that the Provider has access to the Bitbucket Cloud API with the
+// credentials it was configured with.
+func (p *Provider) Validate() (problems []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := p.client.CurrentUser(ctx); err != nil {
+		return []string{err.Error()}
+	}
+	return nil
+}
+
+func (p *Provider) URN() string {
+	return p.urn
+}
+
+// ServiceID returns the absolute URL that identifies the Bitbucket Cloud this provider is
+// configured with.
+func (p *Provider) ServiceID() string { return p.codeHost.ServiceID }
+
+// ServiceType returns the type of this Provider, namely, "bitbucketCloud".
+func (p *Provider) ServiceType() string { return p.codeHost.ServiceType }
+
+// FetchAccount satisfies the authz.Provider interface. Bitbucket Cloud accounts are only
+// linked when users sign in with a Bitbucket Cloud authentication provider.
+func (p *Provider) FetchAccount(context.Context, *types.User, []*extsvc.Account, []string) (mine *extsvc.Account, err error) {
+	return nil, nil
+}
+
+// FetchUserPerms returns a list of repository UUIDs (on code host) that the given account
+// has read access to. The repository UUID has the same value as it would be used as
+// api.ExternalRepoSpec.ID. The returned list includes access that is inherited from
+// workspace, project and group membership.
+//
+// This method may return partial but valid results in case of error, and it is up to
+// callers to decide whether to discard.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-permissions-repositories-get
+func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account, opts authz.FetchPermsOptions) (*authz.ExternalUserPermissions, error) {
+	switch {
+	case account == nil:
+		return nil, errors.New("no account provided")
+	case !extsvc.IsHostOfAccount(p.codeHost, account):
+		return nil, errors.Errorf("not a code host of the account: want %q but have %q",
+			p.codeHost.ServiceID, account.AccountSpec.ServiceID)
+	}
+
+	_, tok, err := bitbucketcloud.GetExternalAccountData(&account.AccountData)
+	if err != nil {
+		return nil, errors.Wrap(err, "get external account data")
+	} else if tok == nil {
+		return nil, errors.New("no token found in the external account data")
+	}
+
+	// Bitbucket Cloud access tokens expire after two hours, so they usually need to be
+	// refreshed first.
+	if p.oauth2Config != nil {
+		tok, err = p.oauth2Config.TokenSource(context.WithValue(ctx, oauth2.HTTPClient, httpcli.ExternalClient), tok).Token()
+		if err != nil {
+			return nil, errors.Wrap(err, "refresh token")
+		}
+	}
+
+	return p.FetchUserPermsByToken(ctx, tok.AccessToken, opts)
+}
+
+// FetchUserPermsByToken is the same as FetchUserPerms, but it only requires a
+// token.
+func (p *Provider) FetchUserPermsByToken(ctx context.Context, token string, opts authz.FetchPermsOptions) (*authz.ExternalUserPermissions, error) {
+	client, err := p.client.WithAuthenticator(&auth.OAuthBearerToken{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		perms  []*bitbucketcloud.RepoPermission
+		next   *bitbucketcloud.PageToken
+		extIDs []extsvc.RepoID
+	)
+	for {
+		perms, next, err = client.CurrentUserRepoPermissions(ctx, next)
+		for _, perm := range perms {
+			if perm.Repository != nil {
+				extIDs = append(extIDs, extsvc.RepoID(perm.Repository.UUID))
+			}
+		}
+		if err != nil || !next.HasMore() {
+			break
+		}
+	}
+
+	return &authz.ExternalUserPermissions{
+		Exacts: extIDs,
+	}, err
+}
+
+// FetchRepoPerms returns a list of user UUIDs (on code host) who have read access to the
+// given repository on the code host. The user UUID has the same value as it would be used
+// as extsvc.Account.AccountID. The returned list includes access that is inherited from
+// workspace, project and group membership.
+//
+// This method may return partial but valid results in case of error, and it is up to
+// callers to decide whether to discard.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-repositories-repo-slug-get
+func (p *Provider) FetchRepoPerms(ctx context.Context, repo *extsvc.Repository, opts authz.FetchPermsOptions) ([]extsvc.AccountID, error) {
+	switch {
+	case repo == nil:
+		return nil, errors.New("no repo provided")
+	case !extsvc.IsHostOfRepo(p.codeHost, &repo.ExternalRepoSpec):
+		return nil, errors.Errorf("not a code host of the repo: want %q but have %q",
+			p.codeHost.ServiceID, repo.ServiceID)
+	}
+
+	// The repository may have been renamed or moved to another workspace since it was
+	// synced, so we look up its current full name by UUID.
+	r, err := p.client.RepoByUUID(ctx, repo.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get repository")
+	}
+	i := strings.Index(r.FullName, "/")
+	if i < 0 {
+		return nil, errors.Errorf("unexpected full name %q of repository %s", r.FullName, repo.ID)
+	}
+	workspace, slug := r.FullName[:i], r.FullName[i+1:]
+
+	var (
+		perms  []*bitbucketcloud.RepoPermission
+		next   *bitbucketcloud.PageToken
+		extIDs []extsvc.AccountID
+	)
+	for {
+		perms, next, err = p.client.RepoUserPermissions(ctx, next, workspace, slug)
+		for _, perm := range perms {
+			if perm.User != nil {
+				extIDs = append(extIDs, extsvc.AccountID(perm.User.UUID))
+			}
+		}
+		if err != nil || !next.HasMore() {
+			break
+		}
+	}
+
+	return extIDs, err
+}
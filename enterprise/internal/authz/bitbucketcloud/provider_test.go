@@ -0,0 +1,245 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// newTestServer returns a fake Bitbucket Cloud that serves both the API and the OAuth
+// token endpoint.
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		admin := func() bool {
+			user, password, ok := r.BasicAuth()
+			return ok && user == "admin" && password == "secret"
+		}
+
+		switch {
+		case r.URL.Path == "/site/oauth2/access_token":
+			if r.FormValue("grant_type") != "refresh_token" || r.FormValue("refresh_token") != "refresh" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"access_token": "fresh", "token_type": "bearer", "expires_in": 7200, "refresh_token": "refresh"}`)
+
+		case r.URL.Path == "/2.0/user/permissions/repositories":
+			if r.Header.Get("Authorization") != "Bearer fresh" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprint(w, `{"values": [{"permission": "read", "repository": {"uuid": "{repo-2}"}}]}`)
+				return
+			}
+			fmt.Fprintf(w, `{"values": [{"permission": "admin", "repository": {"uuid": "{repo-1}"}}], "next": "%s/2.0/user/permissions/repositories?page=2"}`, srv.URL)
+
+		case r.URL.Path == "/2.0/user" && admin():
+			fmt.Fprint(w, `{"uuid": "{admin}", "nickname": "admin"}`)
+
+		case r.URL.Path == "/2.0/repositories/{}/{repo-1}" && admin():
+			fmt.Fprint(w, `{"uuid": "{repo-1}", "slug": "mux", "full_name": "sglocal/mux"}`)
+
+		case r.URL.Path == "/2.0/workspaces/sglocal/permissions/repositories/mux" && admin():
+			fmt.Fprint(w, `{"values": [{"permission": "admin", "user": {"uuid": "{alice}"}}, {"permission": "read", "user": {"uuid": "{bob}"}}]}`)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestProvider(t *testing.T, srv *httptest.Server, withOAuth bool) *Provider {
+	t.Helper()
+
+	conn := &types.BitbucketCloudConnection{
+		URN: "extsvc:bitbucketcloud:1",
+		BitbucketCloudConnection: &schema.BitbucketCloudConnection{
+			Url:           srv.URL,
+			ApiURL:        srv.URL,
+			Username:      "admin",
+			AppPassword:   "secret",
+			Authorization: &schema.BitbucketCloudAuthorization{},
+		},
+	}
+	var authProvider *schema.BitbucketCloudAuthProvider
+	if withOAuth {
+		authProvider = &schema.BitbucketCloudAuthProvider{Url: srv.URL, ClientKey: "key", ClientSecret: "secret"}
+	}
+	p, err := newAuthzProvider(conn, authProvider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestProvider_FetchUserPerms(t *testing.T) {
+	srv := newTestServer(t)
+	p := newTestProvider(t, srv, true)
+
+	t.Run("nil account", func(t *testing.T) {
+		if _, err := p.FetchUserPerms(context.Background(), nil, authz.FetchPermsOptions{}); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("not the code host of the account", func(t *testing.T) {
+		account := &extsvc.Account{AccountSpec: extsvc.AccountSpec{ServiceType: extsvc.TypeGitLab, ServiceID: "https://gitlab.com/"}}
+		if _, err := p.FetchUserPerms(context.Background(), account, authz.FetchPermsOptions{}); err == nil || !strings.Contains(err.Error(), "not a code host of the account") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("expired token is refreshed", func(t *testing.T) {
+		account := &extsvc.Account{AccountSpec: extsvc.AccountSpec{ServiceType: p.ServiceType(), ServiceID: p.ServiceID(), AccountID: "{alice}"}}
+		bitbucketcloud.SetExternalAccountData(&account.AccountData,
+			&bitbucketcloud.Account{UUID: "{alice}"},
+			&oauth2.Token{AccessToken: "stale", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)},
+		)
+
+		perms, err := p.FetchUserPerms(context.Background(), account, authz.FetchPermsOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &authz.ExternalUserPermissions{Exacts: []extsvc.RepoID{"{repo-1}", "{repo-2}"}}
+		if diff := cmp.Diff(want, perms); diff != "" {
+			t.Fatalf("unexpected permissions (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		account := &extsvc.Account{AccountSpec: extsvc.AccountSpec{ServiceType: p.ServiceType(), ServiceID: p.ServiceID()}}
+		data := json.RawMessage(`{"uuid": "{alice}"}`)
+		account.Data = &data
+		if _, err := p.FetchUserPerms(context.Background(), account, authz.FetchPermsOptions{}); err == nil || !strings.Contains(err.Error(), "no token") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestProvider_FetchUserPermsByToken(t *testing.T) {
+	srv := newTestServer(t)
+	p := newTestProvider(t, srv, false)
+
+	perms, err := p.FetchUserPermsByToken(context.Background(), "fresh", authz.FetchPermsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &authz.ExternalUserPermissions{Exacts: []extsvc.RepoID{"{repo-1}", "{repo-2}"}}
+	if diff := cmp.Diff(want, perms); diff != "" {
+		t.Fatalf("unexpected permissions (-want +got):\n%s", diff)
+	}
+
+	// Partial results are returned along with the error.
+	perms, err = p.FetchUserPermsByToken(context.Background(), "revoked", authz.FetchPermsOptions{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if len(perms.Exacts) != 0 {
+		t.Fatalf("unexpected permissions: %v", perms.Exacts)
+	}
+}
+
+func TestProvider_FetchRepoPerms(t *testing.T) {
+	srv := newTestServer(t)
+	p := newTestProvider(t, srv, false)
+
+	t.Run("not the code host of the repo", func(t *testing.T) {
+		repo := &extsvc.Repository{ExternalRepoSpec: api.ExternalRepoSpec{ID: "{repo-1}", ServiceType: extsvc.TypeGitLab, ServiceID: "https://gitlab.com/"}}
+		if _, err := p.FetchRepoPerms(context.Background(), repo, authz.FetchPermsOptions{}); err == nil || !strings.Contains(err.Error(), "not a code host of the repo") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("repo", func(t *testing.T) {
+		repo := &extsvc.Repository{ExternalRepoSpec: api.ExternalRepoSpec{ID: "{repo-1}", ServiceType: p.ServiceType(), ServiceID: p.ServiceID()}}
+		ids, err := p.FetchRepoPerms(context.Background(), repo, authz.FetchPermsOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]extsvc.AccountID{"{alice}", "{bob}"}, ids); diff != "" {
+			t.Fatalf("unexpected account IDs (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("unknown repo", func(t *testing.T) {
+		repo := &extsvc.Repository{ExternalRepoSpec: api.ExternalRepoSpec{ID: "{missing}", ServiceType: p.ServiceType(), ServiceID: p.ServiceID()}}
+		if _, err := p.FetchRepoPerms(context.Background(), repo, authz.FetchPermsOptions{}); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestNewAuthzProviders(t *testing.T) {
+	srv := newTestServer(t)
+	u, _ := url.Parse(srv.URL)
+	serviceID := extsvc.NewCodeHost(u, extsvc.TypeBitbucketCloud).ServiceID
+
+	conn := func(authorization *schema.BitbucketCloudAuthorization) *types.BitbucketCloudConnection {
+		return &types.BitbucketCloudConnection{
+			URN: "extsvc:bitbucketcloud:1",
+			BitbucketCloudConnection: &schema.BitbucketCloudConnection{
+				Url:           srv.URL,
+				ApiURL:        srv.URL,
+				Username:      "admin",
+				AppPassword:   "secret",
+				Authorization: authorization,
+			},
+		}
+	}
+
+	t.Run("no authorization", func(t *testing.T) {
+		ps, problems, warnings := NewAuthzProviders([]*types.BitbucketCloudConnection{conn(nil)}, nil)
+		if len(ps) != 0 || len(problems) != 0 || len(warnings) != 0 {
+			t.Fatalf("unexpected result: %v, %v, %v", ps, problems, warnings)
+		}
+	})
+
+	t.Run("no matching auth provider", func(t *testing.T) {
+		ps, problems, warnings := NewAuthzProviders(
+			[]*types.BitbucketCloudConnection{conn(&schema.BitbucketCloudAuthorization{})},
+			[]schema.AuthProviders{{Bitbucketcloud: &schema.BitbucketCloudAuthProvider{Url: "https://bitbucket.org"}}},
+		)
+		if len(ps) != 1 || len(problems) != 0 {
+			t.Fatalf("unexpected result: %v, %v", ps, problems)
+		}
+		if len(warnings) != 1 || !strings.Contains(warnings[0], "no authentication provider matching") {
+			t.Fatalf("unexpected warnings: %v", warnings)
+		}
+	})
+
+	t.Run("matching auth provider", func(t *testing.T) {
+		ps, problems, warnings := NewAuthzProviders(
+			[]*types.BitbucketCloudConnection{conn(&schema.BitbucketCloudAuthorization{})},
+			[]schema.AuthProviders{{Bitbucketcloud: &schema.BitbucketCloudAuthProvider{Url: srv.URL, ClientKey: "key"}}},
+		)
+		if len(ps) != 1 || len(problems) != 0 || len(warnings) != 0 {
+			t.Fatalf("unexpected result: %v, %v, %v", ps, problems, warnings)
+		}
+		if ps[0].ServiceID() != serviceID || ps[0].ServiceType() != extsvc.TypeBitbucketCloud {
+			t.Fatalf("unexpected provider: %s %s", ps[0].ServiceType(), ps[0].ServiceID())
+		}
+	})
+}
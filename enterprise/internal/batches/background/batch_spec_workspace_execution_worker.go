@@ -14,6 +14,7 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"github.com/lib/pq"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/global"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -239,6 +240,11 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 		return false, tx.Done(err)
 	}
 
+	err = attachRebasedChangesetSpecs(ctx, tx, job.BatchSpecWorkspaceID, changesetSpecIDs)
+	if err != nil {
+		return false, tx.Done(err)
+	}
+
 	ok, err := s.Store.With(tx).MarkComplete(ctx, id, options)
 	return ok, tx.Done(err)
 }
@@ -290,6 +296,66 @@ func setChangesetSpecIDs(ctx context.Context, tx *store.Store, batchSpecWorkspac
 	return tx.Exec(ctx, sqlf.Sprintf(setChangesetSpecIDsOnBatchSpecWorkspaceQueryFmtstr, marshaledIDs, batchSpecWorkspaceID))
 }
 
+// attachRebasedChangesetSpecs attaches the given changeset specs to the
+// changeset the workspace rebases, if the reconciler created the workspace to
+// rebase a changeset with an auto-rebase policy onto its new base. The
+// changeset is enqueued, so that the reconciler pushes the new commit.
+func attachRebasedChangesetSpecs(ctx context.Context, tx *store.Store, batchSpecWorkspaceID int64, changesetSpecIDs []int64) error {
+	if len(changesetSpecIDs) == 0 {
+		return nil
+	}
+
+	workspace, err := tx.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ID: batchSpecWorkspaceID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch spec workspace")
+	}
+	if workspace.RebaseChangesetID == 0 {
+		return nil
+	}
+
+	ch, err := tx.GetChangeset(ctx, store.GetChangesetOpts{ID: workspace.RebaseChangesetID})
+	if err == store.ErrNoResults {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "loading changeset")
+	}
+	if ch.CurrentSpecID == 0 {
+		return nil
+	}
+
+	current, err := tx.GetChangesetSpecByID(ctx, ch.CurrentSpecID)
+	if err != nil {
+		return errors.Wrap(err, "loading current changeset spec")
+	}
+	if !current.Spec.AutoRebase {
+		// The batch change has been applied with a new batch spec in the
+		// meantime, which doesn't want the changeset to be rebased anymore.
+		return nil
+	}
+
+	specs, _, err := tx.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{IDs: changesetSpecIDs})
+	if err != nil {
+		return errors.Wrap(err, "loading changeset specs")
+	}
+
+	for _, spec := range specs {
+		if spec.Spec.HeadRef != current.Spec.HeadRef {
+			continue
+		}
+
+		ch.PreviousSpecID = ch.CurrentSpecID
+		ch.SetCurrentSpec(spec)
+		ch.ResetReconcilerState(global.DefaultReconcilerEnqueueState())
+		if err := tx.UpdateChangeset(ctx, ch); err != nil {
+			return errors.Wrap(err, "updating changeset")
+		}
+		break
+	}
+
+	return nil
+}
+
 func extractCacheEntries(ctx context.Context, events []*batcheslib.LogEvent) ([]*btypes.BatchSpecExecutionCacheEntry, error) {
 	var entries []*btypes.BatchSpecExecutionCacheEntry
 
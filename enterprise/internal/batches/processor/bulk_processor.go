@@ -101,6 +101,10 @@ func (b *bulkProcessor) Process(ctx context.Context, job *btypes.ChangesetJob) (
 		return b.closeChangeset(ctx, job)
 	case btypes.ChangesetJobTypePublish:
 		return b.publishChangeset(ctx, job)
+	case btypes.ChangesetJobTypeRequestReviewers:
+		return b.requestReviewers(ctx, job)
+	case btypes.ChangesetJobTypeAddLabels:
+		return b.addLabels(ctx, job)
 
 	default:
 		return &unknownJobTypeErr{jobType: string(job.JobType)}
@@ -169,35 +173,59 @@ func (b *bulkProcessor) mergeChangeset(ctx context.Context, job *btypes.Changese
 		return err
 	}
 
-	events, err := cs.Changeset.Events()
-	if err != nil {
-		log15.Error("Events", "err", err)
-		return errcode.MakeNonRetryable(err)
+	return b.updateCodeHostState(ctx, cs)
+}
+
+func (b *bulkProcessor) closeChangeset(ctx context.Context, job *btypes.ChangesetJob) (err error) {
+	cs := &sources.Changeset{
+		Changeset: b.ch,
+		Repo:      b.repo,
+	}
+	if err := b.css.CloseChangeset(ctx, cs); err != nil {
+		return err
 	}
-	state.SetDerivedState(ctx, b.tx.Repos(), cs.Changeset, events)
 
-	if err := b.tx.UpsertChangesetEvents(ctx, events...); err != nil {
-		log15.Error("UpsertChangesetEvents", "err", err)
-		return errcode.MakeNonRetryable(err)
+	return b.updateCodeHostState(ctx, cs)
+}
+
+func (b *bulkProcessor) requestReviewers(ctx context.Context, job *btypes.ChangesetJob) (err error) {
+	typedPayload, ok := job.Payload.(*btypes.ChangesetJobRequestReviewersPayload)
+	if !ok {
+		return errors.Errorf("invalid payload type for changeset_job, want=%T have=%T", &btypes.ChangesetJobRequestReviewersPayload{}, job.Payload)
 	}
 
-	if err := b.tx.UpdateChangesetCodeHostState(ctx, cs.Changeset); err != nil {
-		log15.Error("UpdateChangeset", "err", err)
-		return errcode.MakeNonRetryable(err)
+	cs := &sources.Changeset{
+		Changeset: b.ch,
+		Repo:      b.repo,
+	}
+	if err := b.css.RequestReviewers(ctx, cs, typedPayload.Reviewers, typedPayload.TeamReviewers); err != nil {
+		return err
 	}
 
-	return nil
+	return b.updateCodeHostState(ctx, cs)
 }
 
-func (b *bulkProcessor) closeChangeset(ctx context.Context, job *btypes.ChangesetJob) (err error) {
+func (b *bulkProcessor) addLabels(ctx context.Context, job *btypes.ChangesetJob) (err error) {
+	typedPayload, ok := job.Payload.(*btypes.ChangesetJobAddLabelsPayload)
+	if !ok {
+		return errors.Errorf("invalid payload type for changeset_job, want=%T have=%T", &btypes.ChangesetJobAddLabelsPayload{}, job.Payload)
+	}
+
 	cs := &sources.Changeset{
 		Changeset: b.ch,
 		Repo:      b.repo,
 	}
-	if err := b.css.CloseChangeset(ctx, cs); err != nil {
+	if err := b.css.AddLabels(ctx, cs, typedPayload.Labels); err != nil {
 		return err
 	}
 
+	return b.updateCodeHostState(ctx, cs)
+}
+
+// updateCodeHostState persists the metadata of the changeset that the
+// ChangesetSource has updated, along with the events and derived state
+// computed from it.
+func (b *bulkProcessor) updateCodeHostState(ctx context.Context, cs *sources.Changeset) error {
 	events, err := cs.Changeset.Events()
 	if err != nil {
 		log15.Error("Events", "err", err)
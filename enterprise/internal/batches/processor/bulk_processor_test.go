@@ -211,6 +211,48 @@ func TestBulkProcessor(t *testing.T) {
 		}
 	})
 
+	t.Run("Request reviewers job", func(t *testing.T) {
+		fake := &sources.FakeChangesetSource{}
+		bp := &bulkProcessor{
+			tx:      bstore,
+			sourcer: sources.NewFakeSourcer(nil, fake),
+		}
+		job := &types.ChangesetJob{
+			JobType:     types.ChangesetJobTypeRequestReviewers,
+			ChangesetID: changeset.ID,
+			UserID:      user.ID,
+			Payload:     &btypes.ChangesetJobRequestReviewersPayload{Reviewers: []string{"alice"}},
+		}
+		err := bp.Process(ctx, job)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !fake.RequestReviewersCalled {
+			t.Fatal("expected RequestReviewers to be called but wasn't")
+		}
+	})
+
+	t.Run("Add labels job", func(t *testing.T) {
+		fake := &sources.FakeChangesetSource{}
+		bp := &bulkProcessor{
+			tx:      bstore,
+			sourcer: sources.NewFakeSourcer(nil, fake),
+		}
+		job := &types.ChangesetJob{
+			JobType:     types.ChangesetJobTypeAddLabels,
+			ChangesetID: changeset.ID,
+			UserID:      user.ID,
+			Payload:     &btypes.ChangesetJobAddLabelsPayload{Labels: []string{"batch-change"}},
+		}
+		err := bp.Process(ctx, job)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !fake.AddLabelsCalled {
+			t.Fatal("expected AddLabels to be called but wasn't")
+		}
+	})
+
 	t.Run("Publish job", func(t *testing.T) {
 		fake := &sources.FakeChangesetSource{FakeMetadata: &github.PullRequest{}}
 		bp := &bulkProcessor{
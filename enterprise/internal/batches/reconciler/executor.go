@@ -91,6 +91,12 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (err error) {
 		case btypes.ReconcilerOperationClose:
 			err = e.closeChangeset(ctx)
 
+		case btypes.ReconcilerOperationMerge:
+			err = e.mergeChangeset(ctx)
+
+		case btypes.ReconcilerOperationRebase:
+			err = e.rebaseChangeset(ctx)
+
 		case btypes.ReconcilerOperationSleep:
 			e.sleep()
 
@@ -159,6 +165,90 @@ func (e *executor) pushChangesetPatch(ctx context.Context) (err error) {
 	return e.pushCommit(ctx, opts)
 }
 
+// rebaseChangeset rebases the changeset onto the commit of its base branch
+// that the syncer recorded in its sync state. If the changeset spec has been
+// created by a workspace of a batch spec that was executed on Sourcegraph, the
+// workspace is executed again against the new base. The resulting changeset
+// spec replaces the current one once the execution completed, which makes the
+// reconciler push the new commit. Otherwise, the existing diff is applied to
+// the new base and force-pushed. Either way, the commit is recorded in the
+// sync state, so that the changeset isn't rebased onto it again.
+func (e *executor) rebaseChangeset(ctx context.Context) (err error) {
+	onto := e.ch.SyncState.RebaseOnto
+
+	workspace, err := e.tx.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ChangesetSpecID: e.spec.ID})
+	if err != nil && err != store.ErrNoResults {
+		return errors.Wrap(err, "loading batch spec workspace")
+	}
+	if err == store.ErrNoResults {
+		err = e.pushChangesetPatchOnto(ctx, onto)
+		if isPatchApplyError(err) {
+			// The diff conflicts with the new base. Retrying won't help, so
+			// we wait for the base branch to move or a new changeset spec.
+			log15.Warn("Diff of changeset with auto-rebase policy doesn't apply to new base", "changeset", e.ch.ID, "onto", onto, "err", err)
+			e.ch.SyncState.RebaseOnto = ""
+			e.ch.SyncState.RebaseFailedOnto = onto
+			return nil
+		}
+		if err != nil {
+			return describeCreateCommitFromPatchError(err)
+		}
+		e.ch.SyncState.RebaseOnto = ""
+		e.ch.SyncState.RebasedOnto = onto
+		return nil
+	}
+
+	rebased := &btypes.BatchSpecWorkspace{
+		BatchSpecID:        workspace.BatchSpecID,
+		RepoID:             workspace.RepoID,
+		Branch:             workspace.Branch,
+		Commit:             onto,
+		Path:               workspace.Path,
+		Steps:              workspace.Steps,
+		FileMatches:        workspace.FileMatches,
+		OnlyFetchWorkspace: workspace.OnlyFetchWorkspace,
+		RebaseChangesetID:  e.ch.ID,
+	}
+	if err := e.tx.CreateBatchSpecWorkspace(ctx, rebased); err != nil {
+		return errors.Wrap(err, "creating batch spec workspace")
+	}
+	if err := e.tx.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, []int64{rebased.ID}); err != nil {
+		return errors.Wrap(err, "creating batch spec workspace execution job")
+	}
+	e.ch.SyncState.RebaseOnto = ""
+	e.ch.SyncState.RebasedOnto = onto
+	return nil
+}
+
+// pushChangesetPatchOnto applies the diff of the changeset spec to the given
+// base commit and force-pushes the resulting commit. Errors returned by
+// gitserver are returned as is, so that the caller can inspect them.
+func (e *executor) pushChangesetPatchOnto(ctx context.Context, baseCommit string) (err error) {
+	css, err := e.changesetSource(ctx)
+	if err != nil {
+		return err
+	}
+	pushConf, err := css.GitserverPushConfig(ctx, e.tx.ExternalServices(), e.repo)
+	if err != nil {
+		return err
+	}
+	opts, err := buildCommitOpts(e.repo, e.spec, pushConf)
+	if err != nil {
+		return err
+	}
+	opts.BaseCommit = api.CommitID(baseCommit)
+
+	_, err = e.gitserverClient.CreateCommitFromPatch(ctx, opts)
+	return err
+}
+
+// isPatchApplyError returns true if gitserver failed to create a commit
+// because the patch didn't apply to the base commit.
+func isPatchApplyError(err error) bool {
+	var e *protocol.CreateCommitFromPatchError
+	return errors.As(err, &e) && strings.HasPrefix(e.Command, "git apply")
+}
+
 // publishChangeset creates the given changeset on its code host.
 func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (err error) {
 	cs := &sources.Changeset{
@@ -340,6 +430,32 @@ func (e *executor) closeChangeset(ctx context.Context) (err error) {
 	return nil
 }
 
+// mergeChangeset merges the given changeset on its code host, with the merge
+// strategy of the auto-merge policy of its changeset spec.
+func (e *executor) mergeChangeset(ctx context.Context) (err error) {
+	css, err := e.changesetSource(ctx)
+	if err != nil {
+		return err
+	}
+
+	cs := &sources.Changeset{Changeset: e.ch, Repo: e.repo}
+
+	if err := css.MergeChangeset(ctx, cs, e.spec.Spec.AutoMerge.Squash()); err != nil {
+		if !errors.HasType(err, sources.ChangesetNotMergeableError{}) && !errors.HasType(err, &sources.ChangesetNotMergeableError{}) {
+			return errors.Wrap(err, "merging changeset")
+		}
+		// The code host doesn't allow merging the changeset, for example
+		// because of merge conflicts. We don't fail the changeset for that,
+		// the syncer enqueues it again when it syncs the changeset next.
+		log15.Warn("Auto-merging changeset", "changeset", e.ch.ID, "err", err)
+		e.ch.SyncState.AutoMergeWaitReason = btypes.ChangesetAutoMergeWaitReasonNotMergeable
+		return nil
+	}
+
+	e.ch.SyncState.AutoMergeWaitReason = btypes.ChangesetAutoMergeWaitReasonNone
+	return nil
+}
+
 // undraftChangeset marks the given changeset on its code host as ready for review.
 func (e *executor) undraftChangeset(ctx context.Context) (err error) {
 	css, err := e.changesetSource(ctx)
@@ -428,6 +544,12 @@ func loadChangesetSource(ctx context.Context, s *store.Store, sourcer sources.So
 
 func (e *executor) pushCommit(ctx context.Context, opts protocol.CreateCommitFromPatchRequest) error {
 	_, err := e.gitserverClient.CreateCommitFromPatch(ctx, opts)
+	return describeCreateCommitFromPatchError(err)
+}
+
+// describeCreateCommitFromPatchError adds the failed git command and its
+// output to errors returned by gitserver when creating a commit from a patch.
+func describeCreateCommitFromPatchError(err error) error {
 	if err != nil {
 		var e *protocol.CreateCommitFromPatchError
 		if errors.As(err, &e) {
@@ -1140,3 +1140,31 @@ type mockInternalClient struct {
 func (c *mockInternalClient) ExternalURL(ctx context.Context) (string, error) {
 	return c.externalURL, c.err
 }
+
+func TestIsPatchApplyError(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil": {err: nil, want: false},
+		"apply": {
+			err:  &gitprotocol.CreateCommitFromPatchError{Command: "git apply --cached -p0", CombinedOutput: "error: patch failed"},
+			want: true,
+		},
+		"wrapped apply": {
+			err:  errors.Wrap(&gitprotocol.CreateCommitFromPatchError{Command: "git apply --cached"}, "creating commit"),
+			want: true,
+		},
+		"push": {
+			err:  &gitprotocol.CreateCommitFromPatchError{Command: "git push --force origin HEAD:refs/heads/my-branch"},
+			want: false,
+		},
+		"other": {err: errors.New("connection refused"), want: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := isPatchApplyError(tc.err); have != tc.want {
+				t.Fatalf("have %v, want %v", have, tc.want)
+			}
+		})
+	}
+}
@@ -21,8 +21,10 @@ var operationPrecedence = map[btypes.ReconcilerOperation]int{
 	btypes.ReconcilerOperationReopen:       2,
 	btypes.ReconcilerOperationUndraft:      3,
 	btypes.ReconcilerOperationUpdate:       4,
-	btypes.ReconcilerOperationSleep:        5,
-	btypes.ReconcilerOperationSync:         6,
+	btypes.ReconcilerOperationRebase:       5,
+	btypes.ReconcilerOperationMerge:        6,
+	btypes.ReconcilerOperationSleep:        7,
+	btypes.ReconcilerOperationSync:         8,
 }
 
 type Operations []btypes.ReconcilerOperation
@@ -31,6 +33,16 @@ func (ops Operations) IsNone() bool {
 	return len(ops) == 0
 }
 
+// Includes returns true if the given operation is part of the operations.
+func (ops Operations) Includes(op btypes.ReconcilerOperation) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
 func (ops Operations) Equal(b Operations) bool {
 	if len(ops) != len(b) {
 		return false
@@ -235,6 +247,31 @@ func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, ch *btypes.C
 			}
 		}
 
+		// If the changeset spec has an auto-rebase policy, the commit is
+		// recreated on top of the new base, even if the diff didn't change.
+		// And if the syncer found the changeset to be stale, we rebase it.
+		if currentSpec.Spec.AutoRebase && ch.Closeable() {
+			if delta.BaseRevChanged && !delta.NeedCommitUpdate() {
+				pl.AddOp(btypes.ReconcilerOperationPush)
+				pl.AddOp(btypes.ReconcilerOperationSleep)
+				pl.AddOp(btypes.ReconcilerOperationSync)
+			} else if ch.SyncState.RebaseOnto != "" && !delta.NeedCommitUpdate() {
+				pl.AddOp(btypes.ReconcilerOperationRebase)
+			}
+		}
+
+		// If the changeset spec has an auto-merge policy and the checks
+		// passed and the changeset has been approved, we merge it. Not if
+		// we push a new commit or rebase it though, since that invalidates
+		// the checks.
+		if currentSpec.Spec.AutoMerge.Enabled() &&
+			ch.ExternalState == btypes.ChangesetExternalStateOpen &&
+			ch.AutoMergeWaitReason() == btypes.ChangesetAutoMergeWaitReasonNone &&
+			!pl.Ops.Includes(btypes.ReconcilerOperationPush) &&
+			!pl.Ops.Includes(btypes.ReconcilerOperationRebase) {
+			pl.AddOp(btypes.ReconcilerOperationMerge)
+		}
+
 	default:
 		return pl, errors.Errorf("unknown changeset publication state: %s", ch.PublicationState)
 	}
@@ -279,6 +316,9 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	if previous.Spec.BaseRef != current.Spec.BaseRef {
 		delta.BaseRefChanged = true
 	}
+	if previous.Spec.BaseRev != current.Spec.BaseRev {
+		delta.BaseRevChanged = true
+	}
 
 	// If was set to "draft" and now "true", need to undraft the changeset.
 	// We currently ignore going from "true" to "draft".
@@ -348,6 +388,7 @@ type ChangesetSpecDelta struct {
 	BodyChanged          bool
 	Undraft              bool
 	BaseRefChanged       bool
+	BaseRevChanged       bool
 	DiffChanged          bool
 	CommitMessageChanged bool
 	AuthorNameChanged    bool
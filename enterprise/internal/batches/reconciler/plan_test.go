@@ -6,6 +6,7 @@ import (
 	ct "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 )
 
 func TestDetermineReconcilerPlan(t *testing.T) {
@@ -243,6 +244,118 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 			// should be a noop
 			wantOperations: Operations{},
 		},
+		{
+			name:         "auto-merge of approved changeset with passed checks",
+			previousSpec: &ct.TestSpecOpts{Published: true, AutoMerge: batcheslib.AutoMergeStrategySquash},
+			currentSpec:  &ct.TestSpecOpts{Published: true, AutoMerge: batcheslib.AutoMergeStrategySquash},
+			changeset: ct.TestChangesetOpts{
+				PublicationState:    btypes.ChangesetPublicationStatePublished,
+				ExternalState:       btypes.ChangesetExternalStateOpen,
+				ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+				ExternalReviewState: btypes.ChangesetReviewStateApproved,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationMerge},
+		},
+		{
+			name:         "auto-merge of changeset with pending checks",
+			previousSpec: &ct.TestSpecOpts{Published: true, AutoMerge: batcheslib.AutoMergeStrategyMerge},
+			currentSpec:  &ct.TestSpecOpts{Published: true, AutoMerge: batcheslib.AutoMergeStrategyMerge},
+			changeset: ct.TestChangesetOpts{
+				PublicationState:    btypes.ChangesetPublicationStatePublished,
+				ExternalState:       btypes.ChangesetExternalStateOpen,
+				ExternalCheckState:  btypes.ChangesetCheckStatePending,
+				ExternalReviewState: btypes.ChangesetReviewStateApproved,
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "auto-merge of changeset with new commit",
+			previousSpec: &ct.TestSpecOpts{Published: true, AutoMerge: batcheslib.AutoMergeStrategyMerge, CommitDiff: "testDiff"},
+			currentSpec:  &ct.TestSpecOpts{Published: true, AutoMerge: batcheslib.AutoMergeStrategyMerge, CommitDiff: "newTestDiff"},
+			changeset: ct.TestChangesetOpts{
+				PublicationState:    btypes.ChangesetPublicationStatePublished,
+				ExternalState:       btypes.ChangesetExternalStateOpen,
+				ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+				ExternalReviewState: btypes.ChangesetReviewStateApproved,
+			},
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:         "approved changeset with passed checks without auto-merge",
+			previousSpec: &ct.TestSpecOpts{Published: true},
+			currentSpec:  &ct.TestSpecOpts{Published: true},
+			changeset: ct.TestChangesetOpts{
+				PublicationState:    btypes.ChangesetPublicationStatePublished,
+				ExternalState:       btypes.ChangesetExternalStateOpen,
+				ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+				ExternalReviewState: btypes.ChangesetReviewStateApproved,
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "auto-rebase of stale changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true, AutoRebase: true, BaseRev: "d34db33f"},
+			currentSpec:  &ct.TestSpecOpts{Published: true, AutoRebase: true, BaseRev: "d34db33f"},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				SyncState:        btypes.ChangesetSyncState{RebaseOnto: "b4dc0ffee"},
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationRebase},
+		},
+		{
+			name:         "auto-rebase of stale changeset with passed checks and approval",
+			previousSpec: &ct.TestSpecOpts{Published: true, AutoRebase: true, AutoMerge: batcheslib.AutoMergeStrategyMerge, BaseRev: "d34db33f"},
+			currentSpec:  &ct.TestSpecOpts{Published: true, AutoRebase: true, AutoMerge: batcheslib.AutoMergeStrategyMerge, BaseRev: "d34db33f"},
+			changeset: ct.TestChangesetOpts{
+				PublicationState:    btypes.ChangesetPublicationStatePublished,
+				ExternalState:       btypes.ChangesetExternalStateOpen,
+				ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+				ExternalReviewState: btypes.ChangesetReviewStateApproved,
+				SyncState:           btypes.ChangesetSyncState{RebaseOnto: "b4dc0ffee"},
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationRebase},
+		},
+		{
+			name:         "auto-rebase with rebased changeset spec",
+			previousSpec: &ct.TestSpecOpts{Published: true, AutoRebase: true, BaseRev: "d34db33f"},
+			currentSpec:  &ct.TestSpecOpts{Published: true, AutoRebase: true, BaseRev: "b4dc0ffee"},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				SyncState:        btypes.ChangesetSyncState{RebaseOnto: "b4dc0ffee"},
+			},
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:         "new base without auto-rebase",
+			previousSpec: &ct.TestSpecOpts{Published: true, BaseRev: "d34db33f"},
+			currentSpec:  &ct.TestSpecOpts{Published: true, BaseRev: "b4dc0ffee"},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "auto-rebase of merged changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true, AutoRebase: true, BaseRev: "d34db33f"},
+			currentSpec:  &ct.TestSpecOpts{Published: true, AutoRebase: true, BaseRev: "d34db33f"},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateMerged,
+				SyncState:        btypes.ChangesetSyncState{RebaseOnto: "b4dc0ffee"},
+			},
+			wantOperations: Operations{},
+		},
 		{
 			name:         "changeset closed-and-detached will reopen",
 			previousSpec: &ct.TestSpecOpts{Published: true},
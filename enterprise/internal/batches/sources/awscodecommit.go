@@ -0,0 +1,295 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// AWSCodeCommitSource is a ChangesetSource for AWS CodeCommit.
+//
+// Batch Changes credentials for AWS CodeCommit are the HTTPS Git credentials
+// of an IAM user. AWS doesn't accept them for API requests, so they are only
+// used to push branches: pull requests are always created, updated and merged
+// with the access key configured in the external service, whichever
+// credential the source has.
+type AWSCodeCommitSource struct {
+	client *awscodecommit.Client
+	doer   httpcli.Doer
+	au     auth.Authenticator
+}
+
+// NewAWSCodeCommitSource returns a new AWSCodeCommitSource from the given external service.
+func NewAWSCodeCommitSource(svc *types.ExternalService, cf *httpcli.Factory) (*AWSCodeCommitSource, error) {
+	var c schema.AWSCodeCommitConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return newAWSCodeCommitSource(&c, cf)
+}
+
+func newAWSCodeCommitSource(c *schema.AWSCodeCommitConnection, cf *httpcli.Factory) (*AWSCodeCommitSource, error) {
+	client, err := repos.NewAWSCodeCommitClient(c, cf)
+	if err != nil {
+		return nil, err
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+	doer, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AWSCodeCommitSource{
+		client: client,
+		doer:   doer,
+		au: &auth.BasicAuth{
+			Username: c.GitCredentials.Username,
+			Password: c.GitCredentials.Password,
+		},
+	}, nil
+}
+
+func (s AWSCodeCommitSource) GitserverPushConfig(ctx context.Context, store database.ExternalServiceStore, repo *types.Repo) (*protocol.PushConfig, error) {
+	return gitserverPushConfig(ctx, store, repo, s.au)
+}
+
+func (s AWSCodeCommitSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth,
+		*auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("AWSCodeCommitSource", a)
+	}
+
+	// The authenticator is only used to push, see the comment on
+	// AWSCodeCommitSource.
+	return &AWSCodeCommitSource{
+		client: s.client,
+		doer:   s.doer,
+		au:     a,
+	}, nil
+}
+
+// ValidateAuthenticator checks that AWS CodeCommit accepts the Git credentials
+// of the authenticator. AWS CodeCommit has no API to verify Git credentials,
+// so they are used to list the refs of a repository of the external service,
+// like `git ls-remote` does.
+func (s AWSCodeCommitSource) ValidateAuthenticator(ctx context.Context) error {
+	var ba *auth.BasicAuth
+	switch a := s.au.(type) {
+	case *auth.BasicAuth:
+		ba = a
+	case *auth.BasicAuthWithSSH:
+		ba = &a.BasicAuth
+	}
+	if ba == nil || ba.Username == "" || ba.Password == "" {
+		return errors.New("AWS CodeCommit Git credentials require a username and password")
+	}
+
+	repos, _, err := s.client.ListRepositories(ctx, "")
+	if err != nil {
+		return errors.Wrap(err, "listing repositories")
+	}
+	if len(repos) == 0 {
+		return errors.New("no AWS CodeCommit repository to verify the Git credentials with")
+	}
+
+	return validateGitCredentials(ctx, s.doer, repos[0].HTTPCloneURL, ba)
+}
+
+// validateGitCredentials requests the refs of the repository at cloneURL with
+// the given credentials over the smart HTTP protocol of Git.
+func validateGitCredentials(ctx context.Context, doer httpcli.Doer, cloneURL string, ba *auth.BasicAuth) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(cloneURL, "/")+"/info/refs?service=git-upload-pack", nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(ba.Username, ba.Password)
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return errors.New("AWS CodeCommit rejected the Git credentials")
+	case resp.StatusCode != http.StatusOK:
+		return errors.Errorf("unexpected status %d verifying the Git credentials", resp.StatusCode)
+	}
+	return nil
+}
+
+// CreateChangeset creates the given *Changeset in the code host. If an open
+// pull request for the same branches already exists and was created with the
+// same credentials, it is loaded instead and true is returned.
+func (s AWSCodeCommitSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
+	repo := c.Repo.Metadata.(*awscodecommit.Repository)
+	exists := true
+
+	// AWS CodeCommit allows opening any number of pull requests for the same
+	// branches, so we look for an existing one first.
+	pr, err := s.client.FindOpenPullRequest(ctx, repo.Name, git.EnsureRefPrefix(c.HeadRef), git.EnsureRefPrefix(c.BaseRef))
+	if err == awscodecommit.ErrPullRequestNotFound {
+		exists = false
+		pr, err = s.client.CreatePullRequest(ctx, &awscodecommit.CreatePullRequestInput{
+			RepositoryName:       repo.Name,
+			Title:                c.Title,
+			Description:          c.Body,
+			SourceReference:      git.AbbreviateRef(c.HeadRef),
+			DestinationReference: git.AbbreviateRef(c.BaseRef),
+		})
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err := c.SetMetadata(pr); err != nil {
+		return false, errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return exists, nil
+}
+
+// CloseChangeset closes the given *Changeset on the code host and updates the
+// Metadata column in the *batches.Changeset to the newly closed pull request.
+func (s AWSCodeCommitSource) CloseChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+
+	if err := s.client.UpdatePullRequestStatus(ctx, pr.ID, awscodecommit.PullRequestStatusClosed); err != nil {
+		return err
+	}
+
+	return s.reloadPullRequest(ctx, c, pr.ID)
+}
+
+// LoadChangeset loads the latest state of the given Changeset from the codehost.
+func (s AWSCodeCommitSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	pr, err := s.client.GetPullRequest(ctx, cs.ExternalID)
+	if err != nil {
+		if err == awscodecommit.ErrPullRequestNotFound {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+
+		return err
+	}
+
+	if err := cs.SetMetadata(pr); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
+
+// UpdateChangeset updates the title and description of the given *Changeset
+// on the code host. AWS CodeCommit doesn't allow changing the destination
+// branch of a pull request.
+func (s AWSCodeCommitSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+
+	if pr.Title != c.Title {
+		if err := s.client.UpdatePullRequestTitle(ctx, pr.ID, c.Title); err != nil {
+			return err
+		}
+	}
+	if pr.Description != c.Body {
+		if err := s.client.UpdatePullRequestDescription(ctx, pr.ID, c.Body); err != nil {
+			return err
+		}
+	}
+
+	return s.reloadPullRequest(ctx, c, pr.ID)
+}
+
+// ReopenChangeset reopens the *Changeset on the code host and updates the
+// Metadata column in the *batches.Changeset.
+func (s AWSCodeCommitSource) ReopenChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+
+	if err := s.client.UpdatePullRequestStatus(ctx, pr.ID, awscodecommit.PullRequestStatusOpen); err != nil {
+		return err
+	}
+
+	return s.reloadPullRequest(ctx, c, pr.ID)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s AWSCodeCommitSource) CreateComment(ctx context.Context, c *Changeset, text string) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+	target := pr.Target()
+
+	return s.client.CreatePullRequestComment(ctx, target.RepositoryName, pr.ID, target.DestinationCommit, target.SourceCommit, text)
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, a squash merge is performed, otherwise a three-way merge.
+func (s AWSCodeCommitSource) MergeChangeset(ctx context.Context, c *Changeset, squash bool) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+	target := pr.Target()
+
+	if err := s.client.MergePullRequest(ctx, target.RepositoryName, pr.ID, target.SourceCommit, squash); err != nil {
+		if errors.Is(err, awscodecommit.ErrNotMergeable) {
+			return &ChangesetNotMergeableError{ErrorMsg: err.Error()}
+		}
+		return err
+	}
+
+	return s.reloadPullRequest(ctx, c, pr.ID)
+}
+
+// RequestReviewers returns UnsupportedOperationError, since AWS CodeCommit
+// uses approval rules instead of reviewers.
+func (s AWSCodeCommitSource) RequestReviewers(ctx context.Context, c *Changeset, reviewers, teamReviewers []string) error {
+	return UnsupportedOperationError{Operation: "requesting reviewers", CodeHost: "AWS CodeCommit"}
+}
+
+// AddLabels returns UnsupportedOperationError, since AWS CodeCommit pull
+// requests don't have labels.
+func (s AWSCodeCommitSource) AddLabels(ctx context.Context, c *Changeset, labels []string) error {
+	return UnsupportedOperationError{Operation: "adding labels", CodeHost: "AWS CodeCommit"}
+}
+
+// reloadPullRequest loads the pull request with the given ID after it has been
+// modified, as the AWS CodeCommit API doesn't return approval information from
+// mutations.
+func (s AWSCodeCommitSource) reloadPullRequest(ctx context.Context, c *Changeset, id string) error {
+	pr, err := s.client.GetPullRequest(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "loading pull request")
+	}
+	return c.Changeset.SetMetadata(pr)
+}
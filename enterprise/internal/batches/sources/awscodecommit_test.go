@@ -0,0 +1,385 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// The test fixtures were recorded against the "test" repository in the
+// us-west-1 region.
+
+func newAWSCodeCommitTestSource(t *testing.T, cf *httpcli.Factory) *AWSCodeCommitSource {
+	t.Helper()
+
+	svc := &types.ExternalService{
+		Kind: extsvc.KindAWSCodeCommit,
+		Config: marshalJSON(t, &schema.AWSCodeCommitConnection{
+			AccessKeyID:     "secret-access-key-id",
+			SecretAccessKey: "secret-secret-access-key",
+			Region:          "us-west-1",
+			GitCredentials: schema.AWSCodeCommitGitCredentials{
+				Username: "git-username",
+				Password: "git-password",
+			},
+		}),
+	}
+
+	src, err := NewAWSCodeCommitSource(svc, cf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+// newAWSCodeCommitTestChangeset returns a changeset with the pull request from
+// the LoadChangeset fixture.
+func newAWSCodeCommitTestChangeset(t *testing.T) *Changeset {
+	t.Helper()
+
+	cf, save := newClientFactory(t, "AWSCodeCommitSource_LoadChangeset_found")
+	defer save(t)
+
+	cs := &Changeset{
+		Repo:      &types.Repo{Metadata: &awscodecommit.Repository{Name: "test"}},
+		Changeset: &btypes.Changeset{ExternalID: "1"},
+	}
+	if err := newAWSCodeCommitTestSource(t, cf).LoadChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+	return cs
+}
+
+func TestAWSCodeCommitSource_LoadChangeset(t *testing.T) {
+	repo := &types.Repo{Metadata: &awscodecommit.Repository{Name: "test"}}
+
+	testCases := []struct {
+		name string
+		cs   *Changeset
+		err  string
+	}{
+		{
+			name: "found",
+			cs:   &Changeset{Repo: repo, Changeset: &btypes.Changeset{ExternalID: "1"}},
+		},
+		{
+			name: "not-found",
+			cs:   &Changeset{Repo: repo, Changeset: &btypes.Changeset{ExternalID: "999"}},
+			err:  "Changeset with external ID 999 not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "AWSCodeCommitSource_LoadChangeset_" + tc.name
+
+		t.Run(tc.name, func(t *testing.T) {
+			cf, save := newClientFactory(t, tc.name)
+			defer save(t)
+
+			src := newAWSCodeCommitTestSource(t, cf)
+
+			if tc.err == "" {
+				tc.err = "<nil>"
+			}
+
+			err := src.LoadChangeset(context.Background(), tc.cs)
+			if have, want := fmt.Sprint(err), tc.err; have != want {
+				t.Errorf("error:\nhave: %q\nwant: %q", have, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			testutil.AssertGolden(
+				t,
+				"testdata/golden/"+tc.name,
+				update(tc.name),
+				tc.cs.Changeset.Metadata.(*awscodecommit.PullRequest),
+			)
+		})
+	}
+}
+
+func TestAWSCodeCommitSource_CreateChangeset(t *testing.T) {
+	repo := &types.Repo{Metadata: &awscodecommit.Repository{Name: "test"}}
+
+	testCases := []struct {
+		name   string
+		exists bool
+		id     string
+	}{
+		{
+			name: "success",
+			id:   "2",
+		},
+		{
+			name:   "already-exists",
+			exists: true,
+			id:     "1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "AWSCodeCommitSource_CreateChangeset_" + tc.name
+
+		t.Run(tc.name, func(t *testing.T) {
+			cf, save := newClientFactory(t, tc.name)
+			defer save(t)
+
+			src := newAWSCodeCommitTestSource(t, cf)
+
+			cs := &Changeset{
+				Title:     "This is a test PR",
+				Body:      "This is the description of the test PR",
+				HeadRef:   "refs/heads/test-batch-change",
+				BaseRef:   "refs/heads/master",
+				Repo:      repo,
+				Changeset: &btypes.Changeset{},
+			}
+
+			exists, err := src.CreateChangeset(context.Background(), cs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exists != tc.exists {
+				t.Errorf("wrong exists value: have=%t want=%t", exists, tc.exists)
+			}
+
+			if have, want := cs.ExternalID, tc.id; have != want {
+				t.Errorf("wrong external ID: have=%q want=%q", have, want)
+			}
+			if have, want := cs.ExternalServiceType, extsvc.TypeAWSCodeCommit; have != want {
+				t.Errorf("wrong external service type: have=%q want=%q", have, want)
+			}
+			if have, want := cs.ExternalBranch, "refs/heads/test-batch-change"; have != want {
+				t.Errorf("wrong external branch: have=%q want=%q", have, want)
+			}
+		})
+	}
+}
+
+func TestAWSCodeCommitSource_CloseChangeset(t *testing.T) {
+	name := "AWSCodeCommitSource_CloseChangeset_success"
+	cs := newAWSCodeCommitTestChangeset(t)
+
+	cf, save := newClientFactory(t, name)
+	defer save(t)
+
+	src := newAWSCodeCommitTestSource(t, cf)
+	if err := src.CloseChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	pr := cs.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if have, want := pr.Status, awscodecommit.PullRequestStatusClosed; have != want {
+		t.Errorf("wrong status: have=%q want=%q", have, want)
+	}
+}
+
+func TestAWSCodeCommitSource_ReopenChangeset(t *testing.T) {
+	name := "AWSCodeCommitSource_ReopenChangeset_success"
+	cs := newAWSCodeCommitTestChangeset(t)
+	cs.Changeset.Metadata.(*awscodecommit.PullRequest).Status = awscodecommit.PullRequestStatusClosed
+
+	cf, save := newClientFactory(t, name)
+	defer save(t)
+
+	src := newAWSCodeCommitTestSource(t, cf)
+	if err := src.ReopenChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	pr := cs.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if have, want := pr.Status, awscodecommit.PullRequestStatusOpen; have != want {
+		t.Errorf("wrong status: have=%q want=%q", have, want)
+	}
+}
+
+func TestAWSCodeCommitSource_UpdateChangeset(t *testing.T) {
+	name := "AWSCodeCommitSource_UpdateChangeset_success"
+	cs := newAWSCodeCommitTestChangeset(t)
+	cs.Title = "This is an updated test PR"
+	cs.Body = "This is the description of the test PR"
+
+	cf, save := newClientFactory(t, name)
+	defer save(t)
+
+	src := newAWSCodeCommitTestSource(t, cf)
+	if err := src.UpdateChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	pr := cs.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if have, want := pr.Title, cs.Title; have != want {
+		t.Errorf("wrong title: have=%q want=%q", have, want)
+	}
+}
+
+func TestAWSCodeCommitSource_CreateComment(t *testing.T) {
+	name := "AWSCodeCommitSource_CreateComment_success"
+	cs := newAWSCodeCommitTestChangeset(t)
+
+	cf, save := newClientFactory(t, name)
+	defer save(t)
+
+	src := newAWSCodeCommitTestSource(t, cf)
+	if err := src.CreateComment(context.Background(), cs, "test-comment"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAWSCodeCommitSource_MergeChangeset(t *testing.T) {
+	testCases := []struct {
+		name   string
+		squash bool
+		err    error
+	}{
+		{
+			name:   "success",
+			squash: true,
+		},
+		{
+			name: "not-mergeable",
+			err:  &ChangesetNotMergeableError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "AWSCodeCommitSource_MergeChangeset_" + tc.name
+
+		t.Run(tc.name, func(t *testing.T) {
+			cs := newAWSCodeCommitTestChangeset(t)
+
+			cf, save := newClientFactory(t, tc.name)
+			defer save(t)
+
+			src := newAWSCodeCommitTestSource(t, cf)
+			err := src.MergeChangeset(context.Background(), cs, tc.squash)
+			if tc.err != nil {
+				if !errors.HasType(err, tc.err) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pr := cs.Changeset.Metadata.(*awscodecommit.PullRequest)
+			if !pr.IsMerged() {
+				t.Error("pull request is not merged")
+			}
+		})
+	}
+}
+
+func TestAWSCodeCommitSource_WithAuthenticator(t *testing.T) {
+	src := newAWSCodeCommitTestSource(t, nil)
+
+	t.Run("supported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"BasicAuth":        &auth.BasicAuth{Username: "user", Password: "pass"},
+			"BasicAuthWithSSH": &auth.BasicAuthWithSSH{BasicAuth: auth.BasicAuth{Username: "user", Password: "pass"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				other, err := src.WithAuthenticator(tc)
+				if err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+
+				if cs, ok := other.(*AWSCodeCommitSource); !ok {
+					t.Error("cannot coerce Source into AWSCodeCommitSource")
+				} else if cs == nil {
+					t.Error("unexpected nil Source")
+				} else if cs.au != tc {
+					t.Errorf("incorrect authenticator: have=%v want=%v", cs.au, tc)
+				} else if cs.client != src.client {
+					t.Error("API client is not the one of the external service")
+				}
+			})
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"nil":              nil,
+			"OAuthBearerToken": &auth.OAuthBearerToken{},
+			"OAuthClient":      &auth.OAuthClient{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				other, err := src.WithAuthenticator(tc)
+				if err == nil {
+					t.Error("unexpected nil error")
+				} else if !errors.HasType(err, UnsupportedAuthenticatorError{}) {
+					t.Errorf("unexpected error of type %T: %v", err, err)
+				}
+				if other != nil {
+					t.Errorf("expected nil Source: %v", other)
+				}
+			})
+		}
+	})
+
+	t.Run("missing password", func(t *testing.T) {
+		other, err := src.WithAuthenticator(&auth.BasicAuth{Username: "user"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := other.ValidateAuthenticator(context.Background()); err == nil {
+			t.Error("unexpected nil error")
+		}
+	})
+}
+
+func TestValidateGitCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/repos/test/info/refs" || r.URL.Query().Get("service") != "git-upload-pack" {
+			http.NotFound(w, r)
+			return
+		}
+		if username, password, ok := r.BasicAuth(); !ok || username != "git-username" || password != "git-password" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, "001e# service=git-upload-pack\n0000")
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	for name, tc := range map[string]struct {
+		cloneURL string
+		au       *auth.BasicAuth
+		wantErr  bool
+	}{
+		"valid":         {cloneURL: srv.URL + "/v1/repos/test", au: &auth.BasicAuth{Username: "git-username", Password: "git-password"}},
+		"wrong":         {cloneURL: srv.URL + "/v1/repos/test", au: &auth.BasicAuth{Username: "git-username", Password: "wrong"}, wantErr: true},
+		"missing repo":  {cloneURL: srv.URL + "/v1/repos/missing", au: &auth.BasicAuth{Username: "git-username", Password: "git-password"}, wantErr: true},
+		"trailing path": {cloneURL: srv.URL + "/v1/repos/test/", au: &auth.BasicAuth{Username: "git-username", Password: "git-password"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := validateGitCredentials(ctx, http.DefaultClient, tc.cloneURL, tc.au)
+			if tc.wantErr && err == nil {
+				t.Error("unexpected nil error")
+			} else if !tc.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package sources
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type BitbucketCloudSource struct {
+	client *bitbucketcloud.Client
+	au     auth.Authenticator
+}
+
+// NewBitbucketCloudSource returns a new BitbucketCloudSource from the given external service.
+func NewBitbucketCloudSource(svc *types.ExternalService, cf *httpcli.Factory) (*BitbucketCloudSource, error) {
+	var c schema.BitbucketCloudConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return newBitbucketCloudSource(&c, cf)
+}
+
+func newBitbucketCloudSource(c *schema.BitbucketCloudConnection, cf *httpcli.Factory) (*BitbucketCloudSource, error) {
+	if c.ApiURL == "" {
+		c.ApiURL = "https://api.bitbucket.org"
+	}
+	apiURL, err := url.Parse(c.ApiURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Bitbucket Cloud API URL")
+	}
+	apiURL = extsvc.NormalizeBaseURL(apiURL)
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	client := bitbucketcloud.NewClient(apiURL, cli)
+	client.Username = c.Username
+	client.AppPassword = c.AppPassword
+
+	return &BitbucketCloudSource{
+		client: client,
+		au:     &auth.BasicAuth{Username: c.Username, Password: c.AppPassword},
+	}, nil
+}
+
+func (s BitbucketCloudSource) GitserverPushConfig(ctx context.Context, store database.ExternalServiceStore, repo *types.Repo) (*protocol.PushConfig, error) {
+	return gitserverPushConfig(ctx, store, repo, s.au)
+}
+
+func (s BitbucketCloudSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth,
+		*auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("BitbucketCloudSource", a)
+	}
+
+	client, err := s.client.WithAuthenticator(a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BitbucketCloudSource{
+		client: client,
+		au:     a,
+	}, nil
+}
+
+func (s BitbucketCloudSource) ValidateAuthenticator(ctx context.Context) error {
+	_, err := s.client.CurrentUser(ctx)
+	return err
+}
+
+// CreateChangeset creates the given *Changeset in the code host. Bitbucket
+// Cloud updates and returns an existing open pull request for the same
+// branches instead of failing, so the returned bool is always false: the
+// returned pull request is already up to date.
+func (s BitbucketCloudSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	pr, err := s.client.CreatePullRequest(ctx, repo.FullName, s.pullRequestInput(c))
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.loadPullRequestData(ctx, repo, pr); err != nil {
+		return false, errors.Wrap(err, "loading extra metadata")
+	}
+	if err := c.SetMetadata(pr); err != nil {
+		return false, errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return false, nil
+}
+
+// CloseChangeset declines the given *Changeset on the code host and updates
+// the Metadata column in the *batches.Changeset to the newly declined pull
+// request.
+func (s BitbucketCloudSource) CloseChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	declined, err := s.client.DeclinePullRequest(ctx, repo.FullName, pr.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.loadPullRequestData(ctx, repo, declined); err != nil {
+		return errors.Wrap(err, "loading pull request data")
+	}
+	return c.Changeset.SetMetadata(declined)
+}
+
+// LoadChangeset loads the latest state of the given Changeset from the codehost.
+func (s BitbucketCloudSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	repo := cs.Repo.Metadata.(*bitbucketcloud.Repo)
+	number, err := strconv.Atoi(cs.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	pr, err := s.client.LoadPullRequest(ctx, repo.FullName, number)
+	if err != nil {
+		if err == bitbucketcloud.ErrPullRequestNotFound {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+
+		return err
+	}
+
+	if err := s.loadPullRequestData(ctx, repo, pr); err != nil {
+		return errors.Wrap(err, "loading pull request data")
+	}
+	if err := cs.SetMetadata(pr); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
+
+func (s BitbucketCloudSource) loadPullRequestData(ctx context.Context, repo *bitbucketcloud.Repo, pr *bitbucketcloud.PullRequest) error {
+	statuses, err := s.client.PullRequestStatuses(ctx, repo.FullName, pr.ID)
+	if err != nil {
+		return errors.Wrap(err, "loading pr build statuses")
+	}
+	pr.Statuses = statuses
+
+	return nil
+}
+
+func (s BitbucketCloudSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	updated, err := s.client.UpdatePullRequest(ctx, repo.FullName, pr.ID, s.pullRequestInput(c))
+	if err != nil {
+		return err
+	}
+
+	if err := s.loadPullRequestData(ctx, repo, updated); err != nil {
+		return errors.Wrap(err, "loading pull request data")
+	}
+	return c.Changeset.SetMetadata(updated)
+}
+
+// ReopenChangeset reopens the *Changeset on the code host and updates the
+// Metadata column in the *batches.Changeset.
+//
+// Bitbucket Cloud can't reopen declined pull requests, so this opens a new
+// pull request for the same branches instead. The declined pull request stays
+// declined on the code host, and comments, approvals and the pull request
+// number don't carry over: the changeset gets the new pull request's external
+// ID.
+func (s BitbucketCloudSource) ReopenChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	if pr.State != bitbucketcloud.PullRequestStateDeclined {
+		return nil
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	reopened, err := s.client.CreatePullRequest(ctx, repo.FullName, s.pullRequestInput(c))
+	if err != nil {
+		return err
+	}
+
+	if err := s.loadPullRequestData(ctx, repo, reopened); err != nil {
+		return errors.Wrap(err, "loading pull request data")
+	}
+	return c.Changeset.SetMetadata(reopened)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s BitbucketCloudSource) CreateComment(ctx context.Context, c *Changeset, text string) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	return s.client.CreatePullRequestComment(ctx, repo.FullName, pr.ID, text)
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, a squash merge is performed.
+func (s BitbucketCloudSource) MergeChangeset(ctx context.Context, c *Changeset, squash bool) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	merged, err := s.client.MergePullRequest(ctx, repo.FullName, pr.ID, squash)
+	if err != nil {
+		if errors.Is(err, bitbucketcloud.ErrNotMergeable) {
+			return &ChangesetNotMergeableError{ErrorMsg: err.Error()}
+		}
+		return err
+	}
+
+	if err := s.loadPullRequestData(ctx, repo, merged); err != nil {
+		return errors.Wrap(err, "loading pull request data")
+	}
+	return c.Changeset.SetMetadata(merged)
+}
+
+// RequestReviewers returns UnsupportedOperationError, since requesting
+// reviewers is not implemented for Bitbucket Cloud yet.
+func (s BitbucketCloudSource) RequestReviewers(ctx context.Context, c *Changeset, reviewers, teamReviewers []string) error {
+	return UnsupportedOperationError{Operation: "requesting reviewers", CodeHost: "Bitbucket Cloud"}
+}
+
+// AddLabels returns UnsupportedOperationError, since Bitbucket Cloud pull
+// requests don't have labels.
+func (s BitbucketCloudSource) AddLabels(ctx context.Context, c *Changeset, labels []string) error {
+	return UnsupportedOperationError{Operation: "adding labels", CodeHost: "Bitbucket Cloud"}
+}
+
+func (s BitbucketCloudSource) pullRequestInput(c *Changeset) *bitbucketcloud.PullRequestInput {
+	in := &bitbucketcloud.PullRequestInput{
+		Title:       c.Title,
+		Description: c.Body,
+	}
+	in.Source.Branch.Name = git.AbbreviateRef(c.HeadRef)
+	in.Destination.Branch.Name = git.AbbreviateRef(c.BaseRef)
+	return in
+}
@@ -0,0 +1,158 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestBitbucketCloudSource_LoadChangeset(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/repositories/sglocal/mux/pullrequests/1":
+			w.Write([]byte(`{
+				"id": 1,
+				"title": "Add README",
+				"state": "OPEN",
+				"source": {"branch": {"name": "add-readme"}},
+				"destination": {"branch": {"name": "master"}},
+				"participants": [
+					{"user": {"uuid": "{alice}"}, "role": "REVIEWER", "approved": true, "state": "approved"}
+				],
+				"links": {"html": {"href": "https://bitbucket.org/sglocal/mux/pull-requests/1"}}
+			}`))
+		case "/2.0/repositories/sglocal/mux/pullrequests/1/statuses":
+			w.Write([]byte(`{"values": [{"uuid": "{build}", "key": "ci", "state": "SUCCESSFUL"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	src, err := newBitbucketCloudSource(&schema.BitbucketCloudConnection{
+		ApiURL:      srv.URL,
+		Username:    "user",
+		AppPassword: "secret",
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := &types.Repo{Metadata: &bitbucketcloud.Repo{FullName: "sglocal/mux"}}
+
+	t.Run("found", func(t *testing.T) {
+		cs := &Changeset{
+			Repo:      repo,
+			Changeset: &btypes.Changeset{ExternalID: "1"},
+		}
+		if err := src.LoadChangeset(context.Background(), cs); err != nil {
+			t.Fatal(err)
+		}
+
+		if have, want := cs.ExternalServiceType, extsvc.TypeBitbucketCloud; have != want {
+			t.Errorf("wrong external service type: have=%q want=%q", have, want)
+		}
+		if have, want := cs.ExternalBranch, "refs/heads/add-readme"; have != want {
+			t.Errorf("wrong external branch: have=%q want=%q", have, want)
+		}
+		pr := cs.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+		if len(pr.Statuses) != 1 || pr.Statuses[0].UUID != "{build}" {
+			t.Errorf("statuses not loaded: %+v", pr.Statuses)
+		}
+
+		events, err := cs.Changeset.Events()
+		if err != nil {
+			t.Fatal(err)
+		}
+		kinds := make([]btypes.ChangesetEventKind, 0, len(events))
+		for _, e := range events {
+			kinds = append(kinds, e.Kind)
+		}
+		want := []btypes.ChangesetEventKind{
+			btypes.ChangesetEventKindBitbucketCloudApproved,
+			btypes.ChangesetEventKindBitbucketCloudCommitStatus,
+		}
+		if len(kinds) != len(want) || kinds[0] != want[0] || kinds[1] != want[1] {
+			t.Errorf("wrong event kinds: have=%v want=%v", kinds, want)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		cs := &Changeset{
+			Repo:      repo,
+			Changeset: &btypes.Changeset{ExternalID: "2"},
+		}
+		err := src.LoadChangeset(context.Background(), cs)
+		if !errors.HasType(err, ChangesetNotFoundError{}) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestBitbucketCloudSource_WithAuthenticator(t *testing.T) {
+	svc := &types.ExternalService{
+		Kind: extsvc.KindBitbucketCloud,
+		Config: marshalJSON(t, &schema.BitbucketCloudConnection{
+			Url:         "https://bitbucket.org",
+			Username:    "user",
+			AppPassword: "secret",
+		}),
+	}
+
+	bbcSrc, err := NewBitbucketCloudSource(svc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("supported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"BasicAuth":        &auth.BasicAuth{},
+			"BasicAuthWithSSH": &auth.BasicAuthWithSSH{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := bbcSrc.WithAuthenticator(tc)
+				if err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+
+				if bs, ok := src.(*BitbucketCloudSource); !ok {
+					t.Error("cannot coerce Source into bbcSource")
+				} else if bs == nil {
+					t.Error("unexpected nil Source")
+				} else if bs.au != tc {
+					t.Errorf("incorrect authenticator: have=%v want=%v", bs.au, tc)
+				}
+			})
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"nil":              nil,
+			"OAuthBearerToken": &auth.OAuthBearerToken{},
+			"OAuthClient":      &auth.OAuthClient{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := bbcSrc.WithAuthenticator(tc)
+				if err == nil {
+					t.Error("unexpected nil error")
+				} else if !errors.HasType(err, UnsupportedAuthenticatorError{}) {
+					t.Errorf("unexpected error of type %T: %v", err, err)
+				}
+				if src != nil {
+					t.Errorf("expected non-nil Source: %v", src)
+				}
+			})
+		}
+	})
+}
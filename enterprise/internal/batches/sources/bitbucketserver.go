@@ -271,3 +271,56 @@ func (s BitbucketServerSource) MergeChangeset(ctx context.Context, c *Changeset,
 
 	return c.Changeset.SetMetadata(pr)
 }
+
+// RequestReviewers adds the users to the reviewers of the pull request.
+// Bitbucket Server doesn't support groups as reviewers, so teamReviewers must
+// be empty.
+func (s BitbucketServerSource) RequestReviewers(ctx context.Context, c *Changeset, reviewers, teamReviewers []string) error {
+	if len(teamReviewers) > 0 {
+		return UnsupportedOperationError{Operation: "requesting reviews from teams", CodeHost: "Bitbucket Server"}
+	}
+
+	pr, ok := c.Changeset.Metadata.(*bitbucketserver.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Server pull request")
+	}
+
+	// Updating a pull request replaces its reviewers, so we have to send the
+	// existing ones along with the new ones, as well as the current title,
+	// description and target branch, which are required.
+	update := &bitbucketserver.UpdatePullRequestInput{
+		PullRequestID: strconv.Itoa(pr.ID),
+		Title:         pr.Title,
+		Description:   pr.Description,
+		Version:       pr.Version,
+		ToRef:         pr.ToRef,
+	}
+	seen := make(map[string]struct{}, len(pr.Reviewers)+len(reviewers))
+	for _, reviewer := range pr.Reviewers {
+		if reviewer.User == nil {
+			continue
+		}
+		update.Reviewers = append(update.Reviewers, bitbucketserver.ReviewerInput{User: bitbucketserver.User{Name: reviewer.User.Name}})
+		seen[reviewer.User.Name] = struct{}{}
+	}
+	for _, name := range reviewers {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		update.Reviewers = append(update.Reviewers, bitbucketserver.ReviewerInput{User: bitbucketserver.User{Name: name}})
+		seen[name] = struct{}{}
+	}
+
+	updated, err := s.client.UpdatePullRequest(ctx, update)
+	if err != nil {
+		return err
+	}
+
+	return c.Changeset.SetMetadata(updated)
+}
+
+// AddLabels returns UnsupportedOperationError, since Bitbucket Server pull
+// requests don't have labels.
+func (s BitbucketServerSource) AddLabels(ctx context.Context, c *Changeset, labels []string) error {
+	return UnsupportedOperationError{Operation: "adding labels", CodeHost: "Bitbucket Server"}
+}
@@ -74,6 +74,15 @@ type ChangesetSource interface {
 	// merge. If the changeset cannot be merged, because it is in an unmergeable
 	// state, ChangesetNotMergeableError must be returned.
 	MergeChangeset(ctx context.Context, ch *Changeset, squash bool) error
+	// RequestReviewers requests reviews of the Changeset from the users with
+	// the given usernames and the given teams. Existing review requests are
+	// kept. If the code host doesn't support review requests, or teams as
+	// reviewers, UnsupportedOperationError must be returned.
+	RequestReviewers(ctx context.Context, ch *Changeset, reviewers, teamReviewers []string) error
+	// AddLabels adds the given labels to the Changeset. Existing labels are
+	// kept. If the code host doesn't support labels,
+	// UnsupportedOperationError must be returned.
+	AddLabels(ctx context.Context, ch *Changeset, labels []string) error
 }
 
 // ChangesetNotMergeableError is returned by MergeChangeset if the changeset
@@ -90,6 +99,19 @@ func (e ChangesetNotMergeableError) Error() string {
 
 func (e ChangesetNotMergeableError) NonRetryable() bool { return true }
 
+// UnsupportedOperationError is returned by a ChangesetSource for operations
+// that the code host doesn't support.
+type UnsupportedOperationError struct {
+	Operation string
+	CodeHost  string
+}
+
+func (e UnsupportedOperationError) Error() string {
+	return fmt.Sprintf("%s is not supported on %s", e.Operation, e.CodeHost)
+}
+
+func (e UnsupportedOperationError) NonRetryable() bool { return true }
+
 // A Changeset of an existing Repo.
 type Changeset struct {
 	Title   string
@@ -51,6 +51,8 @@ type FakeChangesetSource struct {
 	AuthenticatedUsernameCalled bool
 	ValidateAuthenticatorCalled bool
 	MergeChangesetCalled        bool
+	RequestReviewersCalled      bool
+	AddLabelsCalled             bool
 
 	// The Changeset.HeadRef to be expected in CreateChangeset/UpdateChangeset calls.
 	WantHeadRef string
@@ -283,3 +285,13 @@ func (s *FakeChangesetSource) MergeChangeset(ctx context.Context, c *Changeset,
 	s.MergeChangesetCalled = true
 	return s.Err
 }
+
+func (s *FakeChangesetSource) RequestReviewers(ctx context.Context, c *Changeset, reviewers, teamReviewers []string) error {
+	s.RequestReviewersCalled = true
+	return s.Err
+}
+
+func (s *FakeChangesetSource) AddLabels(ctx context.Context, c *Changeset, labels []string) error {
+	s.AddLabelsCalled = true
+	return s.Err
+}
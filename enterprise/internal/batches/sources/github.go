@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -268,3 +269,47 @@ func (s GithubSource) MergeChangeset(ctx context.Context, c *Changeset, squash b
 
 	return c.Changeset.SetMetadata(pr)
 }
+
+// RequestReviewers requests reviews of the pull request. Team reviewers can be
+// given as `org/team-slug`, or only as the team slug, in which case the team
+// is looked up in the organization owning the repository.
+func (s GithubSource) RequestReviewers(ctx context.Context, c *Changeset, reviewers, teamReviewers []string) error {
+	pr, ok := c.Changeset.Metadata.(*github.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitHub pull request")
+	}
+	repo := c.Repo.Metadata.(*github.Repository)
+
+	owner, _, err := github.SplitRepositoryNameWithOwner(repo.NameWithOwner)
+	if err != nil {
+		return errors.Wrap(err, "getting repository owner")
+	}
+	teams := make([]string, 0, len(teamReviewers))
+	for _, team := range teamReviewers {
+		if !strings.Contains(team, "/") {
+			team = owner + "/" + team
+		}
+		teams = append(teams, team)
+	}
+
+	if err := s.client.RequestReviews(ctx, pr, reviewers, teams); err != nil {
+		return err
+	}
+
+	return c.Changeset.SetMetadata(pr)
+}
+
+// AddLabels adds the labels to the pull request. The labels must already exist
+// in the repository.
+func (s GithubSource) AddLabels(ctx context.Context, c *Changeset, labels []string) error {
+	pr, ok := c.Changeset.Metadata.(*github.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitHub pull request")
+	}
+
+	if err := s.client.AddLabelsToPullRequest(ctx, pr, labels); err != nil {
+		return err
+	}
+
+	return c.Changeset.SetMetadata(pr)
+}
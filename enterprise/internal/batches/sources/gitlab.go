@@ -457,3 +457,70 @@ func (s *GitLabSource) MergeChangeset(ctx context.Context, c *Changeset, squash
 
 	return c.Changeset.SetMetadata(updated)
 }
+
+// RequestReviewers adds the users to the reviewers of the merge request.
+// GitLab doesn't support groups as reviewers, so teamReviewers must be empty.
+func (s *GitLabSource) RequestReviewers(ctx context.Context, c *Changeset, reviewers, teamReviewers []string) error {
+	if len(teamReviewers) > 0 {
+		return UnsupportedOperationError{Operation: "requesting reviews from teams", CodeHost: "GitLab"}
+	}
+
+	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitLab merge request")
+	}
+	project := c.Repo.Metadata.(*gitlab.Project)
+
+	// The API replaces the reviewers, so we have to send the existing ones
+	// along with the new ones.
+	ids := make([]int32, 0, len(mr.Reviewers)+len(reviewers))
+	seen := make(map[int32]struct{}, cap(ids))
+	for _, reviewer := range mr.Reviewers {
+		ids = append(ids, reviewer.ID)
+		seen[reviewer.ID] = struct{}{}
+	}
+	for _, username := range reviewers {
+		user, err := s.client.GetUserByUsername(ctx, username)
+		if err != nil {
+			return errors.Wrapf(err, "looking up GitLab user %q", username)
+		}
+		if _, ok := seen[user.ID]; ok {
+			continue
+		}
+		ids = append(ids, user.ID)
+		seen[user.ID] = struct{}{}
+	}
+
+	updated, err := s.client.SetMergeRequestReviewers(ctx, project, mr, ids)
+	if err != nil {
+		return errors.Wrap(err, "requesting reviewers on GitLab merge request")
+	}
+
+	// These additional API calls can go away once we can use the GraphQL API.
+	if err := s.decorateMergeRequestData(ctx, project, updated); err != nil {
+		return errors.Wrapf(err, "retrieving additional data for merge request %d", mr.IID)
+	}
+
+	return c.Changeset.SetMetadata(updated)
+}
+
+// AddLabels adds the labels to the merge request.
+func (s *GitLabSource) AddLabels(ctx context.Context, c *Changeset, labels []string) error {
+	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitLab merge request")
+	}
+	project := c.Repo.Metadata.(*gitlab.Project)
+
+	updated, err := s.client.AddMergeRequestLabels(ctx, project, mr, labels)
+	if err != nil {
+		return errors.Wrap(err, "adding labels to GitLab merge request")
+	}
+
+	// These additional API calls can go away once we can use the GraphQL API.
+	if err := s.decorateMergeRequestData(ctx, project, updated); err != nil {
+		return errors.Wrapf(err, "retrieving additional data for merge request %d", mr.IID)
+	}
+
+	return c.Changeset.SetMetadata(updated)
+}
@@ -640,6 +640,93 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 		}
 	})
 
+	t.Run("RequestReviewers", func(t *testing.T) {
+		t.Run("team reviewers", func(t *testing.T) {
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = p.mr
+
+			err := p.source.RequestReviewers(p.ctx, p.changeset, nil, []string{"core"})
+			if !errors.HasType(err, UnsupportedOperationError{}) {
+				t.Errorf("unexpected error: have %+v; want UnsupportedOperationError", err)
+			}
+		})
+
+		t.Run("error from GetUserByUsername", func(t *testing.T) {
+			inner := errors.New("foo")
+
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = p.mr
+			p.mockGetUserByUsername(nil, inner)
+
+			have := p.source.RequestReviewers(p.ctx, p.changeset, []string{"alice"}, nil)
+			if !errors.Is(have, inner) {
+				t.Errorf("error does not include inner error: have %+v; want %+v", have, inner)
+			}
+		})
+
+		t.Run("success", func(t *testing.T) {
+			in := &gitlab.MergeRequest{IID: 2, Reviewers: []gitlab.User{{ID: 1, Username: "bob"}}}
+			out := &gitlab.MergeRequest{IID: 2}
+
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = in
+			p.mockGetUserByUsername(map[string]*gitlab.User{
+				"alice": {ID: 2, Username: "alice"},
+				"bob":   {ID: 1, Username: "bob"},
+			}, nil)
+			gitlab.MockSetMergeRequestReviewers = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, reviewerIDs []int32) (*gitlab.MergeRequest, error) {
+				p.testCommonParams(ctx, client, project)
+				if diff := cmp.Diff([]int32{1, 2}, reviewerIDs); diff != "" {
+					t.Errorf("unexpected reviewer IDs (-want +got):\n%s", diff)
+				}
+				return out, nil
+			}
+			p.mockGetMergeRequestNotes(in.IID, nil, 20, nil)
+			p.mockGetMergeRequestResourceStateEvents(in.IID, nil, 20, nil)
+			p.mockGetMergeRequestPipelines(in.IID, nil, 20, nil)
+
+			if err := p.source.RequestReviewers(p.ctx, p.changeset, []string{"alice", "bob"}, nil); err != nil {
+				t.Errorf("unexpected non-nil error: %+v", err)
+			}
+			if p.changeset.Changeset.Metadata != out {
+				t.Errorf("metadata not correctly updated: have %+v; want %+v", p.changeset.Changeset.Metadata, out)
+			}
+		})
+	})
+
+	t.Run("AddLabels", func(t *testing.T) {
+		t.Run("error from AddMergeRequestLabels", func(t *testing.T) {
+			inner := errors.New("foo")
+
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = p.mr
+			p.mockAddMergeRequestLabels([]string{"bug"}, nil, inner)
+
+			have := p.source.AddLabels(p.ctx, p.changeset, []string{"bug"})
+			if !errors.Is(have, inner) {
+				t.Errorf("error does not include inner error: have %+v; want %+v", have, inner)
+			}
+		})
+
+		t.Run("success", func(t *testing.T) {
+			out := &gitlab.MergeRequest{IID: 2, Labels: []string{"bug"}}
+
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = p.mr
+			p.mockAddMergeRequestLabels([]string{"bug"}, out, nil)
+			p.mockGetMergeRequestNotes(out.IID, nil, 20, nil)
+			p.mockGetMergeRequestResourceStateEvents(out.IID, nil, 20, nil)
+			p.mockGetMergeRequestPipelines(out.IID, nil, 20, nil)
+
+			if err := p.source.AddLabels(p.ctx, p.changeset, []string{"bug"}); err != nil {
+				t.Errorf("unexpected non-nil error: %+v", err)
+			}
+			if p.changeset.Changeset.Metadata != out {
+				t.Errorf("metadata not correctly updated: have %+v; want %+v", p.changeset.Changeset.Metadata, out)
+			}
+		})
+	})
+
 	t.Run("CreateComment", func(t *testing.T) {
 		commentBody := "test-comment"
 		t.Run("invalid metadata", func(t *testing.T) {
@@ -978,6 +1065,29 @@ func (p *gitLabChangesetSourceTestProvider) mockCreateComment(expected string, m
 	}
 }
 
+func (p *gitLabChangesetSourceTestProvider) mockGetUserByUsername(users map[string]*gitlab.User, err error) {
+	gitlab.MockGetUserByUsername = func(client *gitlab.Client, ctx context.Context, username string) (*gitlab.User, error) {
+		if err != nil {
+			return nil, err
+		}
+		user, ok := users[username]
+		if !ok {
+			return nil, &gitlab.UserNotFoundError{Username: username}
+		}
+		return user, nil
+	}
+}
+
+func (p *gitLabChangesetSourceTestProvider) mockAddMergeRequestLabels(expected []string, mr *gitlab.MergeRequest, err error) {
+	gitlab.MockAddMergeRequestLabels = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, mrIn *gitlab.MergeRequest, labels []string) (*gitlab.MergeRequest, error) {
+		p.testCommonParams(ctx, client, project)
+		if diff := cmp.Diff(expected, labels); diff != "" {
+			p.t.Errorf("unexpected labels (-want +got):\n%s", diff)
+		}
+		return mr, err
+	}
+}
+
 func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockCreateMergeRequest = nil
 	gitlab.MockGetMergeRequest = nil
@@ -987,6 +1097,9 @@ func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockGetOpenMergeRequestByRefs = nil
 	gitlab.MockUpdateMergeRequest = nil
 	gitlab.MockCreateMergeRequestNote = nil
+	gitlab.MockGetUserByUsername = nil
+	gitlab.MockSetMergeRequestReviewers = nil
+	gitlab.MockAddMergeRequestLabels = nil
 }
 
 // panicDoer provides a httpcli.Doer implementation that panics if any attempt
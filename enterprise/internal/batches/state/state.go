@@ -16,6 +16,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -103,6 +105,9 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 	case *bitbucketserver.PullRequest:
 		return computeBitbucketBuildStatus(c.UpdatedAt, m, events)
 
+	case *bitbucketcloud.PullRequest:
+		return computeBitbucketCloudBuildStatus(c.UpdatedAt, m, events)
+
 	case *gitlab.MergeRequest:
 		return computeGitLabCheckState(c.UpdatedAt, m, events)
 	}
@@ -197,6 +202,46 @@ func parseBitbucketBuildState(s string) btypes.ChangesetCheckState {
 	}
 }
 
+func computeBitbucketCloudBuildStatus(lastSynced time.Time, pr *bitbucketcloud.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
+	stateMap := make(map[string]btypes.ChangesetCheckState)
+
+	// States from last sync
+	for _, status := range pr.Statuses {
+		stateMap[status.UUID] = parseBitbucketCloudBuildState(status.State)
+	}
+
+	// Add any events we've received since our last sync
+	for _, e := range events {
+		switch m := e.Metadata.(type) {
+		case *bitbucketcloud.PullRequestStatus:
+			if m.UpdatedOn.Before(lastSynced) {
+				continue
+			}
+			stateMap[m.UUID] = parseBitbucketCloudBuildState(m.State)
+		}
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(stateMap))
+	for _, v := range stateMap {
+		states = append(states, v)
+	}
+
+	return combineCheckStates(states)
+}
+
+func parseBitbucketCloudBuildState(s bitbucketcloud.PullRequestStatusState) btypes.ChangesetCheckState {
+	switch s {
+	case bitbucketcloud.PullRequestStatusStateFailed, bitbucketcloud.PullRequestStatusStateStopped:
+		return btypes.ChangesetCheckStateFailed
+	case bitbucketcloud.PullRequestStatusStateInProgress:
+		return btypes.ChangesetCheckStatePending
+	case bitbucketcloud.PullRequestStatusStateSuccessful:
+		return btypes.ChangesetCheckStatePassed
+	default:
+		return btypes.ChangesetCheckStateUnknown
+	}
+}
+
 func computeGitHubCheckState(lastSynced time.Time, pr *github.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
 	// We should only consider the latest commit. This could be from a sync or a webhook that
 	// has occurred later
@@ -435,6 +480,30 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		} else {
 			s = btypes.ChangesetExternalState(m.State)
 		}
+	case *bitbucketcloud.PullRequest:
+		switch m.State {
+		case bitbucketcloud.PullRequestStateDeclined, bitbucketcloud.PullRequestStateSuperseded:
+			s = btypes.ChangesetExternalStateClosed
+		case bitbucketcloud.PullRequestStateMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case bitbucketcloud.PullRequestStateOpen:
+			s = btypes.ChangesetExternalStateOpen
+		default:
+			return "", errors.Errorf("unknown Bitbucket Cloud pull request state: %s", m.State)
+		}
+	case *awscodecommit.PullRequest:
+		switch m.Status {
+		case awscodecommit.PullRequestStatusClosed:
+			if m.IsMerged() {
+				s = btypes.ChangesetExternalStateMerged
+			} else {
+				s = btypes.ChangesetExternalStateClosed
+			}
+		case awscodecommit.PullRequestStatusOpen:
+			s = btypes.ChangesetExternalStateOpen
+		default:
+			return "", errors.Errorf("unknown AWS CodeCommit pull request status: %s", m.Status)
+		}
 	case *gitlab.MergeRequest:
 		switch m.State {
 		case gitlab.MergeRequestStateClosed, gitlab.MergeRequestStateLocked:
@@ -486,6 +555,37 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 			}
 		}
 
+	case *bitbucketcloud.PullRequest:
+		for _, p := range m.Participants {
+			switch p.State {
+			case bitbucketcloud.ParticipantStateChangesRequested:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			case bitbucketcloud.ParticipantStateApproved:
+				states[btypes.ChangesetReviewStateApproved] = true
+			default:
+				if p.Role == bitbucketcloud.ParticipantRoleReviewer {
+					states[btypes.ChangesetReviewStatePending] = true
+				}
+			}
+		}
+
+	case *awscodecommit.PullRequest:
+		// AWS CodeCommit has no concept of requesting changes. Pull requests
+		// with approval rules are approved once all rules are satisfied or
+		// have been overridden, and pull requests without rules once anyone
+		// approved them.
+		if len(m.ApprovalRules) > 0 && m.Evaluation != nil {
+			if m.Evaluation.Approved || m.Evaluation.Overridden {
+				states[btypes.ChangesetReviewStateApproved] = true
+			}
+		} else {
+			for _, a := range m.Approvals {
+				if a.State == awscodecommit.ApprovalStateApprove {
+					states[btypes.ChangesetReviewStateApproved] = true
+				}
+			}
+		}
+
 	case *gitlab.MergeRequest:
 		// GitLab has an elaborate approvers workflow, but this doesn't map
 		// terribly closely to the GitHub/Bitbucket workflow: most notably,
@@ -585,6 +685,11 @@ func computeSyncState(ctx context.Context, c *btypes.Changeset, repo api.RepoNam
 		BaseRefOid: base,
 		HeadRefOid: head,
 		IsComplete: c.Complete(),
+		// These are maintained by the syncer and the reconciler.
+		AutoMergeWaitReason: c.SyncState.AutoMergeWaitReason,
+		RebaseOnto:          c.SyncState.RebaseOnto,
+		RebasedOnto:         c.SyncState.RebasedOnto,
+		RebaseFailedOnto:    c.SyncState.RebaseFailedOnto,
 	}, nil
 }
 
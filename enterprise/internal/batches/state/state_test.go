@@ -9,6 +9,8 @@ import (
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -276,6 +278,79 @@ func TestComputeBitbucketBuildStatus(t *testing.T) {
 	}
 }
 
+func TestComputeBitbucketCloudBuildStatus(t *testing.T) {
+	t.Parallel()
+
+	now := timeutil.Now()
+	lastSynced := now.Add(-1 * time.Minute)
+
+	statusEvent := func(uuid string, state bitbucketcloud.PullRequestStatusState) *btypes.ChangesetEvent {
+		return &btypes.ChangesetEvent{
+			Kind: btypes.ChangesetEventKindBitbucketCloudCommitStatus,
+			Metadata: &bitbucketcloud.PullRequestStatus{
+				UUID:      uuid,
+				State:     state,
+				UpdatedOn: now,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		statuses []*bitbucketcloud.PullRequestStatus
+		events   []*btypes.ChangesetEvent
+		want     btypes.ChangesetCheckState
+	}{
+		{
+			name: "empty",
+			want: btypes.ChangesetCheckStateUnknown,
+		},
+		{
+			name: "synced success",
+			statuses: []*bitbucketcloud.PullRequestStatus{
+				{UUID: "a", State: bitbucketcloud.PullRequestStatusStateSuccessful},
+			},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name: "stopped counts as failed",
+			events: []*btypes.ChangesetEvent{
+				statusEvent("a", bitbucketcloud.PullRequestStatusStateSuccessful),
+				statusEvent("b", bitbucketcloud.PullRequestStatusStateStopped),
+			},
+			want: btypes.ChangesetCheckStateFailed,
+		},
+		{
+			name: "pending + success",
+			events: []*btypes.ChangesetEvent{
+				statusEvent("a", bitbucketcloud.PullRequestStatusStateInProgress),
+				statusEvent("b", bitbucketcloud.PullRequestStatusStateSuccessful),
+			},
+			want: btypes.ChangesetCheckStatePending,
+		},
+		{
+			name: "events newer than sync have precedence",
+			statuses: []*bitbucketcloud.PullRequestStatus{
+				{UUID: "a", State: bitbucketcloud.PullRequestStatusStateInProgress},
+			},
+			events: []*btypes.ChangesetEvent{
+				statusEvent("a", bitbucketcloud.PullRequestStatusStateSuccessful),
+			},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &bitbucketcloud.PullRequest{Statuses: tc.statuses}
+			have := computeBitbucketCloudBuildStatus(lastSynced, pr, tc.events)
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf(diff)
+			}
+		})
+	}
+}
+
 func TestComputeGitLabCheckState(t *testing.T) {
 	t.Parallel()
 
@@ -535,6 +610,56 @@ func TestComputeReviewState(t *testing.T) {
 			},
 			want: btypes.ChangesetReviewStateChangesRequested,
 		},
+		{
+			name: "awscodecommit - approval rules not satisfied",
+			changeset: awsCodeCommitChangeset(daysAgo(0), &awscodecommit.PullRequest{
+				Status:        awscodecommit.PullRequestStatusOpen,
+				ApprovalRules: []*awscodecommit.ApprovalRule{{Name: "Require two approvals"}},
+				Approvals:     []*awscodecommit.Approval{{State: awscodecommit.ApprovalStateApprove}},
+				Evaluation:    &awscodecommit.Evaluation{RulesNotSatisfied: []string{"Require two approvals"}},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStatePending,
+		},
+		{
+			name: "awscodecommit - approval rules satisfied",
+			changeset: awsCodeCommitChangeset(daysAgo(0), &awscodecommit.PullRequest{
+				Status:        awscodecommit.PullRequestStatusOpen,
+				ApprovalRules: []*awscodecommit.ApprovalRule{{Name: "Require two approvals"}},
+				Evaluation:    &awscodecommit.Evaluation{Approved: true},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name: "awscodecommit - approval rules overridden",
+			changeset: awsCodeCommitChangeset(daysAgo(0), &awscodecommit.PullRequest{
+				Status:        awscodecommit.PullRequestStatusOpen,
+				ApprovalRules: []*awscodecommit.ApprovalRule{{Name: "Require two approvals"}},
+				Evaluation:    &awscodecommit.Evaluation{Overridden: true},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name: "awscodecommit - no approval rules, approved",
+			changeset: awsCodeCommitChangeset(daysAgo(0), &awscodecommit.PullRequest{
+				Status:     awscodecommit.PullRequestStatusOpen,
+				Approvals:  []*awscodecommit.Approval{{State: awscodecommit.ApprovalStateApprove}},
+				Evaluation: &awscodecommit.Evaluation{},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name: "awscodecommit - no approval rules, no approvals",
+			changeset: awsCodeCommitChangeset(daysAgo(0), &awscodecommit.PullRequest{
+				Status:     awscodecommit.PullRequestStatusOpen,
+				Evaluation: &awscodecommit.Evaluation{},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStatePending,
+		},
 	}
 
 	for i, tc := range tests {
@@ -715,6 +840,24 @@ func TestComputeExternalState(t *testing.T) {
 			},
 			want: btypes.ChangesetExternalStateDraft,
 		},
+		{
+			name: "awscodecommit - closed",
+			changeset: awsCodeCommitChangeset(daysAgo(0), &awscodecommit.PullRequest{
+				Status:  awscodecommit.PullRequestStatusClosed,
+				Targets: []*awscodecommit.PullRequestTarget{{IsMerged: false}},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name: "awscodecommit - merged",
+			changeset: awsCodeCommitChangeset(daysAgo(0), &awscodecommit.PullRequest{
+				Status:  awscodecommit.PullRequestStatusClosed,
+				Targets: []*awscodecommit.PullRequestTarget{{IsMerged: true}},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetExternalStateMerged,
+		},
 	}
 
 	for i, tc := range tests {
@@ -865,6 +1008,14 @@ func gitLabChangeset(updatedAt time.Time, state gitlab.MergeRequestState, notes
 	}
 }
 
+func awsCodeCommitChangeset(updatedAt time.Time, pr *awscodecommit.PullRequest) *btypes.Changeset {
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypeAWSCodeCommit,
+		UpdatedAt:           updatedAt,
+		Metadata:            pr,
+	}
+}
+
 func setDeletedAt(c *btypes.Changeset, deletedAt time.Time) *btypes.Changeset {
 	c.ExternalDeletedAt = deletedAt
 	return c
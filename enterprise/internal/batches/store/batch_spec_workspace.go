@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"sort"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 	"github.com/keegancsmith/sqlf"
@@ -35,6 +36,7 @@ var batchSpecWorkspaceInsertColumns = []string{
 	"ignored",
 	"skipped",
 	"cached_result_found",
+	"rebase_changeset_id",
 
 	"created_at",
 	"updated_at",
@@ -59,6 +61,7 @@ var BatchSpecWorkspaceColums = SQLColumns{
 	"batch_spec_workspaces.ignored",
 	"batch_spec_workspaces.skipped",
 	"batch_spec_workspaces.cached_result_found",
+	"batch_spec_workspaces.rebase_changeset_id",
 
 	"batch_spec_workspaces.created_at",
 	"batch_spec_workspaces.updated_at",
@@ -119,6 +122,7 @@ func (s *Store) CreateBatchSpecWorkspace(ctx context.Context, ws ...*btypes.Batc
 				wj.Ignored,
 				wj.Skipped,
 				wj.CachedResultFound,
+				nullInt64Column(wj.RebaseChangesetID),
 				wj.CreatedAt,
 				wj.UpdatedAt,
 			); err != nil {
@@ -146,7 +150,8 @@ func (s *Store) CreateBatchSpecWorkspace(ctx context.Context, ws ...*btypes.Batc
 
 // GetBatchSpecWorkspaceOpts captures the query options needed for getting a BatchSpecWorkspace
 type GetBatchSpecWorkspaceOpts struct {
-	ID int64
+	ID              int64
+	ChangesetSpecID int64
 }
 
 // GetBatchSpecWorkspace gets a BatchSpecWorkspace matching the given options.
@@ -183,7 +188,14 @@ LIMIT 1
 func getBatchSpecWorkspaceQuery(opts *GetBatchSpecWorkspaceOpts) *sqlf.Query {
 	preds := []*sqlf.Query{
 		sqlf.Sprintf("repo.deleted_at IS NULL"),
-		sqlf.Sprintf("batch_spec_workspaces.id = %s", opts.ID),
+	}
+
+	if opts.ID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.id = %s", opts.ID))
+	}
+
+	if opts.ChangesetSpecID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.changeset_spec_ids ? %s", strconv.FormatInt(opts.ChangesetSpecID, 10)))
 	}
 
 	return sqlf.Sprintf(
@@ -306,6 +318,7 @@ func scanBatchSpecWorkspace(wj *btypes.BatchSpecWorkspace, s dbutil.Scanner) err
 		&wj.Ignored,
 		&wj.Skipped,
 		&wj.CachedResultFound,
+		&dbutil.NullInt64{N: &wj.RebaseChangesetID},
 		&wj.CreatedAt,
 		&wj.UpdatedAt,
 	); err != nil {
@@ -64,6 +64,10 @@ func testStoreBatchSpecWorkspaces(t *testing.T, ctx context.Context, s *Store, c
 			CachedResultFound:  true,
 		}
 
+		if i == 0 {
+			job.RebaseChangesetID = 789
+		}
+
 		if i == cap(workspaces)-1 {
 			job.RepoID = deletedRepo.ID
 		}
@@ -116,6 +120,20 @@ func testStoreBatchSpecWorkspaces(t *testing.T, ctx context.Context, s *Store, c
 			}
 		})
 
+		t.Run("GetByChangesetSpecID", func(t *testing.T) {
+			job := workspaces[0]
+			for _, id := range job.ChangesetSpecIDs {
+				have, err := s.GetBatchSpecWorkspace(ctx, GetBatchSpecWorkspaceOpts{ChangesetSpecID: id})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := cmp.Diff(have, job); diff != "" {
+					t.Fatal(diff)
+				}
+			}
+		})
+
 		t.Run("NoResults", func(t *testing.T) {
 			opts := GetBatchSpecWorkspaceOpts{ID: 0xdeadbeef}
 
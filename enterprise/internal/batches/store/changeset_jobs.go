@@ -203,6 +203,10 @@ func scanChangesetJob(c *btypes.ChangesetJob, s dbutil.Scanner) error {
 		c.Payload = new(btypes.ChangesetJobClosePayload)
 	case btypes.ChangesetJobTypePublish:
 		c.Payload = new(btypes.ChangesetJobPublishPayload)
+	case btypes.ChangesetJobTypeRequestReviewers:
+		c.Payload = new(btypes.ChangesetJobRequestReviewersPayload)
+	case btypes.ChangesetJobTypeAddLabels:
+		c.Payload = new(btypes.ChangesetJobAddLabelsPayload)
 	default:
 		return errors.Errorf("unknown job type %q", c.JobType)
 	}
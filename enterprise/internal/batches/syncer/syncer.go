@@ -10,15 +10,18 @@ import (
 	"github.com/inconshreveable/log15"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/global"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
 
 // externalServiceSyncerInterval is the time in between synchronizations with the
@@ -486,14 +489,142 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, source sources.Chan
 	// Reset syncer error message state.
 	c.SyncErrorMessage = nil
 
+	enqueue, err := updatePolicyState(ctx, tx, repo, c)
+	if err != nil {
+		return err
+	}
+
 	err = tx.UpdateChangesetCodeHostState(ctx, c)
 	if err != nil {
 		return err
 	}
 
+	if enqueue {
+		if err := tx.EnqueueChangeset(ctx, c, global.DefaultReconcilerEnqueueState(), btypes.ReconcilerStateCompleted); err != nil {
+			return err
+		}
+	}
+
 	return tx.UpsertChangesetEvents(ctx, events...)
 }
 
+// updatePolicyState updates the sync state of the changeset according to the
+// auto-merge and auto-rebase policies of its current changeset spec. It
+// returns true if the changeset needs to be enqueued, so that the reconciler
+// merges or rebases it.
+func updatePolicyState(ctx context.Context, tx *store.Store, repo *types.Repo, c *btypes.Changeset) (bool, error) {
+	// The reconciler records if the code host refused to merge the changeset.
+	wasNotMergeable := c.SyncState.AutoMergeWaitReason == btypes.ChangesetAutoMergeWaitReasonNotMergeable
+	c.SyncState.AutoMergeWaitReason = btypes.ChangesetAutoMergeWaitReasonNone
+
+	if c.CurrentSpecID == 0 || !c.Published() ||
+		(c.ExternalState != btypes.ChangesetExternalStateOpen && c.ExternalState != btypes.ChangesetExternalStateDraft) {
+		c.SyncState.RebaseOnto = ""
+		return false, nil
+	}
+
+	spec, err := tx.GetChangesetSpecByID(ctx, c.CurrentSpecID)
+	if err != nil {
+		return false, errors.Wrap(err, "loading changeset spec")
+	}
+
+	wantMerge := updateAutoMergeWaitReason(c, spec)
+	wantRebase := updateRebaseOnto(ctx, repo, c, spec, wasNotMergeable)
+
+	// If the reconciler didn't finish processing the changeset yet, it merges
+	// or rebases the changeset when it gets to it, so we don't need to
+	// enqueue it again.
+	return (wantMerge || wantRebase) && c.ReconcilerState == btypes.ReconcilerStateCompleted, nil
+}
+
+// updateAutoMergeWaitReason records in the sync state of the changeset why it
+// is waiting to be merged, if the changeset spec has an auto-merge policy. It
+// returns true if the changeset is ready to be merged.
+func updateAutoMergeWaitReason(c *btypes.Changeset, spec *btypes.ChangesetSpec) bool {
+	if !spec.Spec.AutoMerge.Enabled() {
+		return false
+	}
+
+	if reason := c.AutoMergeWaitReason(); reason != btypes.ChangesetAutoMergeWaitReasonNone {
+		c.SyncState.AutoMergeWaitReason = reason
+		return false
+	}
+
+	// The changeset is merged once the rollout windows allow it.
+	enqueueState := c.ReconcilerState
+	if c.ReconcilerState == btypes.ReconcilerStateCompleted {
+		enqueueState = global.DefaultReconcilerEnqueueState()
+	}
+	if enqueueState == btypes.ReconcilerStateScheduled {
+		c.SyncState.AutoMergeWaitReason = btypes.ChangesetAutoMergeWaitReasonRolloutWindow
+	} else {
+		c.SyncState.AutoMergeWaitReason = btypes.ChangesetAutoMergeWaitReasonQueued
+	}
+	return true
+}
+
+// updateRebaseOnto records in the sync state of the changeset which commit of
+// its base branch it needs to be rebased onto, if the changeset spec has an
+// auto-rebase policy and the changeset went stale: either the base branch
+// moved since the changeset spec was created, or the code host refused to
+// merge the changeset. It returns true if a rebase onto a new commit is
+// required.
+func updateRebaseOnto(ctx context.Context, repo *types.Repo, c *btypes.Changeset, spec *btypes.ChangesetSpec, notMergeable bool) bool {
+	if !spec.Spec.AutoRebase {
+		c.SyncState.RebaseOnto = ""
+		return false
+	}
+
+	base, err := git.ResolveRevision(ctx, repo.Name, spec.Spec.BaseRef, git.ResolveRevisionOptions{})
+	if err != nil {
+		// We try again the next time the changeset is synced.
+		log15.Warn("Resolving base of changeset with auto-rebase policy", "changeset", c.ID, "err", err)
+		return false
+	}
+
+	if string(base) == spec.Spec.BaseRev && !notMergeable {
+		c.SyncState.RebaseOnto = ""
+		return false
+	}
+
+	// The reconciler rebased the changeset onto this commit already, or the
+	// diff of the changeset doesn't apply to it. We wait for the base branch
+	// to move again.
+	if string(base) == c.SyncState.RebasedOnto || string(base) == c.SyncState.RebaseFailedOnto {
+		c.SyncState.RebaseOnto = ""
+		return false
+	}
+
+	if headBasedOn(ctx, repo, c, base) {
+		c.SyncState.RebaseOnto = ""
+		return false
+	}
+
+	if c.SyncState.RebaseOnto == string(base) {
+		// The changeset is waiting for the reconciler to rebase it already.
+		return false
+	}
+	c.SyncState.RebaseOnto = string(base)
+	return true
+}
+
+// headBasedOn returns true if the head of the changeset contains the given
+// commit of its base branch, in which case rebasing it doesn't change
+// anything.
+func headBasedOn(ctx context.Context, repo *types.Repo, c *btypes.Changeset, base api.CommitID) bool {
+	if c.SyncState.HeadRefOid == "" {
+		return false
+	}
+	mergeBase, err := git.MergeBase(ctx, repo.Name, base, api.CommitID(c.SyncState.HeadRefOid))
+	if err != nil {
+		// The head commit might not be fetched yet, for example if it's on a
+		// fork. We rebase in that case.
+		log15.Warn("Computing merge base of changeset with auto-rebase policy", "changeset", c.ID, "err", err)
+		return false
+	}
+	return mergeBase == base
+}
+
 func loadChangesetSource(ctx context.Context, cf *httpcli.Factory, syncStore SyncStore, repo *types.Repo) (sources.ChangesetSource, error) {
 	srcer := sources.NewSourcer(cf)
 	// This is a ChangesetSource authenticated with the external service
@@ -41,6 +41,7 @@ type TestChangesetOpts struct {
 	NumResets       int64
 
 	SyncErrorMessage string
+	SyncState        btypes.ChangesetSyncState
 
 	OwnedByBatchChange int64
 
@@ -100,6 +101,8 @@ func BuildChangeset(opts TestChangesetOpts) *btypes.Changeset {
 		NumFailures:     opts.NumFailures,
 		NumResets:       opts.NumResets,
 
+		SyncState: opts.SyncState,
+
 		Metadata: opts.Metadata,
 	}
 
@@ -40,6 +40,9 @@ type TestSpecOpts struct {
 
 	BaseRev string
 	BaseRef string
+
+	AutoMerge  batcheslib.AutoMergeStrategy
+	AutoRebase bool
 }
 
 var TestChangsetSpecDiffStat = &diff.Stat{Added: 10, Changed: 5, Deleted: 2}
@@ -70,6 +73,9 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 			Title: opts.Title,
 			Body:  opts.Body,
 
+			AutoMerge:  opts.AutoMerge,
+			AutoRebase: opts.AutoRebase,
+
 			Commits: []batcheslib.GitCommitDescription{
 				{
 					Message:     opts.CommitMessage,
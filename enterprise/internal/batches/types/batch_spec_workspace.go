@@ -27,6 +27,12 @@ type BatchSpecWorkspace struct {
 	Skipped           bool
 	CachedResultFound bool
 
+	// RebaseChangesetID is the ID of the changeset with an auto-rebase policy
+	// that the workspace has been created for by the reconciler, to execute
+	// the workspace again against the new base of the changeset. It's zero
+	// for workspaces created by resolving a batch spec.
+	RebaseChangesetID int64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
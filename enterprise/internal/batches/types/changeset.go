@@ -11,6 +11,8 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -310,6 +312,33 @@ func (c *Changeset) Complete() bool {
 		c.ExternalState != ChangesetExternalStateDraft
 }
 
+// AutoMergeWaitReason returns why the Changeset can't be merged automatically
+// yet, based on its last synced external state. It returns
+// ChangesetAutoMergeWaitReasonNone if the checks passed and the Changeset has
+// been approved. The result is only meaningful for open or draft changesets.
+func (c *Changeset) AutoMergeWaitReason() ChangesetAutoMergeWaitReason {
+	if c.ExternalState == ChangesetExternalStateDraft {
+		return ChangesetAutoMergeWaitReasonDraft
+	}
+
+	switch c.ExternalCheckState {
+	case ChangesetCheckStatePassed:
+	case ChangesetCheckStateFailed:
+		return ChangesetAutoMergeWaitReasonChecksFailed
+	default:
+		return ChangesetAutoMergeWaitReasonChecksPending
+	}
+
+	switch c.ExternalReviewState {
+	case ChangesetReviewStateApproved:
+		return ChangesetAutoMergeWaitReasonNone
+	case ChangesetReviewStateChangesRequested:
+		return ChangesetAutoMergeWaitReasonChangesRequested
+	default:
+		return ChangesetAutoMergeWaitReasonReviewRequired
+	}
+}
+
 // Published returns whether the Changeset's PublicationState is Published.
 func (c *Changeset) Published() bool { return c.PublicationState.Published() }
 
@@ -373,6 +402,18 @@ func (c *Changeset) SetMetadata(meta interface{}) error {
 		c.ExternalServiceType = extsvc.TypeBitbucketServer
 		c.ExternalBranch = git.EnsureRefPrefix(pr.FromRef.ID)
 		c.ExternalUpdatedAt = unixMilliToTime(int64(pr.UpdatedDate))
+	case *bitbucketcloud.PullRequest:
+		c.Metadata = pr
+		c.ExternalID = strconv.FormatInt(int64(pr.ID), 10)
+		c.ExternalServiceType = extsvc.TypeBitbucketCloud
+		c.ExternalBranch = git.EnsureRefPrefix(pr.Source.Branch.Name)
+		c.ExternalUpdatedAt = pr.UpdatedOn
+	case *awscodecommit.PullRequest:
+		c.Metadata = pr
+		c.ExternalID = pr.ID
+		c.ExternalServiceType = extsvc.TypeAWSCodeCommit
+		c.ExternalBranch = git.EnsureRefPrefix(pr.Target().SourceReference)
+		c.ExternalUpdatedAt = pr.LastActivityDate
 	case *gitlab.MergeRequest:
 		c.Metadata = pr
 		c.ExternalID = strconv.FormatInt(int64(pr.IID), 10)
@@ -402,6 +443,10 @@ func (c *Changeset) Title() (string, error) {
 		return m.Title, nil
 	case *bitbucketserver.PullRequest:
 		return m.Title, nil
+	case *bitbucketcloud.PullRequest:
+		return m.Title, nil
+	case *awscodecommit.PullRequest:
+		return m.Title, nil
 	case *gitlab.MergeRequest:
 		return m.Title, nil
 	default:
@@ -419,6 +464,12 @@ func (c *Changeset) AuthorName() (string, error) {
 			return "", nil
 		}
 		return m.Author.User.Name, nil
+	case *bitbucketcloud.PullRequest:
+		return m.Author.Nickname, nil
+	case *awscodecommit.PullRequest:
+		// The author is identified by the ARN of an IAM user or role, whose
+		// name is the last path component.
+		return m.AuthorARN[strings.LastIndex(m.AuthorARN, "/")+1:], nil
 	case *gitlab.MergeRequest:
 		return m.Author.Username, nil
 	default:
@@ -442,6 +493,13 @@ func (c *Changeset) AuthorEmail() (string, error) {
 			return "", nil
 		}
 		return m.Author.User.EmailAddress, nil
+	case *bitbucketcloud.PullRequest:
+		// Bitbucket Cloud doesn't expose email addresses of accounts.
+		return "", nil
+	case *awscodecommit.PullRequest:
+		// AWS CodeCommit pull requests are authored by IAM identities, which
+		// don't have email addresses.
+		return "", nil
 	case *gitlab.MergeRequest:
 		return m.Author.Email, nil
 	default:
@@ -458,6 +516,10 @@ func (c *Changeset) ExternalCreatedAt() time.Time {
 		return m.CreatedAt
 	case *bitbucketserver.PullRequest:
 		return unixMilliToTime(int64(m.CreatedDate))
+	case *bitbucketcloud.PullRequest:
+		return m.CreatedOn
+	case *awscodecommit.PullRequest:
+		return m.CreationDate
 	case *gitlab.MergeRequest:
 		return m.CreatedAt.Time
 	default:
@@ -472,6 +534,10 @@ func (c *Changeset) Body() (string, error) {
 		return m.Body, nil
 	case *bitbucketserver.PullRequest:
 		return m.Description, nil
+	case *bitbucketcloud.PullRequest:
+		return m.Description, nil
+	case *awscodecommit.PullRequest:
+		return m.Description, nil
 	case *gitlab.MergeRequest:
 		return m.Description, nil
 	default:
@@ -509,6 +575,10 @@ func (c *Changeset) URL() (s string, err error) {
 		}
 		selfLink := m.Links.Self[0]
 		return selfLink.Href, nil
+	case *bitbucketcloud.PullRequest:
+		return m.Links.HTML.Href, nil
+	case *awscodecommit.PullRequest:
+		return m.URL, nil
 	case *gitlab.MergeRequest:
 		return m.WebURL, nil
 	default:
@@ -600,6 +670,39 @@ func (c *Changeset) Events() (events []*ChangesetEvent, err error) {
 			}
 		}
 
+	case *bitbucketcloud.PullRequest:
+		events = make([]*ChangesetEvent, 0, len(m.Participants)+len(m.Statuses))
+		var kind ChangesetEventKind
+
+		for _, p := range m.Participants {
+			if kind, err = ChangesetEventKindFor(p); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         p.Key(),
+				Kind:        kind,
+				Metadata:    p,
+			})
+		}
+
+		for _, s := range m.Statuses {
+			if kind, err = ChangesetEventKindFor(s); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         s.UUID,
+				Kind:        kind,
+				Metadata:    s,
+			})
+		}
+
+	case *awscodecommit.PullRequest:
+		// AWS CodeCommit doesn't record when approvals were given, so there
+		// are no events: the review state is computed from the approval rule
+		// evaluation stored on the pull request.
+
 	case *gitlab.MergeRequest:
 		events = make([]*ChangesetEvent, 0, len(m.Notes)+len(m.ResourceStateEvents)+len(m.Pipelines))
 		var kind ChangesetEventKind
@@ -656,6 +759,11 @@ func (c *Changeset) HeadRefOid() (string, error) {
 		return m.HeadRefOid, nil
 	case *bitbucketserver.PullRequest:
 		return "", nil
+	case *bitbucketcloud.PullRequest:
+		// Bitbucket Cloud only returns abbreviated commit hashes.
+		return "", nil
+	case *awscodecommit.PullRequest:
+		return m.Target().SourceCommit, nil
 	case *gitlab.MergeRequest:
 		return m.DiffRefs.HeadSHA, nil
 	default:
@@ -671,6 +779,10 @@ func (c *Changeset) HeadRef() (string, error) {
 		return "refs/heads/" + m.HeadRefName, nil
 	case *bitbucketserver.PullRequest:
 		return m.FromRef.ID, nil
+	case *bitbucketcloud.PullRequest:
+		return "refs/heads/" + m.Source.Branch.Name, nil
+	case *awscodecommit.PullRequest:
+		return git.EnsureRefPrefix(m.Target().SourceReference), nil
 	case *gitlab.MergeRequest:
 		return "refs/heads/" + m.SourceBranch, nil
 	default:
@@ -687,6 +799,11 @@ func (c *Changeset) BaseRefOid() (string, error) {
 		return m.BaseRefOid, nil
 	case *bitbucketserver.PullRequest:
 		return "", nil
+	case *bitbucketcloud.PullRequest:
+		// Bitbucket Cloud only returns abbreviated commit hashes.
+		return "", nil
+	case *awscodecommit.PullRequest:
+		return m.Target().DestinationCommit, nil
 	case *gitlab.MergeRequest:
 		return m.DiffRefs.BaseSHA, nil
 	default:
@@ -702,6 +819,10 @@ func (c *Changeset) BaseRef() (string, error) {
 		return "refs/heads/" + m.BaseRefName, nil
 	case *bitbucketserver.PullRequest:
 		return m.ToRef.ID, nil
+	case *bitbucketcloud.PullRequest:
+		return "refs/heads/" + m.Destination.Branch.Name, nil
+	case *awscodecommit.PullRequest:
+		return git.EnsureRefPrefix(m.Target().DestinationReference), nil
 	case *gitlab.MergeRequest:
 		return "refs/heads/" + m.TargetBranch, nil
 	default:
@@ -964,6 +1085,17 @@ func ChangesetEventKindFor(e interface{}) (ChangesetEventKind, error) {
 		return ChangesetEventKind("bitbucketserver:participant_status:" + strings.ToLower(string(e.Action))), nil
 	case *bitbucketserver.CommitStatus:
 		return ChangesetEventKindBitbucketServerCommitStatus, nil
+	case *bitbucketcloud.Participant:
+		switch e.State {
+		case bitbucketcloud.ParticipantStateApproved:
+			return ChangesetEventKindBitbucketCloudApproved, nil
+		case bitbucketcloud.ParticipantStateChangesRequested:
+			return ChangesetEventKindBitbucketCloudChangesRequested, nil
+		default:
+			return ChangesetEventKindBitbucketCloudUnapproved, nil
+		}
+	case *bitbucketcloud.PullRequestStatus:
+		return ChangesetEventKindBitbucketCloudCommitStatus, nil
 	case *gitlab.Pipeline:
 		return ChangesetEventKindGitLabPipeline, nil
 	case *gitlab.ReviewApprovedEvent:
@@ -999,6 +1131,13 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (interface{}, error) {
 		default:
 			return new(bitbucketserver.Activity), nil
 		}
+	case strings.HasPrefix(string(k), "bitbucketcloud"):
+		switch k {
+		case ChangesetEventKindBitbucketCloudCommitStatus:
+			return new(bitbucketcloud.PullRequestStatus), nil
+		default:
+			return new(bitbucketcloud.Participant), nil
+		}
 	case strings.HasPrefix(string(k), "github"):
 		switch k {
 		case ChangesetEventKindGitHubAssigned:
@@ -9,6 +9,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -69,6 +70,15 @@ const (
 	// clearly convey that it only occurs when a request for changes has been dismissed.
 	ChangesetEventKindBitbucketServerDismissed ChangesetEventKind = "bitbucketserver:participant_status:unapproved"
 
+	// Bitbucket Cloud doesn't expose a timeline of review activities, so these
+	// are derived from the current review state of each participant. A
+	// participant without a review state, for example after withdrawing an
+	// approval, is recorded as Unapproved.
+	ChangesetEventKindBitbucketCloudApproved         ChangesetEventKind = "bitbucketcloud:approved"
+	ChangesetEventKindBitbucketCloudChangesRequested ChangesetEventKind = "bitbucketcloud:changes_requested"
+	ChangesetEventKindBitbucketCloudUnapproved       ChangesetEventKind = "bitbucketcloud:unapproved"
+	ChangesetEventKindBitbucketCloudCommitStatus     ChangesetEventKind = "bitbucketcloud:commit_status"
+
 	ChangesetEventKindGitLabApproved             ChangesetEventKind = "gitlab:approved"
 	ChangesetEventKindGitLabClosed               ChangesetEventKind = "gitlab:closed"
 	ChangesetEventKindGitLabMerged               ChangesetEventKind = "gitlab:merged"
@@ -115,6 +125,9 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 	case *bitbucketserver.ParticipantStatusEvent:
 		return meta.User.Name
 
+	case *bitbucketcloud.Participant:
+		return meta.User.UUID
+
 	case *gitlab.ReviewApprovedEvent:
 		return meta.Author.Username
 
@@ -130,9 +143,13 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 	switch e.Kind {
 	case ChangesetEventKindBitbucketServerApproved,
+		ChangesetEventKindBitbucketCloudApproved,
 		ChangesetEventKindGitLabApproved:
 		return ChangesetReviewStateApproved, nil
 
+	case ChangesetEventKindBitbucketCloudChangesRequested:
+		return ChangesetReviewStateChangesRequested, nil
+
 	// BitbucketServer's "REVIEWED" activity is created when someone clicks
 	// the "Needs work" button in the UI, which is why we map it to "Changes Requested"
 	case ChangesetEventKindBitbucketServerReviewed:
@@ -155,6 +172,7 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 	case ChangesetEventKindGitHubReviewDismissed,
 		ChangesetEventKindBitbucketServerUnapproved,
 		ChangesetEventKindBitbucketServerDismissed,
+		ChangesetEventKindBitbucketCloudUnapproved,
 		ChangesetEventKindGitLabUnapproved:
 		return ChangesetReviewStateDismissed, nil
 
@@ -221,6 +239,10 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = unixMilliToTime(int64(ev.CreatedDate))
 	case *bitbucketserver.CommitStatus:
 		t = unixMilliToTime(ev.Status.DateAdded)
+	case *bitbucketcloud.Participant:
+		t = ev.ParticipatedOn
+	case *bitbucketcloud.PullRequestStatus:
+		t = ev.UpdatedOn
 	case *gitlab.ReviewApprovedEvent:
 		t = ev.CreatedAt.Time
 	case *gitlab.ReviewUnapprovedEvent:
@@ -602,6 +624,16 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 		// We always get the full event, so safe to replace it
 		*e = *o
 
+	case *bitbucketcloud.Participant:
+		o := o.Metadata.(*bitbucketcloud.Participant)
+		// We always get the full participant, so safe to replace it
+		*e = *o
+
+	case *bitbucketcloud.PullRequestStatus:
+		o := o.Metadata.(*bitbucketcloud.PullRequestStatus)
+		// We always get the full status, so safe to replace it
+		*e = *o
+
 	case *github.CheckRun:
 		o := o.Metadata.(*github.CheckRun)
 		if e.Status == "" {
@@ -47,6 +47,9 @@ var (
 	ChangesetJobTypeMerge     ChangesetJobType = "merge"
 	ChangesetJobTypeClose     ChangesetJobType = "close"
 	ChangesetJobTypePublish   ChangesetJobType = "publish"
+
+	ChangesetJobTypeRequestReviewers ChangesetJobType = "requestreviewers"
+	ChangesetJobTypeAddLabels        ChangesetJobType = "addlabels"
 )
 
 type ChangesetJobCommentPayload struct {
@@ -67,6 +70,15 @@ type ChangesetJobPublishPayload struct {
 	Draft bool `json:"draft"`
 }
 
+type ChangesetJobRequestReviewersPayload struct {
+	Reviewers     []string `json:"reviewers"`
+	TeamReviewers []string `json:"teamReviewers,omitempty"`
+}
+
+type ChangesetJobAddLabelsPayload struct {
+	Labels []string `json:"labels"`
+}
+
 // ChangesetJob describes a one-time action to be taken on a changeset.
 type ChangesetJob struct {
 	ID int64
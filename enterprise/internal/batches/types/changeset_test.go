@@ -214,6 +214,48 @@ func TestChangeset_ExternalCreatedAt(t *testing.T) {
 	})
 }
 
+func TestChangeset_AutoMergeWaitReason(t *testing.T) {
+	for name, tc := range map[string]struct {
+		changeset Changeset
+		want      ChangesetAutoMergeWaitReason
+	}{
+		"ready": {
+			changeset: Changeset{ExternalState: ChangesetExternalStateOpen, ExternalCheckState: ChangesetCheckStatePassed, ExternalReviewState: ChangesetReviewStateApproved},
+			want:      ChangesetAutoMergeWaitReasonNone,
+		},
+		"draft": {
+			changeset: Changeset{ExternalState: ChangesetExternalStateDraft, ExternalCheckState: ChangesetCheckStatePassed, ExternalReviewState: ChangesetReviewStateApproved},
+			want:      ChangesetAutoMergeWaitReasonDraft,
+		},
+		"checks pending": {
+			changeset: Changeset{ExternalState: ChangesetExternalStateOpen, ExternalCheckState: ChangesetCheckStatePending, ExternalReviewState: ChangesetReviewStateApproved},
+			want:      ChangesetAutoMergeWaitReasonChecksPending,
+		},
+		"checks unknown": {
+			changeset: Changeset{ExternalState: ChangesetExternalStateOpen, ExternalCheckState: ChangesetCheckStateUnknown, ExternalReviewState: ChangesetReviewStateApproved},
+			want:      ChangesetAutoMergeWaitReasonChecksPending,
+		},
+		"checks failed": {
+			changeset: Changeset{ExternalState: ChangesetExternalStateOpen, ExternalCheckState: ChangesetCheckStateFailed, ExternalReviewState: ChangesetReviewStateApproved},
+			want:      ChangesetAutoMergeWaitReasonChecksFailed,
+		},
+		"review pending": {
+			changeset: Changeset{ExternalState: ChangesetExternalStateOpen, ExternalCheckState: ChangesetCheckStatePassed, ExternalReviewState: ChangesetReviewStatePending},
+			want:      ChangesetAutoMergeWaitReasonReviewRequired,
+		},
+		"changes requested": {
+			changeset: Changeset{ExternalState: ChangesetExternalStateOpen, ExternalCheckState: ChangesetCheckStatePassed, ExternalReviewState: ChangesetReviewStateChangesRequested},
+			want:      ChangesetAutoMergeWaitReasonChangesRequested,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := tc.changeset.AutoMergeWaitReason(); have != tc.want {
+				t.Errorf("unexpected wait reason: have %q; want %q", have, tc.want)
+			}
+		})
+	}
+}
+
 func TestChangeset_Body(t *testing.T) {
 	want := "foo"
 	for name, meta := range map[string]interface{}{
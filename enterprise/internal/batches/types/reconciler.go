@@ -16,6 +16,8 @@ const (
 	ReconcilerOperationSleep        ReconcilerOperation = "SLEEP"
 	ReconcilerOperationDetach       ReconcilerOperation = "DETACH"
 	ReconcilerOperationArchive      ReconcilerOperation = "ARCHIVE"
+	ReconcilerOperationMerge        ReconcilerOperation = "MERGE"
+	ReconcilerOperationRebase       ReconcilerOperation = "REBASE"
 )
 
 // Valid returns true if the given ReconcilerOperation is valid.
@@ -32,7 +34,9 @@ func (r ReconcilerOperation) Valid() bool {
 		ReconcilerOperationReopen,
 		ReconcilerOperationSleep,
 		ReconcilerOperationDetach,
-		ReconcilerOperationArchive:
+		ReconcilerOperationArchive,
+		ReconcilerOperationMerge,
+		ReconcilerOperationRebase:
 		return true
 	default:
 		return false
@@ -10,12 +10,47 @@ type ChangesetSyncState struct {
 	// the last time a sync occured. We use this to short circuit computing the
 	// sync state if the changeset remains closed.
 	IsComplete bool
+
+	// AutoMergeWaitReason is the reason why a changeset with an auto-merge
+	// policy hasn't been merged yet, as observed the last time it was
+	// synced.
+	AutoMergeWaitReason ChangesetAutoMergeWaitReason
+
+	// RebaseOnto is the commit of the base branch the changeset needs to be
+	// rebased onto, if its changeset spec has an auto-rebase policy and the
+	// changeset went stale since the changeset spec was created. The
+	// reconciler resets it once it rebased the changeset.
+	RebaseOnto string
+	// RebasedOnto is the commit of the base branch the reconciler last
+	// rebased the changeset onto.
+	RebasedOnto string
+	// RebaseFailedOnto is the commit of the base branch the diff of the
+	// changeset didn't apply to, so that the reconciler doesn't try to rebase
+	// the changeset onto it again.
+	RebaseFailedOnto string
 }
 
 func (state *ChangesetSyncState) Equals(old *ChangesetSyncState) bool {
 	return state.BaseRefOid == old.BaseRefOid && state.HeadRefOid == old.HeadRefOid && state.IsComplete == old.IsComplete
 }
 
+// ChangesetAutoMergeWaitReason describes why a changeset with an auto-merge
+// policy is waiting to be merged.
+type ChangesetAutoMergeWaitReason string
+
+// ChangesetAutoMergeWaitReason constants.
+const (
+	ChangesetAutoMergeWaitReasonNone             ChangesetAutoMergeWaitReason = ""
+	ChangesetAutoMergeWaitReasonDraft            ChangesetAutoMergeWaitReason = "DRAFT"
+	ChangesetAutoMergeWaitReasonChecksPending    ChangesetAutoMergeWaitReason = "CHECKS_PENDING"
+	ChangesetAutoMergeWaitReasonChecksFailed     ChangesetAutoMergeWaitReason = "CHECKS_FAILED"
+	ChangesetAutoMergeWaitReasonReviewRequired   ChangesetAutoMergeWaitReason = "REVIEW_REQUIRED"
+	ChangesetAutoMergeWaitReasonChangesRequested ChangesetAutoMergeWaitReason = "CHANGES_REQUESTED"
+	ChangesetAutoMergeWaitReasonRolloutWindow    ChangesetAutoMergeWaitReason = "ROLLOUT_WINDOW"
+	ChangesetAutoMergeWaitReasonQueued           ChangesetAutoMergeWaitReason = "QUEUED"
+	ChangesetAutoMergeWaitReasonNotMergeable     ChangesetAutoMergeWaitReason = "NOT_MERGEABLE"
+)
+
 // ChangesetSyncData represents data about the sync status of a changeset
 type ChangesetSyncData struct {
 	ChangesetID int64
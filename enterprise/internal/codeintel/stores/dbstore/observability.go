@@ -57,6 +57,7 @@ type operations struct {
 	markRepositoryAsDirty                       *observation.Operation
 	queueSize                                   *observation.Operation
 	referenceIDsAndFilters                      *observation.Operation
+	referencedPackageVersions                   *observation.Operation
 	referencesForUpload                         *observation.Operation
 	refreshCommitResolvability                  *observation.Operation
 	repoIDsByGlobPattern                        *observation.Operation
@@ -152,6 +153,7 @@ func newOperations(observationContext *observation.Context, metrics *metrics.Ope
 		markRepositoryAsDirty:               op("MarkRepositoryAsDirty"),
 		queueSize:                           op("QueueSize"),
 		referenceIDsAndFilters:              op("ReferenceIDsAndFilters"),
+		referencedPackageVersions:           op("ReferencedPackageVersions"),
 		referencesForUpload:                 op("ReferencesForUpload"),
 		refreshCommitResolvability:          op("RefreshCommitResolvability"),
 		repoIDsByGlobPattern:                op("repoIDsByGlobPattern"),
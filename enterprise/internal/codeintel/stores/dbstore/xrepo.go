@@ -191,6 +191,33 @@ const referenceIDsAndFiltersCountQuery = referenceIDsAndFiltersCTEDefinitions +
 SELECT COUNT(distinct r.dump_id)
 ` + referenceIDsAndFiltersBaseQuery
 
+// ReferencedPackageVersions returns the distinct versions of the package with the given scheme and
+// name that are referenced by at least one completed upload.
+func (s *Store) ReferencedPackageVersions(ctx context.Context, scheme, name string) (_ []string, err error) {
+	ctx, traceLog, endObservation := s.operations.referencedPackageVersions.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("scheme", scheme),
+		log.String("name", name),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	versions, err := basestore.ScanStrings(s.Query(ctx, sqlf.Sprintf(referencedPackageVersionsQuery, scheme, name)))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numVersions", len(versions)))
+
+	return versions, nil
+}
+
+const referencedPackageVersionsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/xrepo.go:ReferencedPackageVersions
+SELECT DISTINCT r.version
+FROM lsif_references r
+JOIN lsif_uploads u ON u.id = r.dump_id
+WHERE r.scheme = %s AND r.name = %s AND u.state = 'completed'
+ORDER BY r.version
+`
+
 func monikersToString(vs []precise.QualifiedMonikerData) string {
 	strs := make([]string, 0, len(vs))
 	for _, v := range vs {
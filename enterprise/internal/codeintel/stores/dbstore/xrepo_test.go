@@ -423,6 +423,40 @@ func TestReferencesForUpload(t *testing.T) {
 	}
 }
 
+func TestReferencedPackageVersions(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	insertUploads(t, db,
+		Upload{ID: 1, Commit: makeCommit(2), Root: "sub1/"},
+		Upload{ID: 2, Commit: makeCommit(3), Root: "sub2/"},
+		Upload{ID: 3, Commit: makeCommit(4), Root: "sub3/"},
+		Upload{ID: 4, Commit: makeCommit(3), Root: "sub4/", State: "errored"},
+	)
+
+	insertPackageReferences(t, store, []shared.PackageReference{
+		{Package: shared.Package{DumpID: 1, Scheme: "gomod", Name: "leftpad", Version: "1.1.0"}, Filter: []byte("f1")},
+		{Package: shared.Package{DumpID: 2, Scheme: "gomod", Name: "leftpad", Version: "2.1.0"}, Filter: []byte("f2")},
+		{Package: shared.Package{DumpID: 3, Scheme: "gomod", Name: "leftpad", Version: "1.1.0"}, Filter: []byte("f3")},
+		{Package: shared.Package{DumpID: 3, Scheme: "gomod", Name: "rightpad", Version: "3.1.0"}, Filter: []byte("f4")},
+		{Package: shared.Package{DumpID: 3, Scheme: "npm", Name: "leftpad", Version: "4.1.0"}, Filter: []byte("f5")},
+		{Package: shared.Package{DumpID: 4, Scheme: "gomod", Name: "leftpad", Version: "5.1.0"}, Filter: []byte("f6")},
+	})
+
+	versions, err := store.ReferencedPackageVersions(context.Background(), "gomod", "leftpad")
+	if err != nil {
+		t.Fatalf("unexpected error getting versions: %s", err)
+	}
+
+	expected := []string{"1.1.0", "2.1.0"}
+	if diff := cmp.Diff(expected, versions); diff != "" {
+		t.Errorf("unexpected versions (-want +got):\n%s", diff)
+	}
+}
+
 // consumeScanner reads all values from the scanner into memory.
 func consumeScanner(scanner PackageReferenceScanner) (references []shared.PackageReference, _ error) {
 	for {
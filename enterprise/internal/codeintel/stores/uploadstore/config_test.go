@@ -190,6 +190,33 @@ func TestConfigGCS(t *testing.T) {
 	}
 }
 
+func TestConfigLocal(t *testing.T) {
+	env := map[string]string{
+		"PRECISE_CODE_INTEL_UPLOAD_BACKEND":   "Local",
+		"PRECISE_CODE_INTEL_UPLOAD_BUCKET":    "lsif-uploads",
+		"PRECISE_CODE_INTEL_UPLOAD_TTL":       "8h",
+		"PRECISE_CODE_INTEL_UPLOAD_LOCAL_DIR": "/data/test-uploads",
+	}
+
+	config := Config{}
+	config.SetMockGetter(mapGetter(env))
+	config.Load()
+
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+
+	if !config.ManageBucket {
+		t.Errorf("unexpected value for ManageBucket. want=%v have=%v", true, config.ManageBucket)
+	}
+	if config.TTL != 8*time.Hour {
+		t.Errorf("unexpected value for Local.TTL. want=%v have=%v", 8*time.Hour, config.TTL)
+	}
+	if config.Local.Dir != "/data/test-uploads" {
+		t.Errorf("unexpected value for Local.Dir. want=%s have=%s", "/data/test-uploads", config.Local.Dir)
+	}
+}
+
 func mapGetter(env map[string]string) func(name, defaultValue, description string) string {
 	return func(name, defaultValue, description string) string {
 		if v, ok := env[name]; ok {
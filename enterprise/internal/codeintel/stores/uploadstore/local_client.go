@@ -0,0 +1,277 @@
+package uploadstore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type localStore struct {
+	dir          string
+	manageBucket bool
+	ttl          time.Duration
+	operations   *operations
+
+	startJanitor sync.Once
+}
+
+var _ Store = &localStore{}
+
+type LocalConfig struct {
+	Dir string
+}
+
+func (c *LocalConfig) load(parent *env.BaseConfig) {
+	c.Dir = parent.Get("PRECISE_CODE_INTEL_UPLOAD_LOCAL_DIR", "/data/uploads", "The root directory to store uploads in when using the local backend.")
+}
+
+// localExpirationInterval is the interval at which objects older than the TTL are removed
+// from a local store.
+const localExpirationInterval = time.Hour
+
+// tempFilePrefix is the prefix of the files that objects are written to before they are
+// atomically moved to their key.
+const tempFilePrefix = ".tmp-"
+
+// newLocalFromConfig creates a new store backed by a directory on the local disk. Each
+// object is stored in a file at its key relative to a directory named after the bucket.
+func newLocalFromConfig(ctx context.Context, config *Config, operations *operations) (Store, error) {
+	return newLocal(filepath.Join(config.Local.Dir, config.Bucket), config.ManageBucket, config.TTL, operations), nil
+}
+
+func newLocal(dir string, manageBucket bool, ttl time.Duration, operations *operations) *localStore {
+	return &localStore{
+		dir:          dir,
+		manageBucket: manageBucket,
+		ttl:          ttl,
+		operations:   operations,
+	}
+}
+
+// Init creates the target directory. As there is no bucket lifecycle configuration on a
+// local disk, it also starts a background routine that removes expired objects.
+func (s *localStore) Init(ctx context.Context) error {
+	if !s.manageBucket {
+		return nil
+	}
+
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to create directory")
+	}
+
+	s.startJanitor.Do(func() {
+		go goroutine.NewPeriodicGoroutine(context.Background(), localExpirationInterval, goroutine.NewHandlerWithErrorMessage(
+			"codeintel.uploadstore.local-expirer",
+			func(ctx context.Context) error { return s.expireObjects(ctx, time.Now()) },
+		)).Start()
+	})
+
+	return nil
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	ctx, endObservation := s.operations.get.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	return f, nil
+}
+
+func (s *localStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	ctx, endObservation := s.operations.upload.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	n, err := s.write(key, func(w io.Writer) (int64, error) {
+		return io.Copy(w, r)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return n, nil
+}
+
+func (s *localStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	ctx, endObservation := s.operations.compose.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("destination", destination),
+		log.String("sources", strings.Join(sources, ", ")),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	paths := make([]string, 0, len(sources))
+	for _, source := range sources {
+		path, err := s.path(source)
+		if err != nil {
+			return 0, err
+		}
+		paths = append(paths, path)
+	}
+
+	n, err := s.write(destination, func(w io.Writer) (int64, error) {
+		var total int64
+		for _, path := range paths {
+			n, err := copyFile(w, path)
+			total += n
+			if err != nil {
+				return total, err
+			}
+		}
+		return total, nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to compose object")
+	}
+
+	// Delete sources on success
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log15.Error("Failed to delete source object", "error", err)
+		}
+	}
+
+	return n, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) (err error) {
+	ctx, endObservation := s.operations.delete.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete object")
+	}
+
+	return nil
+}
+
+// expireObjects removes all objects that were last written more than the store's TTL before
+// the given time. This includes temporary files of writes that never completed.
+func (s *localStore) expireObjects(ctx context.Context, now time.Time) error {
+	return filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if now.Sub(info.ModTime()) <= s.ttl {
+			return nil
+		}
+
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to delete expired object")
+		}
+		return nil
+	})
+}
+
+// write atomically replaces the object at the given key with the content written by fn. The
+// content is written to a temporary file next to the target, which is renamed once fn returns
+// successfully, so readers never observe a partially written object.
+func (s *localStore) write(key string, fn func(w io.Writer) (int64, error)) (_ int64, err error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return 0, err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), tempFilePrefix)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	n, err := fn(f)
+	if err != nil {
+		return 0, err
+	}
+	if err := f.Sync(); err != nil {
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// path returns the path of the file that stores the object at the given key. Keys that would
+// resolve to a path outside of the store's directory are rejected.
+func (s *localStore) path(key string) (string, error) {
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if rel, err := filepath.Rel(s.dir, path); err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid key %q", key)
+	}
+	if strings.HasPrefix(filepath.Base(path), tempFilePrefix) {
+		return "", errors.Errorf("invalid key %q", key)
+	}
+
+	return path, nil
+}
+
+// copyFile writes the content of the file at the given path to the given writer.
+func copyFile(w io.Writer, path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return io.Copy(w, f)
+}
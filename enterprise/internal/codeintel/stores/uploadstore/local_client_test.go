@@ -0,0 +1,248 @@
+package uploadstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestLocalInit(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "test-bucket")
+	client := testLocalClient(dir)
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+
+	if info, err := os.Stat(dir); err != nil {
+		t.Fatalf("unexpected error statting directory: %s", err)
+	} else if !info.IsDir() {
+		t.Fatalf("expected %s to be a directory", dir)
+	}
+}
+
+func TestLocalUnmanagedInit(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "test-bucket")
+	client := newLocal(dir, false, time.Hour, newOperations(&observation.TestContext))
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("unexpected directory. err=%v", err)
+	}
+}
+
+func TestLocalUploadGet(t *testing.T) {
+	client := testLocalClient(t.TempDir())
+
+	size, err := client.Upload(context.Background(), "test-key", bytes.NewReader([]byte("TEST PAYLOAD")))
+	if err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	} else if size != 12 {
+		t.Errorf("unexpected size. want=%d have=%d", 12, size)
+	}
+
+	// Uploading to an existing key replaces its content
+	if _, err := client.Upload(context.Background(), "test-key", bytes.NewReader([]byte("NEW PAYLOAD"))); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	if contents := readLocalObject(t, client, "test-key"); contents != "NEW PAYLOAD" {
+		t.Errorf("unexpected contents. want=%s have=%s", "NEW PAYLOAD", contents)
+	}
+	if keys := localKeys(t, client); !cmp.Equal([]string{"test-key"}, keys) {
+		t.Errorf("unexpected files. want=%v have=%v", []string{"test-key"}, keys)
+	}
+}
+
+func TestLocalUploadError(t *testing.T) {
+	client := testLocalClient(t.TempDir())
+
+	if _, err := client.Upload(context.Background(), "test-key", bytes.NewReader([]byte("TEST PAYLOAD"))); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	// A failed write leaves the existing object in place and no temporary files behind
+	r := io.MultiReader(strings.NewReader("PARTIAL"), errReader{})
+	if _, err := client.Upload(context.Background(), "test-key", r); err == nil {
+		t.Fatalf("expected error uploading object")
+	}
+
+	if contents := readLocalObject(t, client, "test-key"); contents != "TEST PAYLOAD" {
+		t.Errorf("unexpected contents. want=%s have=%s", "TEST PAYLOAD", contents)
+	}
+	if keys := localKeys(t, client); !cmp.Equal([]string{"test-key"}, keys) {
+		t.Errorf("unexpected files. want=%v have=%v", []string{"test-key"}, keys)
+	}
+}
+
+func TestLocalGetMissing(t *testing.T) {
+	client := testLocalClient(t.TempDir())
+
+	if _, err := client.Get(context.Background(), "test-key"); err == nil {
+		t.Fatalf("expected error getting missing object")
+	}
+}
+
+func TestLocalInvalidKeys(t *testing.T) {
+	dir := t.TempDir()
+	client := testLocalClient(filepath.Join(dir, "test-bucket"))
+
+	for _, key := range []string{"", ".", "..", "../escape", "a/../../escape", tempFilePrefix + "123"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("TEST PAYLOAD")); err == nil {
+			t.Errorf("expected error uploading to key %q", key)
+		}
+		if _, err := client.Get(context.Background(), key); err == nil {
+			t.Errorf("expected error getting key %q", key)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "escape")); !os.IsNotExist(err) {
+		t.Fatalf("unexpected file outside of the store. err=%v", err)
+	}
+}
+
+func TestLocalCompose(t *testing.T) {
+	client := testLocalClient(t.TempDir())
+
+	for i, payload := range []string{"foo", "bar", "baz"} {
+		if _, err := client.Upload(context.Background(), "test-src"+string(rune('1'+i)), strings.NewReader(payload)); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+	}
+
+	size, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2", "test-src3")
+	if err != nil {
+		t.Fatalf("unexpected error composing objects: %s", err)
+	} else if size != 9 {
+		t.Errorf("unexpected size. want=%d have=%d", 9, size)
+	}
+
+	if contents := readLocalObject(t, client, "test-key"); contents != "foobarbaz" {
+		t.Errorf("unexpected contents. want=%s have=%s", "foobarbaz", contents)
+	}
+
+	// Sources are removed after a successful compose
+	if keys := localKeys(t, client); !cmp.Equal([]string{"test-key"}, keys) {
+		t.Errorf("unexpected files. want=%v have=%v", []string{"test-key"}, keys)
+	}
+}
+
+func TestLocalComposeMissingSource(t *testing.T) {
+	client := testLocalClient(t.TempDir())
+
+	if _, err := client.Upload(context.Background(), "test-src1", strings.NewReader("foo")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	if _, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2"); err == nil {
+		t.Fatalf("expected error composing objects")
+	}
+
+	// Sources are kept and no destination is written on failure
+	if keys := localKeys(t, client); !cmp.Equal([]string{"test-src1"}, keys) {
+		t.Errorf("unexpected files. want=%v have=%v", []string{"test-src1"}, keys)
+	}
+}
+
+func TestLocalDelete(t *testing.T) {
+	client := testLocalClient(t.TempDir())
+
+	if _, err := client.Upload(context.Background(), "test-key", strings.NewReader("TEST PAYLOAD")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	if err := client.Delete(context.Background(), "test-key"); err != nil {
+		t.Fatalf("unexpected error deleting object: %s", err)
+	}
+	if keys := localKeys(t, client); len(keys) != 0 {
+		t.Errorf("unexpected files. want=%v have=%v", nil, keys)
+	}
+
+	// Deleting a missing object is not an error
+	if err := client.Delete(context.Background(), "test-key"); err != nil {
+		t.Fatalf("unexpected error deleting missing object: %s", err)
+	}
+}
+
+func TestLocalExpireObjects(t *testing.T) {
+	client := testLocalClient(t.TempDir())
+
+	now := time.Now()
+	for key, age := range map[string]time.Duration{
+		"fresh":        time.Minute,
+		"nested/fresh": time.Minute,
+		"stale":        2 * time.Hour,
+		"nested/stale": 2 * time.Hour,
+	} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("TEST PAYLOAD")); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+		path, _ := client.path(key)
+		if err := os.Chtimes(path, now.Add(-age), now.Add(-age)); err != nil {
+			t.Fatalf("unexpected error changing file times: %s", err)
+		}
+	}
+
+	if err := client.expireObjects(context.Background(), now); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+
+	if diff := cmp.Diff([]string{"fresh", "nested/fresh"}, localKeys(t, client)); diff != "" {
+		t.Errorf("unexpected files (-want +got):\n%s", diff)
+	}
+}
+
+func testLocalClient(dir string) *localStore {
+	return newLocal(dir, true, time.Hour, newOperations(&observation.TestContext))
+}
+
+func readLocalObject(t *testing.T, client Store, key string) string {
+	t.Helper()
+
+	rc, err := client.Get(context.Background(), key)
+	if err != nil {
+		t.Fatalf("unexpected error getting object: %s", err)
+	}
+	defer rc.Close()
+
+	contents, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("unexpected error reading object: %s", err)
+	}
+
+	return string(contents)
+}
+
+// localKeys returns the slash-separated paths of all files in the store's directory.
+func localKeys(t *testing.T, client *localStore) (keys []string) {
+	t.Helper()
+
+	if err := filepath.Walk(client.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(client.dir, path)
+		keys = append(keys, filepath.ToSlash(rel))
+		return err
+	}); err != nil {
+		t.Fatalf("unexpected error listing files: %s", err)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) { return 0, io.ErrUnexpectedEOF }
@@ -34,6 +34,7 @@ var storeConstructors = map[string]func(ctx context.Context, config *Config, ope
 	"s3":    newS3FromConfig,
 	"minio": newS3FromConfig,
 	"gcs":   newGCSFromConfig,
+	"local": newLocalFromConfig,
 }
 
 // CreateLazy initialize a new store from the given configuration that is initialized
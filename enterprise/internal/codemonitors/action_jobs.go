@@ -3,6 +3,7 @@ package codemonitors
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/keegancsmith/sqlf"
@@ -38,8 +39,14 @@ type ActionJobMetadata struct {
 	MonitorID   int64
 	NumResults  *int
 
+	// CreatedBy is the ID of the user who created the monitor.
+	CreatedBy int32
+
 	// The query with after: filter.
 	Query string
+
+	// Results holds the first matches found by the trigger query, if any.
+	Results []*MatchedCommit
 }
 
 // ActionJobColumns is the list of db columns used to populate an ActionJob struct.
@@ -183,12 +190,54 @@ func (s *codeMonitorStore) EnqueueActionEmailsForQueryIDInt64(ctx context.Contex
 	return s.Store.Exec(ctx, sqlf.Sprintf(enqueueActionEmailFmtStr, queryID, triggerEventID, triggerEventID))
 }
 
+const enqueueActionWebhookFmtStr = `
+WITH due AS (
+	SELECT w.id
+	FROM cm_webhooks w
+	INNER JOIN cm_queries q ON w.monitor = q.monitor
+	WHERE q.id = %s AND w.enabled = true
+),
+busy AS (
+	SELECT DISTINCT webhook as id FROM cm_action_jobs
+	WHERE state = 'queued'
+	OR state = 'processing'
+)
+INSERT INTO cm_action_jobs (webhook, trigger_event)
+SELECT id, %s::integer from due EXCEPT SELECT id, %s::integer from busy ORDER BY id
+`
+
+func (s *codeMonitorStore) EnqueueActionWebhooksForQueryIDInt64(ctx context.Context, queryID int64, triggerEventID int) (err error) {
+	return s.Store.Exec(ctx, sqlf.Sprintf(enqueueActionWebhookFmtStr, queryID, triggerEventID, triggerEventID))
+}
+
+const enqueueActionSlackWebhookFmtStr = `
+WITH due AS (
+	SELECT w.id
+	FROM cm_slack_webhooks w
+	INNER JOIN cm_queries q ON w.monitor = q.monitor
+	WHERE q.id = %s AND w.enabled = true
+),
+busy AS (
+	SELECT DISTINCT slack_webhook as id FROM cm_action_jobs
+	WHERE state = 'queued'
+	OR state = 'processing'
+)
+INSERT INTO cm_action_jobs (slack_webhook, trigger_event)
+SELECT id, %s::integer from due EXCEPT SELECT id, %s::integer from busy ORDER BY id
+`
+
+func (s *codeMonitorStore) EnqueueActionSlackWebhooksForQueryIDInt64(ctx context.Context, queryID int64, triggerEventID int) (err error) {
+	return s.Store.Exec(ctx, sqlf.Sprintf(enqueueActionSlackWebhookFmtStr, queryID, triggerEventID, triggerEventID))
+}
+
 const getActionJobMetadataFmtStr = `
 SELECT
 	cm.description,
 	ctj.query_string,
 	cm.id AS monitorID,
-	ctj.num_results
+	cm.created_by,
+	ctj.num_results,
+	ctj.search_results
 FROM cm_action_jobs caj
 INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
 INNER JOIN cm_queries cq on cq.id = ctj.query
@@ -199,7 +248,16 @@ WHERE caj.id = %s
 func (s *codeMonitorStore) GetActionJobMetadata(ctx context.Context, recordID int) (*ActionJobMetadata, error) {
 	row := s.Store.QueryRow(ctx, sqlf.Sprintf(getActionJobMetadataFmtStr, recordID))
 	m := &ActionJobMetadata{}
-	return m, row.Scan(&m.Description, &m.Query, &m.MonitorID, &m.NumResults)
+	var results []byte
+	if err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &m.CreatedBy, &m.NumResults, &results); err != nil {
+		return nil, err
+	}
+	if results != nil {
+		if err := json.Unmarshal(results, &m.Results); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
 }
 
 const actionJobForIDFmtStr = `
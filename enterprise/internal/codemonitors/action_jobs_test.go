@@ -61,7 +61,7 @@ func TestGetActionJobMetadata(t *testing.T) {
 	}
 
 	ctx, s := newTestStore(t)
-	_, _, _, userCTX := newTestUser(ctx, t)
+	_, userID, _, userCTX := newTestUser(ctx, t)
 	_, err := s.insertTestMonitor(userCTX, t)
 	if err != nil {
 		t.Fatal(err)
@@ -94,6 +94,7 @@ func TestGetActionJobMetadata(t *testing.T) {
 		Query:       wantQuery,
 		NumResults:  &wantNumResults,
 		MonitorID:   wantMonitorID,
+		CreatedBy:   userID,
 	}
 	if diff := cmp.Diff(got, want); diff != "" {
 		t.Fatalf("diff: %s", diff)
@@ -0,0 +1,142 @@
+package codemonitors
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+)
+
+type MonitorSlackWebhook struct {
+	Id        int64
+	Monitor   int64
+	Enabled   bool
+	URL       string
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+
+	// IncludeResults is true if payloads include the matched commits and
+	// diff excerpts. Results can contain confidential code, so it is opt-in.
+	IncludeResults bool
+}
+
+const createSlackWebhookActionFmtStr = `
+INSERT INTO cm_slack_webhooks
+(monitor, enabled, include_results, url, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateSlackWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string) (*MonitorSlackWebhook, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createSlackWebhookActionFmtStr,
+		monitorID,
+		enabled,
+		includeResults,
+		url,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(SlackWebhooksColumns, ", "),
+	)
+	return s.runSlackWebhookQuery(ctx, q)
+}
+
+const slackWebhookActionByIDFmtStr = `
+SELECT %s -- SlackWebhooksColumns
+FROM cm_slack_webhooks
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) SlackWebhookActionByIDInt64(ctx context.Context, slackWebhookID int64) (*MonitorSlackWebhook, error) {
+	return s.runSlackWebhookQuery(ctx, sqlf.Sprintf(slackWebhookActionByIDFmtStr, sqlf.Join(SlackWebhooksColumns, ", "), slackWebhookID))
+}
+
+const listSlackWebhookActionsFmtStr = `
+SELECT %s -- SlackWebhooksColumns
+FROM cm_slack_webhooks
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+// ListSlackWebhookActions lists Slack webhooks from cm_slack_webhooks with the given opts
+func (s *codeMonitorStore) ListSlackWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*MonitorSlackWebhook, error) {
+	q := sqlf.Sprintf(
+		listSlackWebhookActionsFmtStr,
+		sqlf.Join(SlackWebhooksColumns, ", "),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanSlackWebhooks(rows)
+}
+
+func (s *codeMonitorStore) runSlackWebhookQuery(ctx context.Context, q *sqlf.Query) (*MonitorSlackWebhook, error) {
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ws, err := scanSlackWebhooks(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ws) == 0 {
+		return nil, errors.Errorf("operation failed. Query should have returned 1 row")
+	}
+	return ws[0], nil
+}
+
+var SlackWebhooksColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_slack_webhooks.id"),
+	sqlf.Sprintf("cm_slack_webhooks.monitor"),
+	sqlf.Sprintf("cm_slack_webhooks.enabled"),
+	sqlf.Sprintf("cm_slack_webhooks.include_results"),
+	sqlf.Sprintf("cm_slack_webhooks.url"),
+	sqlf.Sprintf("cm_slack_webhooks.created_by"),
+	sqlf.Sprintf("cm_slack_webhooks.created_at"),
+	sqlf.Sprintf("cm_slack_webhooks.changed_by"),
+	sqlf.Sprintf("cm_slack_webhooks.changed_at"),
+}
+
+func scanSlackWebhooks(rows *sql.Rows) (ws []*MonitorSlackWebhook, err error) {
+	for rows.Next() {
+		w := &MonitorSlackWebhook{}
+		if err = rows.Scan(
+			&w.Id,
+			&w.Monitor,
+			&w.Enabled,
+			&w.IncludeResults,
+			&w.URL,
+			&w.CreatedBy,
+			&w.CreatedAt,
+			&w.ChangedBy,
+			&w.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	err = rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
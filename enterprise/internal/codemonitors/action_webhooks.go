@@ -0,0 +1,169 @@
+package codemonitors
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+type MonitorWebhook struct {
+	Id        int64
+	Monitor   int64
+	Enabled   bool
+	URL       string
+	Secret    *string
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+
+	// IncludeResults is true if payloads include the matched commits and
+	// diff excerpts. Results can contain confidential code, so it is opt-in.
+	IncludeResults bool
+}
+
+const createWebhookActionFmtStr = `
+INSERT INTO cm_webhooks
+(monitor, enabled, include_results, url, secret, encryption_key_id, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string, secret *string) (*MonitorWebhook, error) {
+	var encryptedSecret *string
+	var keyID string
+	if secret != nil {
+		encrypted, id, err := database.MaybeEncrypt(ctx, s.getEncryptionKey(), *secret)
+		if err != nil {
+			return nil, errors.Wrap(err, "encrypting webhook secret")
+		}
+		encryptedSecret, keyID = &encrypted, id
+	}
+
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createWebhookActionFmtStr,
+		monitorID,
+		enabled,
+		includeResults,
+		url,
+		encryptedSecret,
+		keyID,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(WebhooksColumns, ", "),
+	)
+	return s.runWebhookQuery(ctx, q)
+}
+
+const webhookActionByIDFmtStr = `
+SELECT %s -- WebhooksColumns
+FROM cm_webhooks
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) WebhookActionByIDInt64(ctx context.Context, webhookID int64) (*MonitorWebhook, error) {
+	return s.runWebhookQuery(ctx, sqlf.Sprintf(webhookActionByIDFmtStr, sqlf.Join(WebhooksColumns, ", "), webhookID))
+}
+
+const listWebhookActionsFmtStr = `
+SELECT %s -- WebhooksColumns
+FROM cm_webhooks
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+// ListWebhookActions lists webhooks from cm_webhooks with the given opts
+func (s *codeMonitorStore) ListWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*MonitorWebhook, error) {
+	q := sqlf.Sprintf(
+		listWebhookActionsFmtStr,
+		sqlf.Join(WebhooksColumns, ", "),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return s.scanWebhooks(ctx, rows)
+}
+
+func (s *codeMonitorStore) runWebhookQuery(ctx context.Context, q *sqlf.Query) (*MonitorWebhook, error) {
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ws, err := s.scanWebhooks(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(ws) == 0 {
+		return nil, errors.Errorf("operation failed. Query should have returned 1 row")
+	}
+	return ws[0], nil
+}
+
+var WebhooksColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_webhooks.id"),
+	sqlf.Sprintf("cm_webhooks.monitor"),
+	sqlf.Sprintf("cm_webhooks.enabled"),
+	sqlf.Sprintf("cm_webhooks.include_results"),
+	sqlf.Sprintf("cm_webhooks.url"),
+	sqlf.Sprintf("cm_webhooks.secret"),
+	sqlf.Sprintf("cm_webhooks.encryption_key_id"),
+	sqlf.Sprintf("cm_webhooks.created_by"),
+	sqlf.Sprintf("cm_webhooks.created_at"),
+	sqlf.Sprintf("cm_webhooks.changed_by"),
+	sqlf.Sprintf("cm_webhooks.changed_at"),
+}
+
+// scanWebhooks scans webhooks from rows and decrypts their secrets.
+func (s *codeMonitorStore) scanWebhooks(ctx context.Context, rows *sql.Rows) (ws []*MonitorWebhook, err error) {
+	for rows.Next() {
+		w := &MonitorWebhook{}
+		var keyID string
+		if err = rows.Scan(
+			&w.Id,
+			&w.Monitor,
+			&w.Enabled,
+			&w.IncludeResults,
+			&w.URL,
+			&w.Secret,
+			&keyID,
+			&w.CreatedBy,
+			&w.CreatedAt,
+			&w.ChangedBy,
+			&w.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		if w.Secret != nil {
+			secret, err := database.MaybeDecrypt(ctx, s.getEncryptionKey(), *w.Secret, keyID)
+			if err != nil {
+				return nil, errors.Wrap(err, "decrypting webhook secret")
+			}
+			w.Secret = &secret
+		}
+		ws = append(ws, w)
+	}
+	err = rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
@@ -0,0 +1,49 @@
+package codemonitors
+
+import (
+	"testing"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	et "github.com/sourcegraph/sourcegraph/internal/encryption/testing"
+)
+
+func TestWebhookActionSecretEncryption(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx, s := newTestStore(t)
+	s.key = et.TestKey{}
+	_, _, _, userCTX := newTestUser(ctx, t)
+	m, err := s.insertTestMonitor(userCTX, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := "hunter2"
+	created, err := s.CreateWebhookAction(userCTX, m.ID, true, false, "https://example.com/hook", &secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Secret == nil || *created.Secret != secret {
+		t.Fatalf("unexpected secret: %v", created.Secret)
+	}
+
+	stored, ok, err := basestore.ScanFirstString(s.Query(ctx, sqlf.Sprintf("SELECT secret FROM cm_webhooks WHERE id = %s", created.Id)))
+	if err != nil || !ok {
+		t.Fatalf("reading stored secret: %v", err)
+	}
+	if stored == secret {
+		t.Fatal("secret stored in plaintext")
+	}
+
+	got, err := s.WebhookActionByIDInt64(ctx, created.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Secret == nil || *got.Secret != secret {
+		t.Fatalf("unexpected secret: %v", got.Secret)
+	}
+}
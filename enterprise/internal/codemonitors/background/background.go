@@ -5,11 +5,14 @@ import (
 	"database/sql"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 )
 
 func StartBackgroundJobs(ctx context.Context, db *sql.DB) {
 	codeMonitorsStore := codemonitors.NewStore(db)
+	subRepoPerms := authz.NewSubRepoPermsClient(database.SubRepoPerms(db))
 
 	triggerMetrics := newMetricsForTriggerQueries()
 	actionMetrics := newActionMetrics()
@@ -19,7 +22,7 @@ func StartBackgroundJobs(ctx context.Context, db *sql.DB) {
 		newTriggerJobsLogDeleter(ctx, codeMonitorsStore),
 		newTriggerQueryRunner(ctx, codeMonitorsStore, triggerMetrics),
 		newTriggerQueryResetter(ctx, codeMonitorsStore, triggerMetrics),
-		newActionRunner(ctx, codeMonitorsStore, actionMetrics),
+		newActionRunner(ctx, codeMonitorsStore, subRepoPerms, actionMetrics),
 		newActionJobResetter(ctx, codeMonitorsStore, actionMetrics),
 	}
 	go goroutine.MonitorBackgroundRoutines(ctx, routines...)
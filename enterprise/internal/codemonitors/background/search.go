@@ -0,0 +1,81 @@
+package background
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+)
+
+const searchClientUserAgent = "Code monitors trigger query runner"
+
+// search runs the trigger query with the streaming search API of the frontend
+// and returns its commit and diff matches. Trigger queries only yield commit
+// and diff matches, so other matches are ignored.
+//
+// The query runs with internal access. Callers must filter the matches by the
+// permissions of the user they are shown to.
+func search(ctx context.Context, query string) (_ []*streamhttp.EventCommitMatch, err error) {
+	req, err := streamhttp.NewRequest(api.InternalClient.URL+"/.internal", query)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", searchClientUserAgent)
+
+	resp, err := httpcli.InternalDoer.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "streaming search")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.Errorf("streaming search: unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	var matches []*streamhttp.EventCommitMatch
+	dec := streamhttp.FrontendStreamDecoder{
+		OnMatches: func(events []streamhttp.EventMatch) {
+			for _, e := range events {
+				if m, ok := e.(*streamhttp.EventCommitMatch); ok {
+					matches = append(matches, m)
+				}
+			}
+		},
+		OnError: func(e *streamhttp.EventError) {
+			err = errors.New(e.Message)
+		},
+	}
+	if decErr := dec.ReadAll(resp.Body); decErr != nil {
+		return nil, errors.Wrap(decErr, "decoding streaming search")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// latestResultTime returns the latest author date of the given matches, or
+// previousLastResult if there are none.
+func latestResultTime(previousLastResult *time.Time, matches []*streamhttp.EventCommitMatch) time.Time {
+	var latest time.Time
+	for _, m := range matches {
+		if m.AuthorDate.After(latest) {
+			latest = m.AuthorDate
+		}
+	}
+	if !latest.IsZero() {
+		return latest
+	}
+	// There were no results. Assume the previous info's result time.
+	if previousLastResult != nil {
+		return *previousLastResult
+	}
+	return time.Now()
+}
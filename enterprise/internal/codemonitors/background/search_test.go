@@ -0,0 +1,92 @@
+package background
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+)
+
+func TestSearch(t *testing.T) {
+	date := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+	commit := &streamhttp.EventCommitMatch{
+		Type:       streamhttp.CommitMatchType,
+		Repository: "github.com/sourcegraph/sourcegraph",
+		OID:        "deadbeef",
+		Message:    "Add x",
+		AuthorName: "Alice",
+		AuthorDate: date,
+		Content:    "```diff\na.go a.go\n@@ -1 +1 @@\n+x\n```",
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.internal/search/stream" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if q := r.URL.Query().Get("q"); q != "type:diff x" {
+			t.Errorf("unexpected query %q", q)
+		}
+		ew, err := streamhttp.NewWriter(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = ew.Event("matches", []streamhttp.EventMatch{
+			commit,
+			&streamhttp.EventPathMatch{Type: streamhttp.PathMatchType, Path: "a.go"},
+		})
+		_ = ew.Event("done", map[string]interface{}{})
+	}))
+	t.Cleanup(ts.Close)
+
+	oldURL := api.InternalClient.URL
+	api.InternalClient.URL = ts.URL
+	t.Cleanup(func() { api.InternalClient.URL = oldURL })
+
+	got, err := search(context.Background(), "type:diff x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*streamhttp.EventCommitMatch{commit}, got); diff != "" {
+		t.Fatalf("unexpected matches (-want +got):\n%s", diff)
+	}
+}
+
+func TestSearchError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ew, err := streamhttp.NewWriter(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = ew.Event("error", streamhttp.EventError{Message: "invalid query"})
+		_ = ew.Event("done", map[string]interface{}{})
+	}))
+	t.Cleanup(ts.Close)
+
+	oldURL := api.InternalClient.URL
+	api.InternalClient.URL = ts.URL
+	t.Cleanup(func() { api.InternalClient.URL = oldURL })
+
+	if _, err := search(context.Background(), "type:diff ("); err == nil || err.Error() != "invalid query" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestLatestResultTime(t *testing.T) {
+	previous := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	older := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2021, 11, 2, 0, 0, 0, 0, time.UTC)
+
+	matches := []*streamhttp.EventCommitMatch{{AuthorDate: older}, {AuthorDate: newer}, {AuthorDate: older}}
+	if got := latestResultTime(&previous, matches); !got.Equal(newer) {
+		t.Errorf("got %v, want %v", got, newer)
+	}
+	if got := latestResultTime(&previous, nil); !got.Equal(previous) {
+		t.Errorf("got %v, want %v", got, previous)
+	}
+}
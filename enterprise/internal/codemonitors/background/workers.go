@@ -12,7 +12,10 @@ import (
 
 	cm "github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/webhook"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -77,7 +80,7 @@ func newTriggerJobsLogDeleter(ctx context.Context, store cm.CodeMonitorStore) go
 	return goroutine.NewPeriodicGoroutine(ctx, 60*time.Minute, deleteLogs)
 }
 
-func newActionRunner(ctx context.Context, s cm.CodeMonitorStore, metrics codeMonitorsMetrics) *workerutil.Worker {
+func newActionRunner(ctx context.Context, s cm.CodeMonitorStore, subRepoPerms authz.SubRepoPermissionChecker, metrics codeMonitorsMetrics) *workerutil.Worker {
 	options := workerutil.WorkerOptions{
 		Name:              "code_monitors_action_jobs_worker",
 		NumHandlers:       1,
@@ -85,7 +88,8 @@ func newActionRunner(ctx context.Context, s cm.CodeMonitorStore, metrics codeMon
 		HeartbeatInterval: 15 * time.Second,
 		Metrics:           metrics.workerMetrics,
 	}
-	worker := dbworker.NewWorker(ctx, createDBWorkerStoreForActionJobs(s), &actionRunner{s}, options)
+	workerStore := createDBWorkerStoreForActionJobs(s)
+	worker := dbworker.NewWorker(ctx, workerStore, &actionRunner{CodeMonitorStore: s, subRepoPerms: subRepoPerms, workerStore: workerStore}, options)
 	return worker
 }
 
@@ -155,23 +159,28 @@ func (r *queryRunner) Handle(ctx context.Context, record workerutil.Record) (err
 	newQuery := newQueryWithAfterFilter(q)
 
 	// Search.
-	var results *gqlSearchResponse
-	results, err = search(ctx, newQuery)
+	var matches []*streamhttp.EventCommitMatch
+	matches, err = search(ctx, newQuery)
 	if err != nil {
 		return err
 	}
-	var numResults int
-	if results != nil {
-		numResults = len(results.Data.Search.Results.Results)
-	}
+	numResults := len(matches)
 	if numResults > 0 {
 		err := s.EnqueueActionEmailsForQueryIDInt64(ctx, q.Id, record.RecordID())
 		if err != nil {
 			return errors.Errorf("store.EnqueueActionEmailsForQueryIDInt64: %w", err)
 		}
+		err = s.EnqueueActionWebhooksForQueryIDInt64(ctx, q.Id, record.RecordID())
+		if err != nil {
+			return errors.Errorf("store.EnqueueActionWebhooksForQueryIDInt64: %w", err)
+		}
+		err = s.EnqueueActionSlackWebhooksForQueryIDInt64(ctx, q.Id, record.RecordID())
+		if err != nil {
+			return errors.Errorf("store.EnqueueActionSlackWebhooksForQueryIDInt64: %w", err)
+		}
 	}
 	// Log next_run and latest_result to table cm_queries.
-	newLatestResult := latestResultTime(q.LatestResult, results, err)
+	newLatestResult := latestResultTime(q.LatestResult, matches)
 	err = s.SetTriggerQueryNextRun(ctx, q.Id, s.Clock()().Add(5*time.Minute), newLatestResult.UTC())
 	if err != nil {
 		return err
@@ -181,11 +190,28 @@ func (r *queryRunner) Handle(ctx context.Context, record workerutil.Record) (err
 	if err != nil {
 		return errors.Errorf("LogSearch: %w", err)
 	}
+	// Keep the new matches so that actions can include them in notifications.
+	if numResults > 0 {
+		matchedCommits := make([]*cm.MatchedCommit, 0, len(matches))
+		for _, m := range matches {
+			matchedCommits = append(matchedCommits, cm.NewMatchedCommit(m))
+		}
+		err = s.SetTriggerJobResults(ctx, record.RecordID(), matchedCommits)
+		if err != nil {
+			return errors.Errorf("SetTriggerJobResults: %w", err)
+		}
+	}
 	return nil
 }
 
 type actionRunner struct {
 	cm.CodeMonitorStore
+
+	// subRepoPerms filters the matched hunks shown in notifications.
+	subRepoPerms authz.SubRepoPermissionChecker
+
+	// workerStore is used to record a log entry for every webhook delivery.
+	workerStore dbworkerstore.Store
 }
 
 func (r *actionRunner) Handle(ctx context.Context, record workerutil.Record) (err error) {
@@ -224,27 +250,123 @@ func (r *actionRunner) Handle(ctx context.Context, record workerutil.Record) (er
 			return errors.Errorf("store.AllRecipientsForEmailIDInt64: %w", err)
 		}
 
-		data, err := email.NewTemplateDataForNewSearchResults(ctx, m.Description, m.Query, e, zeroOrVal(m.NumResults))
+		userIDs, err := cm.EmailRecipientUserIDs(ctx, s, m.MonitorID, recs)
 		if err != nil {
-			return errors.Errorf("email.NewTemplateDataForNewSearchResults: %w", err)
+			return err
 		}
-		for _, rec := range recs {
-			if rec.NamespaceOrgID != nil {
-				// TODO (stefan): Send emails to org members.
-				continue
+		for _, userID := range userIDs {
+			// Every recipient only sees the results in repositories they
+			// have access to.
+			results, err := cm.ResultsVisibleToUser(ctx, s, r.subRepoPerms, userID, m.Results)
+			if err != nil {
+				return err
 			}
-			if rec.NamespaceUserID == nil {
-				return errors.Errorf("nil recipient")
+			data, err := email.NewTemplateDataForNewSearchResults(ctx, m.Description, m.Query, e, zeroOrVal(m.NumResults), results)
+			if err != nil {
+				return errors.Errorf("email.NewTemplateDataForNewSearchResults: %w", err)
 			}
-			err = email.SendEmailForNewSearchResult(ctx, *rec.NamespaceUserID, data)
+			err = email.SendEmailForNewSearchResult(ctx, userID, data)
 			if err != nil {
 				return err
 			}
 		}
 		return nil
+	case j.Webhook != nil:
+		w, err := s.WebhookActionByIDInt64(ctx, int64(*j.Webhook))
+		if err != nil {
+			return errors.Errorf("store.WebhookActionByIDInt64: %w", err)
+		}
+
+		payload, err := r.newWebhookPayload(ctx, s, m, w.IncludeResults, utmSourceWebhook)
+		if err != nil {
+			return err
+		}
+		d, err := webhook.SendWebhook(ctx, w.URL, w.Secret, payload)
+		r.logDelivery(ctx, record.RecordID(), "webhook.deliver", d, err)
+		return err
+	case j.SlackWebhook != nil:
+		w, err := s.SlackWebhookActionByIDInt64(ctx, int64(*j.SlackWebhook))
+		if err != nil {
+			return errors.Errorf("store.SlackWebhookActionByIDInt64: %w", err)
+		}
+
+		payload, err := r.newWebhookPayload(ctx, s, m, w.IncludeResults, utmSourceSlackWebhook)
+		if err != nil {
+			return err
+		}
+		d, err := webhook.SendSlackWebhook(ctx, w.URL, webhook.NewSlackPayload(payload))
+		r.logDelivery(ctx, record.RecordID(), "slack_webhook.deliver", d, err)
+		return err
 	default:
-		// TODO(camdencheek): handle j.SlackWebhook != nil and j.Webhook != nil
-		return errors.New("cannot yet handle non-email jobs")
+		return errors.New("action job has no action")
+	}
+}
+
+const (
+	utmSourceWebhook      = "code-monitoring-webhook"
+	utmSourceSlackWebhook = "code-monitoring-slack-webhook"
+
+	// maxResultsInWebhook is the number of matches we include in webhook
+	// payloads and Slack messages.
+	maxResultsInWebhook = 10
+)
+
+// newWebhookPayload returns the payload sent to webhooks and Slack. Webhook
+// URLs are not tied to a user we could check permissions for, so matched
+// commits and diff excerpts are only included if the action opted in, and
+// only those visible to the creator of the monitor.
+func (r *actionRunner) newWebhookPayload(ctx context.Context, s cm.CodeMonitorStore, m *cm.ActionJobMetadata, includeResults bool, utmSource string) (*webhook.Payload, error) {
+	searchURL, err := email.SearchURL(ctx, m.Query, utmSource)
+	if err != nil {
+		return nil, err
+	}
+	monitorURL, err := email.CodeMonitorURL(ctx, m.MonitorID, utmSource)
+	if err != nil {
+		return nil, err
+	}
+	p := &webhook.Payload{
+		MonitorDescription: m.Description,
+		MonitorURL:         monitorURL,
+		Query:              m.Query,
+		SearchURL:          searchURL,
+		ResultCount:        zeroOrVal(m.NumResults),
+	}
+	if includeResults {
+		results, err := cm.ResultsVisibleToUser(ctx, s, r.subRepoPerms, m.CreatedBy, m.Results)
+		if err != nil {
+			return nil, err
+		}
+		p.Results, err = email.FirstResults(ctx, results, maxResultsInWebhook, utmSource)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// logDelivery records the outcome of a webhook delivery in the execution logs
+// of the action job. Failing to write the log entry does not fail the job.
+func (r *actionRunner) logDelivery(ctx context.Context, recordID int, key string, d *webhook.Delivery, deliveryErr error) {
+	if r.workerStore == nil || d == nil {
+		return
+	}
+
+	exitCode := d.StatusCode
+	durationMs := int(d.Duration.Milliseconds())
+	out := d.Response
+	if deliveryErr != nil {
+		out = deliveryErr.Error()
+	}
+	entry := workerutil.ExecutionLogEntry{
+		Key:        key,
+		Command:    []string{"POST", d.URL},
+		StartTime:  d.StartedAt,
+		ExitCode:   &exitCode,
+		Out:        out,
+		DurationMs: &durationMs,
+	}
+	if _, err := r.workerStore.AddExecutionLogEntry(ctx, recordID, entry, dbworkerstore.ExecutionLogEntryOptions{}); err != nil {
+		log15.Error("actionRunner.logDelivery", "recordID", recordID, "error", err)
 	}
 }
 
@@ -267,25 +389,6 @@ func newQueryWithAfterFilter(q *cm.MonitorQuery) string {
 	return strings.Join([]string{q.QueryString, fmt.Sprintf(`after:"%s"`, afterTime)}, " ")
 }
 
-func latestResultTime(previousLastResult *time.Time, v *gqlSearchResponse, searchErr error) time.Time {
-	if searchErr != nil || len(v.Data.Search.Results.Results) == 0 {
-		// Error performing the search, or there were no results. Assume the
-		// previous info's result time.
-		if previousLastResult != nil {
-			return *previousLastResult
-		}
-		return time.Now()
-	}
-
-	// Results are ordered chronologically, so first result is the latest.
-	t, err := extractTime(v.Data.Search.Results.Results[0])
-	if err != nil {
-		// Error already logged by extractTime.
-		return time.Now()
-	}
-	return *t
-}
-
 func zeroOrVal(i *int) int {
 	if i == nil {
 		return 0
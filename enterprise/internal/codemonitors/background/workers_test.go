@@ -12,7 +12,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/storetest"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/webhook"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/slack"
 )
 
 func TestActionRunner(t *testing.T) {
@@ -100,7 +102,7 @@ func TestActionRunner(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			a := actionRunner{s}
+			a := actionRunner{CodeMonitorStore: s}
 			err = a.Handle(ctx, record)
 			if err != nil {
 				t.Fatal(err)
@@ -113,3 +115,93 @@ func TestActionRunner(t *testing.T) {
 		})
 	}
 }
+
+func TestActionRunnerWebhooks(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	externalURL := "https://www.sourcegraph.com"
+	testQuery := "test patternType:literal"
+
+	// Mocks.
+	var gotWebhook *webhook.Payload
+	webhook.MockSendWebhook = func(ctx context.Context, url string, secret *string, payload *webhook.Payload) (*webhook.Delivery, error) {
+		gotWebhook = payload
+		return &webhook.Delivery{URL: url, StatusCode: 200}, nil
+	}
+	var gotSlack *slack.Payload
+	webhook.MockSendSlackWebhook = func(ctx context.Context, url string, payload *slack.Payload) (*webhook.Delivery, error) {
+		gotSlack = payload
+		return &webhook.Delivery{URL: url, StatusCode: 200}, nil
+	}
+	email.MockExternalURL = func() *url.URL {
+		externalURL, _ := url.Parse("https://www.sourcegraph.com")
+		return externalURL
+	}
+	t.Cleanup(func() {
+		webhook.MockSendWebhook = nil
+		webhook.MockSendSlackWebhook = nil
+		email.MockExternalURL = nil
+	})
+
+	db := dbtesting.GetDB(t)
+	now := time.Now()
+	s := codemonitors.NewStoreWithClock(db, func() time.Time { return now })
+	ctx, ts := storetest.NewTestStore(t)
+
+	var (
+		queryID      int64 = 1
+		triggerEvent       = 1
+	)
+
+	dbtesting.SetupGlobalTestDB(t)
+	_, _, _, userCtx := storetest.NewTestUser(ctx, t)
+	m, err := ts.InsertTestMonitor(userCtx, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ts.CreateWebhookAction(userCtx, m.ID, true, false, "https://example.com/hook", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ts.CreateSlackWebhookAction(userCtx, m.ID, true, false, "https://hooks.slack.com/services/test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.EnqueueTriggerQueries(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.LogSearch(ctx, testQuery, 3, triggerEvent); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.EnqueueActionWebhooksForQueryIDInt64(ctx, queryID, triggerEvent); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.EnqueueActionSlackWebhooksForQueryIDInt64(ctx, queryID, triggerEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	a := actionRunner{CodeMonitorStore: s, workerStore: createDBWorkerStoreForActionJobs(s)}
+	for _, id := range []int{1, 2} {
+		record, err := ts.ActionJobForIDInt(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := a.Handle(ctx, record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := &webhook.Payload{
+		MonitorDescription: "test description",
+		MonitorURL:         externalURL + "/code-monitoring/" + string(relay.MarshalID("CodeMonitor", 1)) + "?utm_source=code-monitoring-webhook",
+		Query:              testQuery,
+		SearchURL:          externalURL + "/search?q=test+patternType%3Aliteral&utm_source=code-monitoring-webhook",
+		ResultCount:        3,
+	}
+	if diff := cmp.Diff(want, gotWebhook); diff != "" {
+		t.Fatalf("diff: %s", diff)
+	}
+	if gotSlack == nil {
+		t.Fatal("expected Slack webhook to be sent")
+	}
+}
@@ -31,6 +31,9 @@ func SendEmailForNewSearchResult(ctx context.Context, userID int32, data *Templa
 	return sendEmail(ctx, userID, newSearchResultsEmailTemplates, data)
 }
 
+// maxResultsInEmail is the number of matches we render inline in an email.
+const maxResultsInEmail = 5
+
 type TemplateDataNewSearchResults struct {
 	Priority                  string
 	CodeMonitorURL            string
@@ -38,21 +41,36 @@ type TemplateDataNewSearchResults struct {
 	Description               string
 	NumberOfResultsWithDetail string
 	IsTest                    bool
+	Results                   []*TemplateDataResult
+}
+
+// TemplateDataResult is a match rendered inline in an email.
+type TemplateDataResult struct {
+	Repo       string
+	Subject    string
+	AuthorName string
+	URL        string
+	Hunks      []TemplateDataHunk
+}
+
+type TemplateDataHunk struct {
+	Path    string
+	Excerpt string
 }
 
-func NewTemplateDataForNewSearchResults(ctx context.Context, monitorDescription, queryString string, email *codemonitors.MonitorEmail, numResults int) (d *TemplateDataNewSearchResults, err error) {
+func NewTemplateDataForNewSearchResults(ctx context.Context, monitorDescription, queryString string, email *codemonitors.MonitorEmail, numResults int, results []*codemonitors.MatchedCommit) (d *TemplateDataNewSearchResults, err error) {
 	var (
 		searchURL                 string
 		codeMonitorURL            string
 		priority                  string
 		numberOfResultsWithDetail string
 	)
-	searchURL, err = getSearchURL(ctx, queryString, utmSourceEmail)
+	searchURL, err = SearchURL(ctx, queryString, utmSourceEmail)
 	if err != nil {
 		return nil, err
 	}
 
-	codeMonitorURL, err = getCodeMonitorURL(ctx, email.Monitor, utmSourceEmail)
+	codeMonitorURL, err = CodeMonitorURL(ctx, email.Monitor, utmSourceEmail)
 	if err != nil {
 		return nil, err
 	}
@@ -69,15 +87,53 @@ func NewTemplateDataForNewSearchResults(ctx context.Context, monitorDescription,
 		numberOfResultsWithDetail = fmt.Sprintf("There were %d new search results for your query", numResults)
 	}
 
+	results, err = FirstResults(ctx, results, maxResultsInEmail, utmSourceEmail)
+	if err != nil {
+		return nil, err
+	}
+	var resultsData []*TemplateDataResult
+	for _, r := range results {
+		rd := &TemplateDataResult{
+			Repo:       r.Repo,
+			Subject:    r.Subject,
+			AuthorName: r.AuthorName,
+			URL:        r.URL,
+		}
+		for _, h := range r.Hunks {
+			rd.Hunks = append(rd.Hunks, TemplateDataHunk{Path: h.Path, Excerpt: h.Excerpt})
+		}
+		resultsData = append(resultsData, rd)
+	}
+
 	return &TemplateDataNewSearchResults{
 		Priority:                  priority,
 		CodeMonitorURL:            codeMonitorURL,
 		SearchURL:                 searchURL,
 		Description:               monitorDescription,
 		NumberOfResultsWithDetail: numberOfResultsWithDetail,
+		Results:                   resultsData,
 	}, nil
 }
 
+// FirstResults returns copies of the first n results with their URLs resolved
+// against the external URL of the instance.
+func FirstResults(ctx context.Context, results []*codemonitors.MatchedCommit, n int, utmSource string) ([]*codemonitors.MatchedCommit, error) {
+	if len(results) > n {
+		results = results[:n]
+	}
+	var first []*codemonitors.MatchedCommit
+	for _, r := range results {
+		u, err := sourcegraphURL(ctx, r.URL, "", utmSource)
+		if err != nil {
+			return nil, err
+		}
+		c := *r
+		c.URL = u
+		first = append(first, &c)
+	}
+	return first, nil
+}
+
 func NewTestTemplateDataForNewSearchResults(ctx context.Context, monitorDescription string) *TemplateDataNewSearchResults {
 	return &TemplateDataNewSearchResults{
 		Priority:                  "New",
@@ -105,11 +161,13 @@ func sendEmail(ctx context.Context, userID int32, template txtypes.Templates, da
 	return nil
 }
 
-func getSearchURL(ctx context.Context, query, utmSource string) (string, error) {
+// SearchURL returns an absolute URL to the search results page for query.
+func SearchURL(ctx context.Context, query, utmSource string) (string, error) {
 	return sourcegraphURL(ctx, "search", query, utmSource)
 }
 
-func getCodeMonitorURL(ctx context.Context, monitorID int64, utmSource string) (string, error) {
+// CodeMonitorURL returns an absolute URL to the page of the given code monitor.
+func CodeMonitorURL(ctx context.Context, monitorID int64, utmSource string) (string, error) {
 	return sourcegraphURL(ctx, fmt.Sprintf("code-monitoring/%s", relay.MarshalID(MonitorKind, monitorID)), "", utmSource)
 }
 
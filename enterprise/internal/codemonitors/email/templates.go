@@ -14,7 +14,13 @@ Code monitoring triggered a new event:
 
 {{.Description}}
 {{.NumberOfResultsWithDetail}}
-
+{{ range .Results }}
+{{.Repo}}: {{.Subject}} ({{.AuthorName}})
+{{.URL}}
+{{ range .Hunks }}
+{{.Path}}
+{{.Excerpt}}
+{{ end }}{{ end }}
 View search on Sourcegraph {{.SearchURL}}
 
 __
@@ -44,6 +50,15 @@ Sourcegraph limits what information is contained in this notification.
         >{{.NumberOfResultsWithDetail}}</span
       >
     </p>
+	{{ range .Results }}
+	<p style="font-size: 14px; line-height: 21px">
+	  <a href="{{.URL}}">{{.Repo}}: {{.Subject}}</a> ({{.AuthorName}})
+	</p>
+	{{ range .Hunks }}
+	<p style="font-size: 12px; line-height: 18px; margin-bottom: 0">{{.Path}}</p>
+	<pre style="font-size: 12px; line-height: 18px; padding: 8px; background-color: #F9FAFB; border-radius: 4px; overflow-x: auto">{{.Excerpt}}</pre>
+	{{ end }}
+	{{ end }}
 	<p style="font-size: 16px; line-height: 24px">
 	  <a href="{{.SearchURL}}" {{ if .IsTest }}style="color: #9C9FA6; font-weight: 400; text-decoration: underline; cursor: default"{{ end }}>
         View search on Sourcegraph
@@ -49,9 +49,15 @@ type MockCodeMonitorStore struct {
 	// CreateRecipientsFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateRecipients.
 	CreateRecipientsFunc *CodeMonitorStoreCreateRecipientsFunc
+	// CreateSlackWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateSlackWebhookAction.
+	CreateSlackWebhookActionFunc *CodeMonitorStoreCreateSlackWebhookActionFunc
 	// CreateTriggerQueryFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateTriggerQuery.
 	CreateTriggerQueryFunc *CodeMonitorStoreCreateTriggerQueryFunc
+	// CreateWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateWebhookAction.
+	CreateWebhookActionFunc *CodeMonitorStoreCreateWebhookActionFunc
 	// DeleteActionsInt64Func is an instance of a mock function object
 	// controlling the behavior of the method DeleteActionsInt64.
 	DeleteActionsInt64Func *CodeMonitorStoreDeleteActionsInt64Func
@@ -70,10 +76,21 @@ type MockCodeMonitorStore struct {
 	// DoneFunc is an instance of a mock function object controlling the
 	// behavior of the method Done.
 	DoneFunc *CodeMonitorStoreDoneFunc
+	// EmailOptOutUserIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method EmailOptOutUserIDs.
+	EmailOptOutUserIDsFunc *CodeMonitorStoreEmailOptOutUserIDsFunc
 	// EnqueueActionEmailsForQueryIDInt64Func is an instance of a mock
 	// function object controlling the behavior of the method
 	// EnqueueActionEmailsForQueryIDInt64.
 	EnqueueActionEmailsForQueryIDInt64Func *CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func
+	// EnqueueActionSlackWebhooksForQueryIDInt64Func is an instance of a
+	// mock function object controlling the behavior of the method
+	// EnqueueActionSlackWebhooksForQueryIDInt64.
+	EnqueueActionSlackWebhooksForQueryIDInt64Func *CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func
+	// EnqueueActionWebhooksForQueryIDInt64Func is an instance of a mock
+	// function object controlling the behavior of the method
+	// EnqueueActionWebhooksForQueryIDInt64.
+	EnqueueActionWebhooksForQueryIDInt64Func *CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func
 	// EnqueueTriggerQueriesFunc is an instance of a mock function object
 	// controlling the behavior of the method EnqueueTriggerQueries.
 	EnqueueTriggerQueriesFunc *CodeMonitorStoreEnqueueTriggerQueriesFunc
@@ -98,6 +115,12 @@ type MockCodeMonitorStore struct {
 	// ListEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListEmailActions.
 	ListEmailActionsFunc *CodeMonitorStoreListEmailActionsFunc
+	// ListSlackWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListSlackWebhookActions.
+	ListSlackWebhookActionsFunc *CodeMonitorStoreListSlackWebhookActionsFunc
+	// ListWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListWebhookActions.
+	ListWebhookActionsFunc *CodeMonitorStoreListWebhookActionsFunc
 	// LogSearchFunc is an instance of a mock function object controlling
 	// the behavior of the method LogSearch.
 	LogSearchFunc *CodeMonitorStoreLogSearchFunc
@@ -118,9 +141,19 @@ type MockCodeMonitorStore struct {
 	// object controlling the behavior of the method
 	// ResetTriggerQueryTimestamps.
 	ResetTriggerQueryTimestampsFunc *CodeMonitorStoreResetTriggerQueryTimestampsFunc
+	// SetEmailOptOutFunc is an instance of a mock function object
+	// controlling the behavior of the method SetEmailOptOut.
+	SetEmailOptOutFunc *CodeMonitorStoreSetEmailOptOutFunc
+	// SetTriggerJobResultsFunc is an instance of a mock function object
+	// controlling the behavior of the method SetTriggerJobResults.
+	SetTriggerJobResultsFunc *CodeMonitorStoreSetTriggerJobResultsFunc
 	// SetTriggerQueryNextRunFunc is an instance of a mock function object
 	// controlling the behavior of the method SetTriggerQueryNextRun.
 	SetTriggerQueryNextRunFunc *CodeMonitorStoreSetTriggerQueryNextRunFunc
+	// SlackWebhookActionByIDInt64Func is an instance of a mock function
+	// object controlling the behavior of the method
+	// SlackWebhookActionByIDInt64.
+	SlackWebhookActionByIDInt64Func *CodeMonitorStoreSlackWebhookActionByIDInt64Func
 	// ToggleMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method ToggleMonitor.
 	ToggleMonitorFunc *CodeMonitorStoreToggleMonitorFunc
@@ -153,6 +186,9 @@ type MockCodeMonitorStore struct {
 	// UpdateTriggerQueryFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateTriggerQuery.
 	UpdateTriggerQueryFunc *CodeMonitorStoreUpdateTriggerQueryFunc
+	// WebhookActionByIDInt64Func is an instance of a mock function object
+	// controlling the behavior of the method WebhookActionByIDInt64.
+	WebhookActionByIDInt64Func *CodeMonitorStoreWebhookActionByIDInt64Func
 }
 
 // NewMockCodeMonitorStore creates a new mock of the CodeMonitorStore
@@ -210,11 +246,21 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return nil
 			},
 		},
+		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (*MonitorSlackWebhook, error) {
+				return nil, nil
+			},
+		},
 		CreateTriggerQueryFunc: &CodeMonitorStoreCreateTriggerQueryFunc{
 			defaultHook: func(context.Context, int64, *graphqlbackend.CreateTriggerArgs) error {
 				return nil
 			},
 		},
+		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string, *string) (*MonitorWebhook, error) {
+				return nil, nil
+			},
+		},
 		DeleteActionsInt64Func: &CodeMonitorStoreDeleteActionsInt64Func{
 			defaultHook: func(context.Context, []int64, int64) error {
 				return nil
@@ -245,11 +291,26 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return nil
 			},
 		},
+		EmailOptOutUserIDsFunc: &CodeMonitorStoreEmailOptOutUserIDsFunc{
+			defaultHook: func(context.Context, int64) ([]int32, error) {
+				return nil, nil
+			},
+		},
 		EnqueueActionEmailsForQueryIDInt64Func: &CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func{
 			defaultHook: func(context.Context, int64, int) error {
 				return nil
 			},
 		},
+		EnqueueActionSlackWebhooksForQueryIDInt64Func: &CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func{
+			defaultHook: func(context.Context, int64, int) error {
+				return nil
+			},
+		},
+		EnqueueActionWebhooksForQueryIDInt64Func: &CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func{
+			defaultHook: func(context.Context, int64, int) error {
+				return nil
+			},
+		},
 		EnqueueTriggerQueriesFunc: &CodeMonitorStoreEnqueueTriggerQueriesFunc{
 			defaultHook: func(context.Context) error {
 				return nil
@@ -290,6 +351,16 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return nil, nil
 			},
 		},
+		ListSlackWebhookActionsFunc: &CodeMonitorStoreListSlackWebhookActionsFunc{
+			defaultHook: func(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error) {
+				return nil, nil
+			},
+		},
+		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
+			defaultHook: func(context.Context, ListActionsOpts) ([]*MonitorWebhook, error) {
+				return nil, nil
+			},
+		},
 		LogSearchFunc: &CodeMonitorStoreLogSearchFunc{
 			defaultHook: func(context.Context, string, int, int) error {
 				return nil
@@ -320,11 +391,26 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return nil
 			},
 		},
+		SetEmailOptOutFunc: &CodeMonitorStoreSetEmailOptOutFunc{
+			defaultHook: func(context.Context, int64, int32, bool) error {
+				return nil
+			},
+		},
+		SetTriggerJobResultsFunc: &CodeMonitorStoreSetTriggerJobResultsFunc{
+			defaultHook: func(context.Context, int, []*MatchedCommit) error {
+				return nil
+			},
+		},
 		SetTriggerQueryNextRunFunc: &CodeMonitorStoreSetTriggerQueryNextRunFunc{
 			defaultHook: func(context.Context, int64, time.Time, time.Time) error {
 				return nil
 			},
 		},
+		SlackWebhookActionByIDInt64Func: &CodeMonitorStoreSlackWebhookActionByIDInt64Func{
+			defaultHook: func(context.Context, int64) (*MonitorSlackWebhook, error) {
+				return nil, nil
+			},
+		},
 		ToggleMonitorFunc: &CodeMonitorStoreToggleMonitorFunc{
 			defaultHook: func(context.Context, *graphqlbackend.ToggleCodeMonitorArgs) (*Monitor, error) {
 				return nil, nil
@@ -375,6 +461,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return nil
 			},
 		},
+		WebhookActionByIDInt64Func: &CodeMonitorStoreWebhookActionByIDInt64Func{
+			defaultHook: func(context.Context, int64) (*MonitorWebhook, error) {
+				return nil, nil
+			},
+		},
 	}
 }
 
@@ -413,9 +504,15 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		CreateRecipientsFunc: &CodeMonitorStoreCreateRecipientsFunc{
 			defaultHook: i.CreateRecipients,
 		},
+		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
+			defaultHook: i.CreateSlackWebhookAction,
+		},
 		CreateTriggerQueryFunc: &CodeMonitorStoreCreateTriggerQueryFunc{
 			defaultHook: i.CreateTriggerQuery,
 		},
+		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
+			defaultHook: i.CreateWebhookAction,
+		},
 		DeleteActionsInt64Func: &CodeMonitorStoreDeleteActionsInt64Func{
 			defaultHook: i.DeleteActionsInt64,
 		},
@@ -434,9 +531,18 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		DoneFunc: &CodeMonitorStoreDoneFunc{
 			defaultHook: i.Done,
 		},
+		EmailOptOutUserIDsFunc: &CodeMonitorStoreEmailOptOutUserIDsFunc{
+			defaultHook: i.EmailOptOutUserIDs,
+		},
 		EnqueueActionEmailsForQueryIDInt64Func: &CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func{
 			defaultHook: i.EnqueueActionEmailsForQueryIDInt64,
 		},
+		EnqueueActionSlackWebhooksForQueryIDInt64Func: &CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func{
+			defaultHook: i.EnqueueActionSlackWebhooksForQueryIDInt64,
+		},
+		EnqueueActionWebhooksForQueryIDInt64Func: &CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func{
+			defaultHook: i.EnqueueActionWebhooksForQueryIDInt64,
+		},
 		EnqueueTriggerQueriesFunc: &CodeMonitorStoreEnqueueTriggerQueriesFunc{
 			defaultHook: i.EnqueueTriggerQueries,
 		},
@@ -461,6 +567,12 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: i.ListEmailActions,
 		},
+		ListSlackWebhookActionsFunc: &CodeMonitorStoreListSlackWebhookActionsFunc{
+			defaultHook: i.ListSlackWebhookActions,
+		},
+		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
+			defaultHook: i.ListWebhookActions,
+		},
 		LogSearchFunc: &CodeMonitorStoreLogSearchFunc{
 			defaultHook: i.LogSearch,
 		},
@@ -479,9 +591,18 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		ResetTriggerQueryTimestampsFunc: &CodeMonitorStoreResetTriggerQueryTimestampsFunc{
 			defaultHook: i.ResetTriggerQueryTimestamps,
 		},
+		SetEmailOptOutFunc: &CodeMonitorStoreSetEmailOptOutFunc{
+			defaultHook: i.SetEmailOptOut,
+		},
+		SetTriggerJobResultsFunc: &CodeMonitorStoreSetTriggerJobResultsFunc{
+			defaultHook: i.SetTriggerJobResults,
+		},
 		SetTriggerQueryNextRunFunc: &CodeMonitorStoreSetTriggerQueryNextRunFunc{
 			defaultHook: i.SetTriggerQueryNextRun,
 		},
+		SlackWebhookActionByIDInt64Func: &CodeMonitorStoreSlackWebhookActionByIDInt64Func{
+			defaultHook: i.SlackWebhookActionByIDInt64,
+		},
 		ToggleMonitorFunc: &CodeMonitorStoreToggleMonitorFunc{
 			defaultHook: i.ToggleMonitor,
 		},
@@ -512,6 +633,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		UpdateTriggerQueryFunc: &CodeMonitorStoreUpdateTriggerQueryFunc{
 			defaultHook: i.UpdateTriggerQuery,
 		},
+		WebhookActionByIDInt64Func: &CodeMonitorStoreWebhookActionByIDInt64Func{
+			defaultHook: i.WebhookActionByIDInt64,
+		},
 	}
 }
 
@@ -1626,6 +1750,128 @@ func (c CodeMonitorStoreCreateRecipientsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreCreateSlackWebhookActionFunc describes the behavior when
+// the CreateSlackWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCreateSlackWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string) (*MonitorSlackWebhook, error)
+	hooks       []func(context.Context, int64, bool, bool, string) (*MonitorSlackWebhook, error)
+	history     []CodeMonitorStoreCreateSlackWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateSlackWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*MonitorSlackWebhook, error) {
+	r0, r1 := m.CreateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CreateSlackWebhookActionFunc.appendCall(CodeMonitorStoreCreateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateSlackWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*MonitorSlackWebhook, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateSlackWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*MonitorSlackWebhook, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultReturn(r0 *MonitorSlackWebhook, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*MonitorSlackWebhook, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushReturn(r0 *MonitorSlackWebhook, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string) (*MonitorSlackWebhook, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*MonitorSlackWebhook, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) appendCall(r0 CodeMonitorStoreCreateSlackWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateSlackWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) History() []CodeMonitorStoreCreateSlackWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateSlackWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateSlackWebhookActionFuncCall is an object that
+// describes an invocation of method CreateSlackWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCreateSlackWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *MonitorSlackWebhook
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateSlackWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateSlackWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateTriggerQueryFunc describes the behavior when the
 // CreateTriggerQuery method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -1738,6 +1984,130 @@ func (c CodeMonitorStoreCreateTriggerQueryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreCreateWebhookActionFunc describes the behavior when the
+// CreateWebhookAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCreateWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string, *string) (*MonitorWebhook, error)
+	hooks       []func(context.Context, int64, bool, bool, string, *string) (*MonitorWebhook, error)
+	history     []CodeMonitorStoreCreateWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateWebhookAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 *string) (*MonitorWebhook, error) {
+	r0, r1 := m.CreateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.CreateWebhookActionFunc.appendCall(CodeMonitorStoreCreateWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateWebhookAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, *string) (*MonitorWebhook, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateWebhookAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, *string) (*MonitorWebhook, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultReturn(r0 *MonitorWebhook, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, *string) (*MonitorWebhook, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreCreateWebhookActionFunc) PushReturn(r0 *MonitorWebhook, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, *string) (*MonitorWebhook, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, *string) (*MonitorWebhook, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateWebhookActionFunc) appendCall(r0 CodeMonitorStoreCreateWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreCreateWebhookActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreCreateWebhookActionFunc) History() []CodeMonitorStoreCreateWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateWebhookActionFuncCall is an object that describes
+// an invocation of method CreateWebhookAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCreateWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 *string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *MonitorWebhook
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreDeleteActionsInt64Func describes the behavior when the
 // DeleteActionsInt64 method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -2386,37 +2756,37 @@ func (c CodeMonitorStoreDoneFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func describes the
-// behavior when the EnqueueActionEmailsForQueryIDInt64 method of the parent
-// MockCodeMonitorStore instance is invoked.
-type CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func struct {
-	defaultHook func(context.Context, int64, int) error
-	hooks       []func(context.Context, int64, int) error
-	history     []CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64FuncCall
+// CodeMonitorStoreEmailOptOutUserIDsFunc describes the behavior when the
+// EmailOptOutUserIDs method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreEmailOptOutUserIDsFunc struct {
+	defaultHook func(context.Context, int64) ([]int32, error)
+	hooks       []func(context.Context, int64) ([]int32, error)
+	history     []CodeMonitorStoreEmailOptOutUserIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// EnqueueActionEmailsForQueryIDInt64 delegates to the next hook function in
-// the queue and stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) EnqueueActionEmailsForQueryIDInt64(v0 context.Context, v1 int64, v2 int) error {
-	r0 := m.EnqueueActionEmailsForQueryIDInt64Func.nextHook()(v0, v1, v2)
-	m.EnqueueActionEmailsForQueryIDInt64Func.appendCall(CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64FuncCall{v0, v1, v2, r0})
-	return r0
+// EmailOptOutUserIDs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) EmailOptOutUserIDs(v0 context.Context, v1 int64) ([]int32, error) {
+	r0, r1 := m.EmailOptOutUserIDsFunc.nextHook()(v0, v1)
+	m.EmailOptOutUserIDsFunc.appendCall(CodeMonitorStoreEmailOptOutUserIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// EnqueueActionEmailsForQueryIDInt64 method of the parent
-// MockCodeMonitorStore instance is invoked and the hook queue is empty.
-func (f *CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func) SetDefaultHook(hook func(context.Context, int64, int) error) {
+// SetDefaultHook sets function that is called when the EmailOptOutUserIDs
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreEmailOptOutUserIDsFunc) SetDefaultHook(hook func(context.Context, int64) ([]int32, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// EnqueueActionEmailsForQueryIDInt64 method of the parent
-// MockCodeMonitorStore instance invokes the hook at the front of the queue
-// and discards it. After the queue is empty, the default hook function is
-// invoked for any future action.
-func (f *CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func) PushHook(hook func(context.Context, int64, int) error) {
+// EmailOptOutUserIDs method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreEmailOptOutUserIDsFunc) PushHook(hook func(context.Context, int64) ([]int32, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2424,8 +2794,120 @@ func (f *CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func) PushHook(hook f
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64, int) error {
+func (f *CodeMonitorStoreEmailOptOutUserIDsFunc) SetDefaultReturn(r0 []int32, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) ([]int32, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreEmailOptOutUserIDsFunc) PushReturn(r0 []int32, r1 error) {
+	f.PushHook(func(context.Context, int64) ([]int32, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreEmailOptOutUserIDsFunc) nextHook() func(context.Context, int64) ([]int32, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreEmailOptOutUserIDsFunc) appendCall(r0 CodeMonitorStoreEmailOptOutUserIDsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreEmailOptOutUserIDsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreEmailOptOutUserIDsFunc) History() []CodeMonitorStoreEmailOptOutUserIDsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreEmailOptOutUserIDsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreEmailOptOutUserIDsFuncCall is an object that describes an
+// invocation of method EmailOptOutUserIDs on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreEmailOptOutUserIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int32
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreEmailOptOutUserIDsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreEmailOptOutUserIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func describes the
+// behavior when the EnqueueActionEmailsForQueryIDInt64 method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func struct {
+	defaultHook func(context.Context, int64, int) error
+	hooks       []func(context.Context, int64, int) error
+	history     []CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64FuncCall
+	mutex       sync.Mutex
+}
+
+// EnqueueActionEmailsForQueryIDInt64 delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) EnqueueActionEmailsForQueryIDInt64(v0 context.Context, v1 int64, v2 int) error {
+	r0 := m.EnqueueActionEmailsForQueryIDInt64Func.nextHook()(v0, v1, v2)
+	m.EnqueueActionEmailsForQueryIDInt64Func.appendCall(CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64FuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// EnqueueActionEmailsForQueryIDInt64 method of the parent
+// MockCodeMonitorStore instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func) SetDefaultHook(hook func(context.Context, int64, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EnqueueActionEmailsForQueryIDInt64 method of the parent
+// MockCodeMonitorStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func) PushHook(hook func(context.Context, int64, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64Func) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, int) error {
 		return r0
 	})
 }
@@ -2499,6 +2981,236 @@ func (c CodeMonitorStoreEnqueueActionEmailsForQueryIDInt64FuncCall) Results() []
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func describes
+// the behavior when the EnqueueActionSlackWebhooksForQueryIDInt64 method of
+// the parent MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func struct {
+	defaultHook func(context.Context, int64, int) error
+	hooks       []func(context.Context, int64, int) error
+	history     []CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall
+	mutex       sync.Mutex
+}
+
+// EnqueueActionSlackWebhooksForQueryIDInt64 delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockCodeMonitorStore) EnqueueActionSlackWebhooksForQueryIDInt64(v0 context.Context, v1 int64, v2 int) error {
+	r0 := m.EnqueueActionSlackWebhooksForQueryIDInt64Func.nextHook()(v0, v1, v2)
+	m.EnqueueActionSlackWebhooksForQueryIDInt64Func.appendCall(CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// EnqueueActionSlackWebhooksForQueryIDInt64 method of the parent
+// MockCodeMonitorStore instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func) SetDefaultHook(hook func(context.Context, int64, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EnqueueActionSlackWebhooksForQueryIDInt64 method of the parent
+// MockCodeMonitorStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func) PushHook(hook func(context.Context, int64, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, int) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func) nextHook() func(context.Context, int64, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func) appendCall(r0 CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall objects
+// describing the invocations of this function.
+func (f *CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64Func) History() []CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall is an
+// object that describes an invocation of method
+// EnqueueActionSlackWebhooksForQueryIDInt64 on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreEnqueueActionSlackWebhooksForQueryIDInt64FuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func describes the
+// behavior when the EnqueueActionWebhooksForQueryIDInt64 method of the
+// parent MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func struct {
+	defaultHook func(context.Context, int64, int) error
+	hooks       []func(context.Context, int64, int) error
+	history     []CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall
+	mutex       sync.Mutex
+}
+
+// EnqueueActionWebhooksForQueryIDInt64 delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockCodeMonitorStore) EnqueueActionWebhooksForQueryIDInt64(v0 context.Context, v1 int64, v2 int) error {
+	r0 := m.EnqueueActionWebhooksForQueryIDInt64Func.nextHook()(v0, v1, v2)
+	m.EnqueueActionWebhooksForQueryIDInt64Func.appendCall(CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// EnqueueActionWebhooksForQueryIDInt64 method of the parent
+// MockCodeMonitorStore instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func) SetDefaultHook(hook func(context.Context, int64, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EnqueueActionWebhooksForQueryIDInt64 method of the parent
+// MockCodeMonitorStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func) PushHook(hook func(context.Context, int64, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, int) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func) nextHook() func(context.Context, int64, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func) appendCall(r0 CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall objects
+// describing the invocations of this function.
+func (f *CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64Func) History() []CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall is an object
+// that describes an invocation of method
+// EnqueueActionWebhooksForQueryIDInt64 on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreEnqueueActionWebhooksForQueryIDInt64FuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreEnqueueTriggerQueriesFunc describes the behavior when the
 // EnqueueTriggerQueries method of the parent MockCodeMonitorStore instance
 // is invoked.
@@ -3217,36 +3929,261 @@ func (f *CodeMonitorStoreListActionJobsFunc) nextHook() func(context.Context, Li
 	return hook
 }
 
-func (f *CodeMonitorStoreListActionJobsFunc) appendCall(r0 CodeMonitorStoreListActionJobsFuncCall) {
+func (f *CodeMonitorStoreListActionJobsFunc) appendCall(r0 CodeMonitorStoreListActionJobsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreListActionJobsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreListActionJobsFunc) History() []CodeMonitorStoreListActionJobsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListActionJobsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListActionJobsFuncCall is an object that describes an
+// invocation of method ListActionJobs on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreListActionJobsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListActionJobsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*ActionJob
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListActionJobsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListActionJobsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreListEmailActionsFunc describes the behavior when the
+// ListEmailActions method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreListEmailActionsFunc struct {
+	defaultHook func(context.Context, ListActionsOpts) ([]*MonitorEmail, error)
+	hooks       []func(context.Context, ListActionsOpts) ([]*MonitorEmail, error)
+	history     []CodeMonitorStoreListEmailActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListEmailActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListEmailActions(v0 context.Context, v1 ListActionsOpts) ([]*MonitorEmail, error) {
+	r0, r1 := m.ListEmailActionsFunc.nextHook()(v0, v1)
+	m.ListEmailActionsFunc.appendCall(CodeMonitorStoreListEmailActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListEmailActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreListEmailActionsFunc) SetDefaultHook(hook func(context.Context, ListActionsOpts) ([]*MonitorEmail, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListEmailActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreListEmailActionsFunc) PushHook(hook func(context.Context, ListActionsOpts) ([]*MonitorEmail, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreListEmailActionsFunc) SetDefaultReturn(r0 []*MonitorEmail, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListActionsOpts) ([]*MonitorEmail, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreListEmailActionsFunc) PushReturn(r0 []*MonitorEmail, r1 error) {
+	f.PushHook(func(context.Context, ListActionsOpts) ([]*MonitorEmail, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListEmailActionsFunc) nextHook() func(context.Context, ListActionsOpts) ([]*MonitorEmail, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListEmailActionsFunc) appendCall(r0 CodeMonitorStoreListEmailActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreListEmailActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreListEmailActionsFunc) History() []CodeMonitorStoreListEmailActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListEmailActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListEmailActionsFuncCall is an object that describes an
+// invocation of method ListEmailActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreListEmailActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*MonitorEmail
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListEmailActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListEmailActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreListSlackWebhookActionsFunc describes the behavior when
+// the ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreListSlackWebhookActionsFunc struct {
+	defaultHook func(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error)
+	hooks       []func(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error)
+	history     []CodeMonitorStoreListSlackWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListSlackWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListSlackWebhookActions(v0 context.Context, v1 ListActionsOpts) ([]*MonitorSlackWebhook, error) {
+	r0, r1 := m.ListSlackWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListSlackWebhookActionsFunc.appendCall(CodeMonitorStoreListSlackWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) SetDefaultHook(hook func(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) PushHook(hook func(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) SetDefaultReturn(r0 []*MonitorSlackWebhook, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) PushReturn(r0 []*MonitorSlackWebhook, r1 error) {
+	f.PushHook(func(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) nextHook() func(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListSlackWebhookActionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreListActionJobsFuncCall
-// objects describing the invocations of this function.
-func (f *CodeMonitorStoreListActionJobsFunc) History() []CodeMonitorStoreListActionJobsFuncCall {
+// History returns a sequence of
+// CodeMonitorStoreListSlackWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) History() []CodeMonitorStoreListSlackWebhookActionsFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreListActionJobsFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreListSlackWebhookActionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreListActionJobsFuncCall is an object that describes an
-// invocation of method ListActionJobs on an instance of
-// MockCodeMonitorStore.
-type CodeMonitorStoreListActionJobsFuncCall struct {
+// CodeMonitorStoreListSlackWebhookActionsFuncCall is an object that
+// describes an invocation of method ListSlackWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreListSlackWebhookActionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ListActionJobsOpts
+	Arg1 ListActionsOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*ActionJob
+	Result0 []*MonitorSlackWebhook
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3254,47 +4191,47 @@ type CodeMonitorStoreListActionJobsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreListActionJobsFuncCall) Args() []interface{} {
+func (c CodeMonitorStoreListSlackWebhookActionsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreListActionJobsFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreListSlackWebhookActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreListEmailActionsFunc describes the behavior when the
-// ListEmailActions method of the parent MockCodeMonitorStore instance is
+// CodeMonitorStoreListWebhookActionsFunc describes the behavior when the
+// ListWebhookActions method of the parent MockCodeMonitorStore instance is
 // invoked.
-type CodeMonitorStoreListEmailActionsFunc struct {
-	defaultHook func(context.Context, ListActionsOpts) ([]*MonitorEmail, error)
-	hooks       []func(context.Context, ListActionsOpts) ([]*MonitorEmail, error)
-	history     []CodeMonitorStoreListEmailActionsFuncCall
+type CodeMonitorStoreListWebhookActionsFunc struct {
+	defaultHook func(context.Context, ListActionsOpts) ([]*MonitorWebhook, error)
+	hooks       []func(context.Context, ListActionsOpts) ([]*MonitorWebhook, error)
+	history     []CodeMonitorStoreListWebhookActionsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListEmailActions delegates to the next hook function in the queue and
+// ListWebhookActions delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) ListEmailActions(v0 context.Context, v1 ListActionsOpts) ([]*MonitorEmail, error) {
-	r0, r1 := m.ListEmailActionsFunc.nextHook()(v0, v1)
-	m.ListEmailActionsFunc.appendCall(CodeMonitorStoreListEmailActionsFuncCall{v0, v1, r0, r1})
+func (m *MockCodeMonitorStore) ListWebhookActions(v0 context.Context, v1 ListActionsOpts) ([]*MonitorWebhook, error) {
+	r0, r1 := m.ListWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListWebhookActionsFunc.appendCall(CodeMonitorStoreListWebhookActionsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListEmailActions
+// SetDefaultHook sets function that is called when the ListWebhookActions
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreListEmailActionsFunc) SetDefaultHook(hook func(context.Context, ListActionsOpts) ([]*MonitorEmail, error)) {
+func (f *CodeMonitorStoreListWebhookActionsFunc) SetDefaultHook(hook func(context.Context, ListActionsOpts) ([]*MonitorWebhook, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListEmailActions method of the parent MockCodeMonitorStore instance
+// ListWebhookActions method of the parent MockCodeMonitorStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreListEmailActionsFunc) PushHook(hook func(context.Context, ListActionsOpts) ([]*MonitorEmail, error)) {
+func (f *CodeMonitorStoreListWebhookActionsFunc) PushHook(hook func(context.Context, ListActionsOpts) ([]*MonitorWebhook, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3302,21 +4239,21 @@ func (f *CodeMonitorStoreListEmailActionsFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *CodeMonitorStoreListEmailActionsFunc) SetDefaultReturn(r0 []*MonitorEmail, r1 error) {
-	f.SetDefaultHook(func(context.Context, ListActionsOpts) ([]*MonitorEmail, error) {
+func (f *CodeMonitorStoreListWebhookActionsFunc) SetDefaultReturn(r0 []*MonitorWebhook, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListActionsOpts) ([]*MonitorWebhook, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *CodeMonitorStoreListEmailActionsFunc) PushReturn(r0 []*MonitorEmail, r1 error) {
-	f.PushHook(func(context.Context, ListActionsOpts) ([]*MonitorEmail, error) {
+func (f *CodeMonitorStoreListWebhookActionsFunc) PushReturn(r0 []*MonitorWebhook, r1 error) {
+	f.PushHook(func(context.Context, ListActionsOpts) ([]*MonitorWebhook, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreListEmailActionsFunc) nextHook() func(context.Context, ListActionsOpts) ([]*MonitorEmail, error) {
+func (f *CodeMonitorStoreListWebhookActionsFunc) nextHook() func(context.Context, ListActionsOpts) ([]*MonitorWebhook, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3329,27 +4266,27 @@ func (f *CodeMonitorStoreListEmailActionsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *CodeMonitorStoreListEmailActionsFunc) appendCall(r0 CodeMonitorStoreListEmailActionsFuncCall) {
+func (f *CodeMonitorStoreListWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListWebhookActionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreListEmailActionsFuncCall
+// History returns a sequence of CodeMonitorStoreListWebhookActionsFuncCall
 // objects describing the invocations of this function.
-func (f *CodeMonitorStoreListEmailActionsFunc) History() []CodeMonitorStoreListEmailActionsFuncCall {
+func (f *CodeMonitorStoreListWebhookActionsFunc) History() []CodeMonitorStoreListWebhookActionsFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreListEmailActionsFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreListWebhookActionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreListEmailActionsFuncCall is an object that describes an
-// invocation of method ListEmailActions on an instance of
+// CodeMonitorStoreListWebhookActionsFuncCall is an object that describes an
+// invocation of method ListWebhookActions on an instance of
 // MockCodeMonitorStore.
-type CodeMonitorStoreListEmailActionsFuncCall struct {
+type CodeMonitorStoreListWebhookActionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3358,7 +4295,7 @@ type CodeMonitorStoreListEmailActionsFuncCall struct {
 	Arg1 ListActionsOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*MonitorEmail
+	Result0 []*MonitorWebhook
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3366,13 +4303,13 @@ type CodeMonitorStoreListEmailActionsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreListEmailActionsFuncCall) Args() []interface{} {
+func (c CodeMonitorStoreListWebhookActionsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreListEmailActionsFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreListWebhookActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
@@ -3850,21 +4787,246 @@ func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) PushHook(hook func(conte
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) SetDefaultReturn(r0 []*Recipient, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, *graphqlbackend.ListRecipientsArgs) ([]*Recipient, error) {
-		return r0, r1
+func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) SetDefaultReturn(r0 []*Recipient, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *graphqlbackend.ListRecipientsArgs) ([]*Recipient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) PushReturn(r0 []*Recipient, r1 error) {
+	f.PushHook(func(context.Context, int64, *graphqlbackend.ListRecipientsArgs) ([]*Recipient, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) nextHook() func(context.Context, int64, *graphqlbackend.ListRecipientsArgs) ([]*Recipient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) appendCall(r0 CodeMonitorStoreRecipientsForEmailIDInt64FuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreRecipientsForEmailIDInt64FuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) History() []CodeMonitorStoreRecipientsForEmailIDInt64FuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreRecipientsForEmailIDInt64FuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreRecipientsForEmailIDInt64FuncCall is an object that
+// describes an invocation of method RecipientsForEmailIDInt64 on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreRecipientsForEmailIDInt64FuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *graphqlbackend.ListRecipientsArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*Recipient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreRecipientsForEmailIDInt64FuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreRecipientsForEmailIDInt64FuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreResetTriggerQueryTimestampsFunc describes the behavior
+// when the ResetTriggerQueryTimestamps method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreResetTriggerQueryTimestampsFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []CodeMonitorStoreResetTriggerQueryTimestampsFuncCall
+	mutex       sync.Mutex
+}
+
+// ResetTriggerQueryTimestamps delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ResetTriggerQueryTimestamps(v0 context.Context, v1 int64) error {
+	r0 := m.ResetTriggerQueryTimestampsFunc.nextHook()(v0, v1)
+	m.ResetTriggerQueryTimestampsFunc.appendCall(CodeMonitorStoreResetTriggerQueryTimestampsFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// ResetTriggerQueryTimestamps method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ResetTriggerQueryTimestamps method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) PushHook(hook func(context.Context, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) nextHook() func(context.Context, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) appendCall(r0 CodeMonitorStoreResetTriggerQueryTimestampsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreResetTriggerQueryTimestampsFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) History() []CodeMonitorStoreResetTriggerQueryTimestampsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreResetTriggerQueryTimestampsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreResetTriggerQueryTimestampsFuncCall is an object that
+// describes an invocation of method ResetTriggerQueryTimestamps on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreResetTriggerQueryTimestampsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreResetTriggerQueryTimestampsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreResetTriggerQueryTimestampsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreSetEmailOptOutFunc describes the behavior when the
+// SetEmailOptOut method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreSetEmailOptOutFunc struct {
+	defaultHook func(context.Context, int64, int32, bool) error
+	hooks       []func(context.Context, int64, int32, bool) error
+	history     []CodeMonitorStoreSetEmailOptOutFuncCall
+	mutex       sync.Mutex
+}
+
+// SetEmailOptOut delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) SetEmailOptOut(v0 context.Context, v1 int64, v2 int32, v3 bool) error {
+	r0 := m.SetEmailOptOutFunc.nextHook()(v0, v1, v2, v3)
+	m.SetEmailOptOutFunc.appendCall(CodeMonitorStoreSetEmailOptOutFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetEmailOptOut
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreSetEmailOptOutFunc) SetDefaultHook(hook func(context.Context, int64, int32, bool) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetEmailOptOut method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreSetEmailOptOutFunc) PushHook(hook func(context.Context, int64, int32, bool) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreSetEmailOptOutFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, int32, bool) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) PushReturn(r0 []*Recipient, r1 error) {
-	f.PushHook(func(context.Context, int64, *graphqlbackend.ListRecipientsArgs) ([]*Recipient, error) {
-		return r0, r1
+func (f *CodeMonitorStoreSetEmailOptOutFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, int32, bool) error {
+		return r0
 	})
 }
 
-func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) nextHook() func(context.Context, int64, *graphqlbackend.ListRecipientsArgs) ([]*Recipient, error) {
+func (f *CodeMonitorStoreSetEmailOptOutFunc) nextHook() func(context.Context, int64, int32, bool) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3877,28 +5039,27 @@ func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) nextHook() func(context.
 	return hook
 }
 
-func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) appendCall(r0 CodeMonitorStoreRecipientsForEmailIDInt64FuncCall) {
+func (f *CodeMonitorStoreSetEmailOptOutFunc) appendCall(r0 CodeMonitorStoreSetEmailOptOutFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// CodeMonitorStoreRecipientsForEmailIDInt64FuncCall objects describing the
-// invocations of this function.
-func (f *CodeMonitorStoreRecipientsForEmailIDInt64Func) History() []CodeMonitorStoreRecipientsForEmailIDInt64FuncCall {
+// History returns a sequence of CodeMonitorStoreSetEmailOptOutFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreSetEmailOptOutFunc) History() []CodeMonitorStoreSetEmailOptOutFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreRecipientsForEmailIDInt64FuncCall, len(f.history))
+	history := make([]CodeMonitorStoreSetEmailOptOutFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreRecipientsForEmailIDInt64FuncCall is an object that
-// describes an invocation of method RecipientsForEmailIDInt64 on an
-// instance of MockCodeMonitorStore.
-type CodeMonitorStoreRecipientsForEmailIDInt64FuncCall struct {
+// CodeMonitorStoreSetEmailOptOutFuncCall is an object that describes an
+// invocation of method SetEmailOptOut on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreSetEmailOptOutFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3907,58 +5068,58 @@ type CodeMonitorStoreRecipientsForEmailIDInt64FuncCall struct {
 	Arg1 int64
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 *graphqlbackend.ListRecipientsArgs
-	// Result0 is the value of the 1st result returned from this method
+	Arg2 int32
+	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Result0 []*Recipient
-	// Result1 is the value of the 2nd result returned from this method
+	Arg3 bool
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreRecipientsForEmailIDInt64FuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c CodeMonitorStoreSetEmailOptOutFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreRecipientsForEmailIDInt64FuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c CodeMonitorStoreSetEmailOptOutFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// CodeMonitorStoreResetTriggerQueryTimestampsFunc describes the behavior
-// when the ResetTriggerQueryTimestamps method of the parent
-// MockCodeMonitorStore instance is invoked.
-type CodeMonitorStoreResetTriggerQueryTimestampsFunc struct {
-	defaultHook func(context.Context, int64) error
-	hooks       []func(context.Context, int64) error
-	history     []CodeMonitorStoreResetTriggerQueryTimestampsFuncCall
+// CodeMonitorStoreSetTriggerJobResultsFunc describes the behavior when the
+// SetTriggerJobResults method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreSetTriggerJobResultsFunc struct {
+	defaultHook func(context.Context, int, []*MatchedCommit) error
+	hooks       []func(context.Context, int, []*MatchedCommit) error
+	history     []CodeMonitorStoreSetTriggerJobResultsFuncCall
 	mutex       sync.Mutex
 }
 
-// ResetTriggerQueryTimestamps delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) ResetTriggerQueryTimestamps(v0 context.Context, v1 int64) error {
-	r0 := m.ResetTriggerQueryTimestampsFunc.nextHook()(v0, v1)
-	m.ResetTriggerQueryTimestampsFunc.appendCall(CodeMonitorStoreResetTriggerQueryTimestampsFuncCall{v0, v1, r0})
+// SetTriggerJobResults delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) SetTriggerJobResults(v0 context.Context, v1 int, v2 []*MatchedCommit) error {
+	r0 := m.SetTriggerJobResultsFunc.nextHook()(v0, v1, v2)
+	m.SetTriggerJobResultsFunc.appendCall(CodeMonitorStoreSetTriggerJobResultsFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// ResetTriggerQueryTimestamps method of the parent MockCodeMonitorStore
-// instance is invoked and the hook queue is empty.
-func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+// SetDefaultHook sets function that is called when the SetTriggerJobResults
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreSetTriggerJobResultsFunc) SetDefaultHook(hook func(context.Context, int, []*MatchedCommit) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ResetTriggerQueryTimestamps method of the parent MockCodeMonitorStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) PushHook(hook func(context.Context, int64) error) {
+// SetTriggerJobResults method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreSetTriggerJobResultsFunc) PushHook(hook func(context.Context, int, []*MatchedCommit) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3966,21 +5127,21 @@ func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) PushHook(hook func(con
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64) error {
+func (f *CodeMonitorStoreSetTriggerJobResultsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []*MatchedCommit) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64) error {
+func (f *CodeMonitorStoreSetTriggerJobResultsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []*MatchedCommit) error {
 		return r0
 	})
 }
 
-func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) nextHook() func(context.Context, int64) error {
+func (f *CodeMonitorStoreSetTriggerJobResultsFunc) nextHook() func(context.Context, int, []*MatchedCommit) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3993,34 +5154,37 @@ func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) nextHook() func(contex
 	return hook
 }
 
-func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) appendCall(r0 CodeMonitorStoreResetTriggerQueryTimestampsFuncCall) {
+func (f *CodeMonitorStoreSetTriggerJobResultsFunc) appendCall(r0 CodeMonitorStoreSetTriggerJobResultsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// CodeMonitorStoreResetTriggerQueryTimestampsFuncCall objects describing
-// the invocations of this function.
-func (f *CodeMonitorStoreResetTriggerQueryTimestampsFunc) History() []CodeMonitorStoreResetTriggerQueryTimestampsFuncCall {
+// CodeMonitorStoreSetTriggerJobResultsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreSetTriggerJobResultsFunc) History() []CodeMonitorStoreSetTriggerJobResultsFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreResetTriggerQueryTimestampsFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreSetTriggerJobResultsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreResetTriggerQueryTimestampsFuncCall is an object that
-// describes an invocation of method ResetTriggerQueryTimestamps on an
-// instance of MockCodeMonitorStore.
-type CodeMonitorStoreResetTriggerQueryTimestampsFuncCall struct {
+// CodeMonitorStoreSetTriggerJobResultsFuncCall is an object that describes
+// an invocation of method SetTriggerJobResults on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreSetTriggerJobResultsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int64
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []*MatchedCommit
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -4028,13 +5192,13 @@ type CodeMonitorStoreResetTriggerQueryTimestampsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreResetTriggerQueryTimestampsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c CodeMonitorStoreSetTriggerJobResultsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreResetTriggerQueryTimestampsFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreSetTriggerJobResultsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
@@ -4154,6 +5318,119 @@ func (c CodeMonitorStoreSetTriggerQueryNextRunFuncCall) Results() []interface{}
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreSlackWebhookActionByIDInt64Func describes the behavior
+// when the SlackWebhookActionByIDInt64 method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreSlackWebhookActionByIDInt64Func struct {
+	defaultHook func(context.Context, int64) (*MonitorSlackWebhook, error)
+	hooks       []func(context.Context, int64) (*MonitorSlackWebhook, error)
+	history     []CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall
+	mutex       sync.Mutex
+}
+
+// SlackWebhookActionByIDInt64 delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) SlackWebhookActionByIDInt64(v0 context.Context, v1 int64) (*MonitorSlackWebhook, error) {
+	r0, r1 := m.SlackWebhookActionByIDInt64Func.nextHook()(v0, v1)
+	m.SlackWebhookActionByIDInt64Func.appendCall(CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// SlackWebhookActionByIDInt64 method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreSlackWebhookActionByIDInt64Func) SetDefaultHook(hook func(context.Context, int64) (*MonitorSlackWebhook, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SlackWebhookActionByIDInt64 method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreSlackWebhookActionByIDInt64Func) PushHook(hook func(context.Context, int64) (*MonitorSlackWebhook, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreSlackWebhookActionByIDInt64Func) SetDefaultReturn(r0 *MonitorSlackWebhook, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*MonitorSlackWebhook, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreSlackWebhookActionByIDInt64Func) PushReturn(r0 *MonitorSlackWebhook, r1 error) {
+	f.PushHook(func(context.Context, int64) (*MonitorSlackWebhook, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreSlackWebhookActionByIDInt64Func) nextHook() func(context.Context, int64) (*MonitorSlackWebhook, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreSlackWebhookActionByIDInt64Func) appendCall(r0 CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreSlackWebhookActionByIDInt64Func) History() []CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall is an object that
+// describes an invocation of method SlackWebhookActionByIDInt64 on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *MonitorSlackWebhook
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreSlackWebhookActionByIDInt64FuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreToggleMonitorFunc describes the behavior when the
 // ToggleMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -5269,3 +6546,116 @@ func (c CodeMonitorStoreUpdateTriggerQueryFuncCall) Args() []interface{} {
 func (c CodeMonitorStoreUpdateTriggerQueryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
+
+// CodeMonitorStoreWebhookActionByIDInt64Func describes the behavior when
+// the WebhookActionByIDInt64 method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreWebhookActionByIDInt64Func struct {
+	defaultHook func(context.Context, int64) (*MonitorWebhook, error)
+	hooks       []func(context.Context, int64) (*MonitorWebhook, error)
+	history     []CodeMonitorStoreWebhookActionByIDInt64FuncCall
+	mutex       sync.Mutex
+}
+
+// WebhookActionByIDInt64 delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) WebhookActionByIDInt64(v0 context.Context, v1 int64) (*MonitorWebhook, error) {
+	r0, r1 := m.WebhookActionByIDInt64Func.nextHook()(v0, v1)
+	m.WebhookActionByIDInt64Func.appendCall(CodeMonitorStoreWebhookActionByIDInt64FuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// WebhookActionByIDInt64 method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreWebhookActionByIDInt64Func) SetDefaultHook(hook func(context.Context, int64) (*MonitorWebhook, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WebhookActionByIDInt64 method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreWebhookActionByIDInt64Func) PushHook(hook func(context.Context, int64) (*MonitorWebhook, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *CodeMonitorStoreWebhookActionByIDInt64Func) SetDefaultReturn(r0 *MonitorWebhook, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*MonitorWebhook, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *CodeMonitorStoreWebhookActionByIDInt64Func) PushReturn(r0 *MonitorWebhook, r1 error) {
+	f.PushHook(func(context.Context, int64) (*MonitorWebhook, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreWebhookActionByIDInt64Func) nextHook() func(context.Context, int64) (*MonitorWebhook, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreWebhookActionByIDInt64Func) appendCall(r0 CodeMonitorStoreWebhookActionByIDInt64FuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreWebhookActionByIDInt64FuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreWebhookActionByIDInt64Func) History() []CodeMonitorStoreWebhookActionByIDInt64FuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreWebhookActionByIDInt64FuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreWebhookActionByIDInt64FuncCall is an object that
+// describes an invocation of method WebhookActionByIDInt64 on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreWebhookActionByIDInt64FuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *MonitorWebhook
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreWebhookActionByIDInt64FuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreWebhookActionByIDInt64FuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -0,0 +1,42 @@
+package codemonitors
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+)
+
+const optOutOfEmailsFmtStr = `
+INSERT INTO cm_email_opt_outs (monitor, user_id, created_at)
+VALUES (%s, %s, %s)
+ON CONFLICT DO NOTHING
+`
+
+const optInToEmailsFmtStr = `
+DELETE FROM cm_email_opt_outs
+WHERE monitor = %s AND user_id = %s
+`
+
+// SetEmailOptOut records whether the given user opted out of receiving emails
+// from the given monitor.
+func (s *codeMonitorStore) SetEmailOptOut(ctx context.Context, monitorID int64, userID int32, optOut bool) error {
+	if optOut {
+		return s.Exec(ctx, sqlf.Sprintf(optOutOfEmailsFmtStr, monitorID, userID, s.Now()))
+	}
+	return s.Exec(ctx, sqlf.Sprintf(optInToEmailsFmtStr, monitorID, userID))
+}
+
+const emailOptOutUserIDsFmtStr = `
+SELECT user_id
+FROM cm_email_opt_outs
+WHERE monitor = %s
+ORDER BY user_id
+`
+
+// EmailOptOutUserIDs returns the IDs of the users who opted out of receiving
+// emails from the given monitor.
+func (s *codeMonitorStore) EmailOptOutUserIDs(ctx context.Context, monitorID int64) ([]int32, error) {
+	return basestore.ScanInt32s(s.Query(ctx, sqlf.Sprintf(emailOptOutUserIDsFmtStr, monitorID)))
+}
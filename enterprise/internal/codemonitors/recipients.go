@@ -9,6 +9,7 @@ import (
 	"github.com/keegancsmith/sqlf"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 )
 
 type Recipient struct {
@@ -155,6 +156,73 @@ func readRecipientQuery(ctx context.Context, emailId int64, args *graphqlbackend
 	), nil
 }
 
+// EmailRecipientUserIDs returns the IDs of the users who should be emailed on
+// behalf of the given monitor. Members of organization recipients are included
+// if they have a verified primary email address. Every user is returned at
+// most once, and users who opted out of the monitor's emails are skipped. A
+// monitorID of 0 skips the opt-out check.
+func EmailRecipientUserIDs(ctx context.Context, s CodeMonitorStore, monitorID int64, recs []*Recipient) ([]int32, error) {
+	skip := map[int32]struct{}{}
+	if monitorID != 0 {
+		optOuts, err := s.EmailOptOutUserIDs(ctx, monitorID)
+		if err != nil {
+			return nil, errors.Errorf("store.EmailOptOutUserIDs: %w", err)
+		}
+		for _, id := range optOuts {
+			skip[id] = struct{}{}
+		}
+	}
+
+	var userIDs []int32
+	add := func(userID int32) {
+		if _, ok := skip[userID]; ok {
+			return
+		}
+		skip[userID] = struct{}{}
+		userIDs = append(userIDs, userID)
+	}
+
+	// Members of organizations are added after loading their primary emails
+	// in one query.
+	var memberIDs []int32
+	for _, rec := range recs {
+		switch {
+		case rec.NamespaceUserID != nil:
+			add(*rec.NamespaceUserID)
+		case rec.NamespaceOrgID != nil:
+			members, err := database.OrgMembersWith(s).GetByOrgID(ctx, *rec.NamespaceOrgID)
+			if err != nil {
+				return nil, errors.Errorf("OrgMembers.GetByOrgID: %w", err)
+			}
+			for _, member := range members {
+				memberIDs = append(memberIDs, member.UserID)
+			}
+		default:
+			return nil, errors.Errorf("nil recipient")
+		}
+	}
+	if len(memberIDs) == 0 {
+		return userIDs, nil
+	}
+
+	emails, err := database.UserEmailsWith(s).GetPrimaryEmails(ctx, memberIDs...)
+	if err != nil {
+		return nil, errors.Errorf("UserEmails.GetPrimaryEmails: %w", err)
+	}
+	verified := make(map[int32]struct{}, len(emails))
+	for _, email := range emails {
+		if email.VerifiedAt != nil {
+			verified[email.UserID] = struct{}{}
+		}
+	}
+	for _, userID := range memberIDs {
+		if _, ok := verified[userID]; ok {
+			add(userID)
+		}
+	}
+	return userIDs, nil
+}
+
 func nilOrInt32(n int32) *int32 {
 	if n == 0 {
 		return nil
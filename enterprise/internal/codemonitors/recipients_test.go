@@ -1,9 +1,13 @@
 package codemonitors
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbconn"
 )
 
 func TestAllRecipientsForEmailIDInt64(t *testing.T) {
@@ -34,3 +38,68 @@ func TestAllRecipientsForEmailIDInt64(t *testing.T) {
 		t.Fatalf("diff: %s", diff)
 	}
 }
+
+func TestEmailRecipientUserIDs(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx, s := newTestStore(t)
+	_, directID, _, userCtx := newTestUser(ctx, t)
+	m, err := s.insertTestMonitor(userCtx, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, err := database.Orgs(dbconn.Global).Create(ctx, "cm-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifiedID := insertTestUser(t, dbconn.Global, "cm-verified", false)
+	unverifiedID := insertTestUser(t, dbconn.Global, "cm-unverified", false)
+	optedOutID := insertTestUser(t, dbconn.Global, "cm-opted-out", false)
+	for _, userID := range []int32{directID, verifiedID, unverifiedID, optedOutID} {
+		if _, err := database.OrgMembers(dbconn.Global).Create(ctx, org.ID, userID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, userID := range []int32{verifiedID, optedOutID} {
+		address := fmt.Sprintf("user-%d@example.com", userID)
+		if err := database.UserEmails(dbconn.Global).Add(ctx, userID, address, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := database.UserEmails(dbconn.Global).SetVerified(ctx, userID, address, true); err != nil {
+			t.Fatal(err)
+		}
+		if err := database.UserEmails(dbconn.Global).SetPrimaryEmail(ctx, userID, address); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.SetEmailOptOut(ctx, m.ID, optedOutID, true); err != nil {
+		t.Fatal(err)
+	}
+
+	recs := []*Recipient{
+		{NamespaceUserID: &directID},
+		{NamespaceOrgID: &org.ID},
+	}
+	got, err := EmailRecipientUserIDs(ctx, s, m.ID, recs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The direct recipient is also an org member, but is emailed only once.
+	if diff := cmp.Diff([]int32{directID, verifiedID}, got); diff != "" {
+		t.Fatalf("diff: %s", diff)
+	}
+
+	if err := s.SetEmailOptOut(ctx, m.ID, optedOutID, false); err != nil {
+		t.Fatal(err)
+	}
+	got, err = EmailRecipientUserIDs(ctx, s, m.ID, recs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]int32{directID, verifiedID, optedOutID}, got); diff != "" {
+		t.Fatalf("diff: %s", diff)
+	}
+}
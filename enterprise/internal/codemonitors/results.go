@@ -0,0 +1,191 @@
+package codemonitors
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git/gitapi"
+)
+
+const (
+	// MaxStoredResults is the maximum number of matches we keep per trigger
+	// job. Actions render at most this many results inline.
+	MaxStoredResults = 50
+
+	// maxExcerptLines bounds the number of lines of a hunk we keep.
+	maxExcerptLines = 10
+)
+
+// MatchedCommit is a commit or diff match found by a trigger query, in the
+// form actions render it.
+type MatchedCommit struct {
+	Repo       string        `json:"repo"`
+	Commit     string        `json:"commit"`
+	URL        string        `json:"url"`
+	AuthorName string        `json:"authorName"`
+	Subject    string        `json:"subject"`
+	Hunks      []MatchedHunk `json:"hunks,omitempty"`
+}
+
+// MatchedHunk is an excerpt of a matched diff hunk.
+type MatchedHunk struct {
+	Path    string `json:"path"`
+	Excerpt string `json:"excerpt"`
+}
+
+// diffContentPrefix and diffContentSuffix enclose the diff preview in the
+// content of streamed diff matches.
+const (
+	diffContentPrefix = "```diff\n"
+	diffContentSuffix = "\n```"
+)
+
+// NewMatchedCommit converts a streamed commit search match into a
+// MatchedCommit. For diff matches, the matched hunks are extracted from the
+// diff preview.
+func NewMatchedCommit(m *streamhttp.EventCommitMatch) *MatchedCommit {
+	mc := &MatchedCommit{
+		Repo:       m.Repository,
+		Commit:     m.OID,
+		URL:        m.URL,
+		AuthorName: m.AuthorName,
+		Subject:    gitapi.Message(m.Message).Subject(),
+	}
+	if strings.HasPrefix(m.Content, diffContentPrefix) && strings.HasSuffix(m.Content, diffContentSuffix) {
+		mc.Hunks = parseDiffPreview(strings.TrimSuffix(strings.TrimPrefix(m.Content, diffContentPrefix), diffContentSuffix))
+	}
+	return mc
+}
+
+// parseDiffPreview splits the diff preview of a commit match into hunks. The
+// preview consists of a "<old path> <new path>" header per file, followed by
+// the file's matched hunks.
+func parseDiffPreview(preview string) []MatchedHunk {
+	var (
+		hunks   []MatchedHunk
+		path    string
+		current *MatchedHunk
+		lines   int
+	)
+	scanner := bufio.NewScanner(strings.NewReader(preview))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			hunks = append(hunks, MatchedHunk{Path: path, Excerpt: line})
+			current = &hunks[len(hunks)-1]
+			lines = 0
+		case len(line) > 0 && (line[0] == '+' || line[0] == '-' || line[0] == ' '):
+			if current == nil || lines >= maxExcerptLines {
+				continue
+			}
+			current.Excerpt += "\n" + line
+			lines++
+		default:
+			current = nil
+			path = filePathFromHeader(line)
+		}
+	}
+	return hunks
+}
+
+func filePathFromHeader(header string) string {
+	i := strings.LastIndexByte(header, ' ')
+	if i < 0 {
+		return header
+	}
+	oldPath, newPath := header[:i], header[i+1:]
+	if newPath == "/dev/null" {
+		return oldPath
+	}
+	return newPath
+}
+
+// ResultsVisibleToUser returns the results the user with the given ID is
+// allowed to read. Results in repositories the user can't access are dropped,
+// as are the hunks of files hidden from the user by sub-repository
+// permissions. Diff matches without any remaining hunks are dropped too.
+// Trigger queries don't run as the recipients of a monitor's notifications,
+// so results must be filtered before they are shown to a recipient.
+func ResultsVisibleToUser(ctx context.Context, s CodeMonitorStore, checker authz.SubRepoPermissionChecker, userID int32, results []*MatchedCommit) ([]*MatchedCommit, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	a := actor.FromUser(userID)
+	names := make([]string, 0, len(results))
+	for _, r := range results {
+		names = append(names, r.Repo)
+	}
+	repos, err := database.ReposWith(s).ListMinimalRepos(actor.WithActor(ctx, a), database.ReposListOptions{
+		Names: names,
+	})
+	if err != nil {
+		return nil, errors.Errorf("Repos.ListMinimalRepos: %w", err)
+	}
+	visible := make(map[string]struct{}, len(repos))
+	for _, r := range repos {
+		visible[string(r.Name)] = struct{}{}
+	}
+
+	var filtered []*MatchedCommit
+	for _, r := range results {
+		if _, ok := visible[r.Repo]; !ok {
+			continue
+		}
+		if len(r.Hunks) == 0 || !checker.Enabled() {
+			filtered = append(filtered, r)
+			continue
+		}
+
+		var hunks []MatchedHunk
+		for _, h := range r.Hunks {
+			perms, err := authz.ActorPermissions(ctx, checker, a, authz.RepoContent{
+				Repo: api.RepoName(r.Repo),
+				Path: h.Path,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if perms.Include(authz.Read) {
+				hunks = append(hunks, h)
+			}
+		}
+		if len(hunks) == 0 {
+			continue
+		}
+		c := *r
+		c.Hunks = hunks
+		filtered = append(filtered, &c)
+	}
+	return filtered, nil
+}
+
+const setTriggerJobResultsFmtStr = `
+UPDATE cm_trigger_jobs
+SET search_results = %s
+WHERE id = %s
+`
+
+// SetTriggerJobResults stores the first MaxStoredResults matches found by the
+// trigger job with the given ID.
+func (s *codeMonitorStore) SetTriggerJobResults(ctx context.Context, recordID int, results []*MatchedCommit) error {
+	if len(results) > MaxStoredResults {
+		results = results[:MaxStoredResults]
+	}
+	b, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	return s.Store.Exec(ctx, sqlf.Sprintf(setTriggerJobResultsFmtStr, b, recordID))
+}
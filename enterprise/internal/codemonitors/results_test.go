@@ -0,0 +1,128 @@
+package codemonitors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestNewMatchedCommit(t *testing.T) {
+	m := &streamhttp.EventCommitMatch{
+		Type:       streamhttp.CommitMatchType,
+		URL:        "/github.com/sourcegraph/sourcegraph/-/commit/deadbeef",
+		Repository: "github.com/sourcegraph/sourcegraph",
+		OID:        "deadbeef",
+		Message:    "Add a feature\n\nWith a body.",
+		AuthorName: "Alice",
+		Content: "```diff\n" + `cmd/main.go cmd/main.go
+@@ -1,2 +1,3 @@ package main
+ import "fmt"
++func feature() {}
+old.txt /dev/null
+@@ -1,1 +0,0 @@
+-removed` + "\n```",
+	}
+
+	want := &MatchedCommit{
+		Repo:       "github.com/sourcegraph/sourcegraph",
+		Commit:     "deadbeef",
+		URL:        "/github.com/sourcegraph/sourcegraph/-/commit/deadbeef",
+		AuthorName: "Alice",
+		Subject:    "Add a feature",
+		Hunks: []MatchedHunk{
+			{Path: "cmd/main.go", Excerpt: "@@ -1,2 +1,3 @@ package main\n import \"fmt\"\n+func feature() {}"},
+			{Path: "old.txt", Excerpt: "@@ -1,1 +0,0 @@\n-removed"},
+		},
+	}
+	if diff := cmp.Diff(want, NewMatchedCommit(m)); diff != "" {
+		t.Fatalf("unexpected matched commit (-want +got):\n%s", diff)
+	}
+
+	m.Content = "```COMMIT_EDITMSG\nAdd a feature\n```"
+	want.Hunks = nil
+	if diff := cmp.Diff(want, NewMatchedCommit(m)); diff != "" {
+		t.Fatalf("unexpected matched commit (-want +got):\n%s", diff)
+	}
+}
+
+func TestResultsVisibleToUser(t *testing.T) {
+	database.Mocks.Repos.ListMinimalRepos = func(ctx context.Context, opt database.ReposListOptions) ([]types.MinimalRepo, error) {
+		if uid := actor.FromContext(ctx).UID; uid != 42 {
+			t.Fatalf("repos listed as user %d, want 42", uid)
+		}
+		if diff := cmp.Diff([]string{"github.com/public/repo", "github.com/private/repo", "github.com/public/repo"}, opt.Names); diff != "" {
+			t.Fatalf("unexpected names (-want +got):\n%s", diff)
+		}
+		return []types.MinimalRepo{{Name: "github.com/public/repo"}}, nil
+	}
+	t.Cleanup(func() { database.Mocks.Repos.ListMinimalRepos = nil })
+
+	results := []*MatchedCommit{
+		{Repo: "github.com/public/repo", Commit: "a"},
+		{Repo: "github.com/private/repo", Commit: "b"},
+		{Repo: "github.com/public/repo", Commit: "c"},
+	}
+	checker := authz.NewMockSubRepoPermissionChecker()
+	got, err := ResultsVisibleToUser(context.Background(), NewMockCodeMonitorStore(), checker, 42, results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*MatchedCommit{results[0], results[2]}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func TestResultsVisibleToUser_SubRepoPermissions(t *testing.T) {
+	database.Mocks.Repos.ListMinimalRepos = func(ctx context.Context, opt database.ReposListOptions) ([]types.MinimalRepo, error) {
+		return []types.MinimalRepo{{Name: "github.com/sourcegraph/repo"}}, nil
+	}
+	t.Cleanup(func() { database.Mocks.Repos.ListMinimalRepos = nil })
+
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.PermissionsFunc.SetDefaultHook(func(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+		if userID != 42 {
+			t.Fatalf("permissions checked for user %d, want 42", userID)
+		}
+		if strings.HasPrefix(content.Path, "secret/") {
+			return authz.None, nil
+		}
+		return authz.Read, nil
+	})
+
+	results := []*MatchedCommit{
+		{Repo: "github.com/sourcegraph/repo", Commit: "a", Hunks: []MatchedHunk{
+			{Path: "secret/a.go", Excerpt: "@@ -1 +1 @@"},
+			{Path: "public/a.go", Excerpt: "@@ -2 +2 @@"},
+		}},
+		{Repo: "github.com/sourcegraph/repo", Commit: "b", Hunks: []MatchedHunk{
+			{Path: "secret/b.go", Excerpt: "@@ -1 +1 @@"},
+		}},
+		{Repo: "github.com/sourcegraph/repo", Commit: "c"},
+	}
+	got, err := ResultsVisibleToUser(context.Background(), NewMockCodeMonitorStore(), checker, 42, results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*MatchedCommit{
+		{Repo: "github.com/sourcegraph/repo", Commit: "a", Hunks: []MatchedHunk{
+			{Path: "public/a.go", Excerpt: "@@ -2 +2 @@"},
+		}},
+		{Repo: "github.com/sourcegraph/repo", Commit: "c"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected results (-want +got):\n%s", diff)
+	}
+	if len(results[0].Hunks) != 2 {
+		t.Fatal("stored results were modified")
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 )
 
@@ -33,6 +35,14 @@ type CodeMonitorStore interface {
 	CountActionJobs(context.Context, ListActionJobsOpts) (int, error)
 	ListEmailActions(context.Context, ListActionsOpts) ([]*MonitorEmail, error)
 	EnqueueActionEmailsForQueryIDInt64(ctx context.Context, queryID int64, triggerEventID int) (err error)
+	CreateWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string, secret *string) (*MonitorWebhook, error)
+	WebhookActionByIDInt64(ctx context.Context, webhookID int64) (*MonitorWebhook, error)
+	ListWebhookActions(context.Context, ListActionsOpts) ([]*MonitorWebhook, error)
+	EnqueueActionWebhooksForQueryIDInt64(ctx context.Context, queryID int64, triggerEventID int) (err error)
+	CreateSlackWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string) (*MonitorSlackWebhook, error)
+	SlackWebhookActionByIDInt64(ctx context.Context, slackWebhookID int64) (*MonitorSlackWebhook, error)
+	ListSlackWebhookActions(context.Context, ListActionsOpts) ([]*MonitorSlackWebhook, error)
+	EnqueueActionSlackWebhooksForQueryIDInt64(ctx context.Context, queryID int64, triggerEventID int) (err error)
 	GetActionJobMetadata(ctx context.Context, recordID int) (*ActionJobMetadata, error)
 	ActionJobForIDInt(ctx context.Context, recordID int) (*ActionJob, error)
 	CreateActions(ctx context.Context, args []*graphqlbackend.CreateActionArgs, monitorID int64) (err error)
@@ -55,8 +65,11 @@ type CodeMonitorStore interface {
 	RecipientsForEmailIDInt64(ctx context.Context, emailID int64, args *graphqlbackend.ListRecipientsArgs) ([]*Recipient, error)
 	AllRecipientsForEmailIDInt64(ctx context.Context, emailID int64) (rs []*Recipient, err error)
 	TotalCountRecipients(ctx context.Context, emailID int64) (count int32, err error)
+	SetEmailOptOut(ctx context.Context, monitorID int64, userID int32, optOut bool) error
+	EmailOptOutUserIDs(ctx context.Context, monitorID int64) ([]int32, error)
 	EnqueueTriggerQueries(ctx context.Context) (err error)
 	LogSearch(ctx context.Context, queryString string, numResults int, recordID int) error
+	SetTriggerJobResults(ctx context.Context, recordID int, results []*MatchedCommit) error
 	DeleteObsoleteJobLogs(ctx context.Context) error
 	DeleteOldJobLogs(ctx context.Context, retentionInDays int) error
 	GetEventsForQueryIDInt64(ctx context.Context, queryID int64, args *graphqlbackend.ListEventsArgs) ([]*TriggerJobs, error)
@@ -68,6 +81,7 @@ type CodeMonitorStore interface {
 type codeMonitorStore struct {
 	*basestore.Store
 	now func() time.Time
+	key encryption.Key
 }
 
 var _ CodeMonitorStore = (*codeMonitorStore)(nil)
@@ -100,5 +114,14 @@ func (s *codeMonitorStore) Transact(ctx context.Context) (CodeMonitorStore, erro
 	if err != nil {
 		return nil, err
 	}
-	return &codeMonitorStore{Store: txBase, now: s.now}, nil
+	return &codeMonitorStore{Store: txBase, now: s.now, key: s.key}, nil
+}
+
+// getEncryptionKey returns the key used to encrypt webhook secrets, falling
+// back to the key configured in the default keyring.
+func (s *codeMonitorStore) getEncryptionKey() encryption.Key {
+	if s.key != nil {
+		return s.key
+	}
+	return keyring.Default().CodeMonitorWebhookKey
 }
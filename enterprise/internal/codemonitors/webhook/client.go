@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+// doer delivers webhooks. Webhook URLs are entered by users, so it refuses to
+// connect to addresses that aren't publicly routable, which would let users
+// send requests to services in the network of the Sourcegraph instance, such
+// as cloud metadata endpoints. Requests are never sent through a proxy, since
+// the proxy would connect to the address on our behalf.
+var doer, _ = httpcli.NewFactory(
+	httpcli.NewMiddleware(
+		httpcli.ContextErrorMiddleware,
+	),
+	// denyPrivateAddressesOpt needs to be before ExternalTransportOpt, since
+	// it wants to extract a http.Transport.
+	denyPrivateAddressesOpt,
+	httpcli.ExternalTransportOpt,
+	httpcli.TracedTransportOpt,
+).Doer()
+
+func denyPrivateAddressesOpt(cli *http.Client) error {
+	tr, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return errors.Errorf("http.DefaultTransport is not an *http.Transport: %T", http.DefaultTransport)
+	}
+	tr = tr.Clone()
+	tr.Proxy = nil
+	tr.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		// Control is called with the resolved address of every connection,
+		// including the ones of redirects, so DNS names resolving to private
+		// addresses are denied too.
+		Control: func(network, address string, _ syscall.RawConn) error {
+			return checkAddress(address)
+		},
+	}).DialContext
+	cli.Transport = tr
+	return nil
+}
+
+// sharedAddressSpace is the range of carrier-grade NAT addresses, which some
+// cloud providers use for internal services.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// errPrivateAddress is returned when a webhook URL resolves to an address that
+// isn't publicly routable.
+var errPrivateAddress = errors.New("webhook URLs must not resolve to private, loopback or link-local addresses")
+
+func checkAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("invalid IP address %q", host)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip) {
+		return errPrivateAddress
+	}
+	return nil
+}
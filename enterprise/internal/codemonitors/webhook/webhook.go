@@ -0,0 +1,162 @@
+// Package webhook delivers code monitor events to generic webhooks and Slack
+// incoming webhooks.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/slack"
+)
+
+// SignatureHeader is the header that carries the HMAC-SHA256 signature of the
+// request body when the webhook has a secret configured.
+const SignatureHeader = "X-Sourcegraph-Signature"
+
+// maxResponseBytes bounds how much of a response body we keep in a Delivery.
+const maxResponseBytes = 1024
+
+var MockSendWebhook func(ctx context.Context, url string, secret *string, payload *Payload) (*Delivery, error)
+var MockSendSlackWebhook func(ctx context.Context, url string, payload *slack.Payload) (*Delivery, error)
+
+// Payload is the JSON body we POST to generic webhooks.
+type Payload struct {
+	MonitorDescription string `json:"monitorDescription"`
+	MonitorURL         string `json:"monitorURL"`
+	Query              string `json:"query"`
+	SearchURL          string `json:"searchURL"`
+	ResultCount        int    `json:"resultCount"`
+
+	// Results holds the first matches found by the monitor. It is only set
+	// if the action opted in to including results.
+	Results []*codemonitors.MatchedCommit `json:"results,omitempty"`
+}
+
+// Delivery describes a single attempt to deliver a payload to a webhook.
+type Delivery struct {
+	URL        string
+	StatusCode int
+	Response   string
+	StartedAt  time.Time
+	Duration   time.Duration
+}
+
+// NewSlackPayload returns a Slack message describing the new results of a
+// code monitor.
+func NewSlackPayload(p *Payload) *slack.Payload {
+	plural := "s"
+	if p.ResultCount == 1 {
+		plural = ""
+	}
+	attachments := make([]*slack.Attachment, 0, len(p.Results))
+	for _, r := range p.Results {
+		var text strings.Builder
+		for _, h := range r.Hunks {
+			fmt.Fprintf(&text, "%s\n```%s```\n", h.Path, h.Excerpt)
+		}
+		attachments = append(attachments, &slack.Attachment{
+			AuthorName: r.AuthorName,
+			Fallback:   fmt.Sprintf("%s: %s", r.Repo, r.Subject),
+			Title:      fmt.Sprintf("%s: %s", r.Repo, r.Subject),
+			TitleLink:  r.URL,
+			Text:       text.String(),
+			MarkdownIn: []string{"text"},
+		})
+	}
+	return &slack.Payload{
+		Username:  "code-monitor-bot",
+		IconEmoji: ":mag:",
+		Text: fmt.Sprintf(`Code monitor <%s|%s> found *%d* new result%s for <%s|%s>`,
+			p.MonitorURL,
+			p.MonitorDescription,
+			p.ResultCount,
+			plural,
+			p.SearchURL,
+			p.Query,
+		),
+		Attachments: attachments,
+	}
+}
+
+// SendWebhook POSTs payload as JSON to url. If secret is set, the body is
+// signed and the signature is sent in SignatureHeader.
+func SendWebhook(ctx context.Context, url string, secret *string, payload *Payload) (*Delivery, error) {
+	if MockSendWebhook != nil {
+		return MockSendWebhook(ctx, url, secret, payload)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal webhook payload")
+	}
+	headers := http.Header{}
+	if secret != nil && *secret != "" {
+		headers.Set(SignatureHeader, Sign(*secret, body))
+	}
+	return post(ctx, doer, url, headers, body)
+}
+
+// SendSlackWebhook POSTs payload to the Slack incoming webhook at url.
+func SendSlackWebhook(ctx context.Context, url string, payload *slack.Payload) (*Delivery, error) {
+	if MockSendSlackWebhook != nil {
+		return MockSendSlackWebhook(ctx, url, payload)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal slack payload")
+	}
+	return post(ctx, doer, url, http.Header{}, body)
+}
+
+// Sign returns the value of SignatureHeader for body signed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func post(ctx context.Context, doer httpcli.Doer, url string, headers http.Header, body []byte) (*Delivery, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create webhook request")
+	}
+	req.Header = headers
+	req.Header.Set("Content-Type", "application/json")
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	d := &Delivery{URL: url, StartedAt: time.Now()}
+	resp, err := doer.Do(req.WithContext(ctx))
+	d.Duration = time.Since(d.StartedAt)
+	if err != nil {
+		return d, errors.Wrap(err, "webhook request")
+	}
+	defer resp.Body.Close()
+
+	d.StatusCode = resp.StatusCode
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return d, errors.Wrap(err, "read webhook response")
+	}
+	d.Response = string(respBody)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return d, errors.Errorf("webhook delivery failed with status %d: %s", resp.StatusCode, d.Response)
+	}
+	return d, nil
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+)
+
+// allowLoopback lets the webhook client connect to the loopback addresses of
+// test servers.
+func allowLoopback(t *testing.T) {
+	old := doer
+	doer = http.DefaultClient
+	t.Cleanup(func() { doer = old })
+}
+
+func TestSendWebhook(t *testing.T) {
+	allowLoopback(t)
+
+	payload := &Payload{
+		MonitorDescription: "test description",
+		MonitorURL:         "https://sourcegraph.com/code-monitoring/1",
+		Query:              "test patternType:literal",
+		SearchURL:          "https://sourcegraph.com/search?q=test",
+		ResultCount:        3,
+	}
+
+	var (
+		gotBody      []byte
+		gotSignature string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSignature = r.Header.Get(SignatureHeader)
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	secret := "s3cr3t"
+	d, err := SendWebhook(context.Background(), srv.URL, &secret, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotPayload Payload
+	if err := json.Unmarshal(gotBody, &gotPayload); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(*payload, gotPayload); diff != "" {
+		t.Errorf("unexpected payload (-want +got):\n%s", diff)
+	}
+	if want := Sign(secret, gotBody); gotSignature != want {
+		t.Errorf("wrong signature: want %q, have %q", want, gotSignature)
+	}
+	if d.StatusCode != http.StatusOK || d.Response != "ok" {
+		t.Errorf("unexpected delivery: %+v", d)
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		if _, err := SendWebhook(context.Background(), srv.URL, nil, payload); err != nil {
+			t.Fatal(err)
+		}
+		if gotSignature != "" {
+			t.Errorf("expected no signature, got %q", gotSignature)
+		}
+	})
+}
+
+func TestSendWebhookError(t *testing.T) {
+	allowLoopback(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	d, err := SendSlackWebhook(context.Background(), srv.URL, NewSlackPayload(&Payload{ResultCount: 1}))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if d == nil || d.StatusCode != http.StatusBadGateway {
+		t.Errorf("unexpected delivery: %+v", d)
+	}
+}
+
+func TestSendWebhookPrivateAddress(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	_, err := SendWebhook(context.Background(), srv.URL, nil, &Payload{})
+	if !errors.Is(err, errPrivateAddress) {
+		t.Fatalf("expected errPrivateAddress, got %v", err)
+	}
+	if called {
+		t.Error("webhook was delivered to a loopback address")
+	}
+}
+
+func TestCheckAddress(t *testing.T) {
+	for address, wantErr := range map[string]bool{
+		"127.0.0.1:80":          true,
+		"[::1]:443":             true,
+		"10.1.2.3:80":           true,
+		"172.16.0.1:80":         true,
+		"192.168.1.1:80":        true,
+		"169.254.169.254:80":    true,
+		"100.100.100.200:80":    true,
+		"0.0.0.0:80":            true,
+		"[fd00:ec2::254]:80":    true,
+		"[fe80::1]:80":          true,
+		"[::ffff:127.0.0.1]:80": true,
+		"8.8.8.8:443":           false,
+		"[2606:4700::1]:443":    false,
+	} {
+		if err := checkAddress(address); (err != nil) != wantErr {
+			t.Errorf("%s: unexpected error %v", address, err)
+		}
+	}
+}
+
+func TestNewSlackPayload(t *testing.T) {
+	p := NewSlackPayload(&Payload{
+		MonitorDescription: "my monitor",
+		MonitorURL:         "https://sourcegraph.com/code-monitoring/1",
+		Query:              "foo",
+		SearchURL:          "https://sourcegraph.com/search?q=foo",
+		ResultCount:        2,
+	})
+	want := "Code monitor <https://sourcegraph.com/code-monitoring/1|my monitor> found *2* new results for <https://sourcegraph.com/search?q=foo|foo>"
+	if p.Text != want {
+		t.Errorf("unexpected text:\nwant %q\nhave %q", want, p.Text)
+	}
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/github"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/gitlab"
@@ -18,6 +19,7 @@ func NewExternalServicesStore(db dbutil.DB) database.ExternalServiceStore {
 		[]func(*schema.GitHubConnection) error{github.ValidateAuthz},
 		[]func(*schema.GitLabConnection, []schema.AuthProviders) error{gitlab.ValidateAuthz},
 		[]func(*schema.BitbucketServerConnection) error{bitbucketserver.ValidateAuthz},
+		[]func(*schema.BitbucketCloudConnection) error{bitbucketcloud.ValidateAuthz},
 		[]func(connection *schema.PerforceConnection) error{perforce.ValidateAuthz},
 	)
 }
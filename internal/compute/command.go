@@ -16,8 +16,10 @@ var (
 	_ Command = (*MatchOnly)(nil)
 	_ Command = (*Replace)(nil)
 	_ Command = (*Output)(nil)
+	_ Command = (*Count)(nil)
 )
 
 func (MatchOnly) command() {}
 func (Replace) command()   {}
 func (Output) command()    {}
+func (Count) command()     {}
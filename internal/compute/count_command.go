@@ -0,0 +1,98 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// defaultGroupLimit is the number of groups reported by a count command that
+// does not specify a limit.
+const defaultGroupLimit = 100
+
+// Count groups the values of GroupPattern, expanded for every match of
+// MatchPattern, and counts the occurrences of each group across all results.
+type Count struct {
+	MatchPattern MatchPattern
+	GroupPattern string
+
+	// Limit is the number of groups with the highest counts to report.
+	Limit int
+}
+
+func (c *Count) String() string {
+	return fmt.Sprintf("Count groups: (%s) -> (%s) limit: %d", c.MatchPattern.String(), c.GroupPattern, c.Limit)
+}
+
+// Run returns the counts of the groups in a single file match. Use a
+// GroupCounter to combine the counts of all results.
+func (c *Count) Run(_ context.Context, fm *result.FileMatch) (Result, error) {
+	r := c.MatchPattern.(*Regexp).Value
+	counts := make(map[string]int)
+	for _, l := range fm.LineMatches {
+		for _, submatches := range r.FindAllStringSubmatchIndex(l.Preview, -1) {
+			group := r.ExpandString([]byte{}, c.GroupPattern, l.Preview, submatches)
+			counts[string(group)]++
+		}
+	}
+	return topGroups(counts, 0), nil
+}
+
+// NewGroupCounter returns a GroupCounter that reports the top groups of this
+// command.
+func (c *Count) NewGroupCounter() *GroupCounter {
+	return &GroupCounter{counts: make(map[string]int), limit: c.Limit}
+}
+
+// GroupCounter accumulates the group counts of a stream of results. It is safe
+// for concurrent use, so that partial counts can be reported while results are
+// still being added.
+type GroupCounter struct {
+	mu     sync.Mutex
+	counts map[string]int
+	limit  int
+}
+
+// Add adds the counts of a result returned by Count.Run.
+func (g *GroupCounter) Add(counts *GroupCounts) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, group := range counts.Groups {
+		g.counts[group.Value] += group.Count
+	}
+}
+
+// Snapshot returns the top groups of the counts added so far.
+func (g *GroupCounter) Snapshot() *GroupCounts {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return topGroups(g.counts, g.limit)
+}
+
+// topGroups returns the groups ordered by decreasing count, then value. If
+// limit is positive, only the first limit groups are returned and the
+// occurrences of the remaining groups are summed up in OtherCount.
+func topGroups(counts map[string]int, limit int) *GroupCounts {
+	groups := make([]Group, 0, len(counts))
+	for value, count := range counts {
+		groups = append(groups, Group{Value: value, Count: count})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Value < groups[j].Value
+	})
+
+	var otherCount int
+	if limit > 0 && len(groups) > limit {
+		for _, group := range groups[limit:] {
+			otherCount += group.Count
+		}
+		groups = groups[:limit]
+	}
+	return &GroupCounts{Groups: groups, OtherCount: otherCount}
+}
@@ -0,0 +1,71 @@
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hexops/autogold"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestCount(t *testing.T) {
+	fileMatches := []*result.FileMatch{
+		{
+			File: result.File{Path: "a.go"},
+			LineMatches: []*result.LineMatch{
+				{Preview: `import "fmt"`},
+				{Preview: `import "strings"`},
+			},
+		},
+		{
+			File: result.File{Path: "b.go"},
+			LineMatches: []*result.LineMatch{
+				{Preview: `import "fmt"`},
+				{Preview: `import "os"; import "fmt"`},
+			},
+		},
+	}
+
+	test := func(q string) string {
+		computeQuery, err := Parse(q)
+		if err != nil {
+			return err.Error()
+		}
+		count := computeQuery.Command.(*Count)
+		counter := count.NewGroupCounter()
+		for _, fm := range fileMatches {
+			res, err := count.Run(context.Background(), fm)
+			if err != nil {
+				return err.Error()
+			}
+			counter.Add(res.(*GroupCounts))
+		}
+		v, _ := json.Marshal(counter.Snapshot())
+		return string(v)
+	}
+
+	autogold.Want(
+		"count capture group",
+		`{"groups":[{"value":"fmt","count":3},{"value":"os","count":1},{"value":"strings","count":1}],"otherCount":0}`).
+		Equal(t, test(`content:count(import "(\w+)" -> $1)`))
+
+	autogold.Want(
+		"count whole match",
+		`{"groups":[{"value":"import \"fmt\"","count":3},{"value":"import \"os\"","count":1},{"value":"import \"strings\"","count":1}],"otherCount":0}`).
+		Equal(t, test(`content:count(import "\w+")`))
+
+	autogold.Want(
+		"count top groups",
+		`{"groups":[{"value":"fmt","count":3},{"value":"os","count":1}],"otherCount":1}`).
+		Equal(t, test(`content:count.top(2, import "(\w+)" -> $1)`))
+}
+
+func TestGroupCounterSnapshot(t *testing.T) {
+	counter := (&Count{Limit: 1}).NewGroupCounter()
+	counter.Add(&GroupCounts{Groups: []Group{{Value: "a", Count: 1}, {Value: "b", Count: 1}}})
+	autogold.Want("partial snapshot", &GroupCounts{Groups: []Group{{Value: "a", Count: 1}}, OtherCount: 1}).Equal(t, counter.Snapshot())
+
+	counter.Add(&GroupCounts{Groups: []Group{{Value: "b", Count: 2}}})
+	autogold.Want("updated snapshot", &GroupCounts{Groups: []Group{{Value: "b", Count: 3}}, OtherCount: 1}).Equal(t, counter.Snapshot())
+}
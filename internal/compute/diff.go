@@ -0,0 +1,72 @@
+package compute
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"github.com/sourcegraph/go-diff/diff"
+)
+
+// unifiedDiff returns the diff between the contents a and b of the file at path
+// in the unified format of `git diff`, or an empty string if they are equal.
+func unifiedDiff(path string, a, b []byte) (string, error) {
+	if bytes.Equal(a, b) {
+		return "", nil
+	}
+
+	edits := myers.ComputeEdits(span.URIFromPath(path), string(a), string(b))
+	unified := gotextdiff.ToUnified("a/"+path, "b/"+path, string(a), edits)
+
+	hunks := make([]*diff.Hunk, 0, len(unified.Hunks))
+	for _, h := range unified.Hunks {
+		hunks = append(hunks, toHunk(h))
+	}
+	out, err := diff.PrintFileDiff(&diff.FileDiff{
+		OrigName: unified.From,
+		NewName:  unified.To,
+		Extended: []string{"diff --git a/" + path + " b/" + path},
+		Hunks:    hunks,
+	})
+	return string(out), err
+}
+
+// toHunk converts a hunk computed by gotextdiff to a go-diff hunk, which
+// prints the line ranges of empty files the way git does.
+func toHunk(h *gotextdiff.Hunk) *diff.Hunk {
+	hunk := &diff.Hunk{
+		OrigStartLine: int32(h.FromLine),
+		NewStartLine:  int32(h.ToLine),
+	}
+	var body bytes.Buffer
+	for _, l := range h.Lines {
+		switch l.Kind {
+		case gotextdiff.Delete:
+			body.WriteByte('-')
+			hunk.OrigLines++
+		case gotextdiff.Insert:
+			body.WriteByte('+')
+			hunk.NewLines++
+		default:
+			body.WriteByte(' ')
+			hunk.OrigLines++
+			hunk.NewLines++
+		}
+		body.WriteString(l.Content)
+		if !strings.HasSuffix(l.Content, "\n") {
+			body.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+	hunk.Body = body.Bytes()
+
+	// An empty range starts at the line before it.
+	if hunk.OrigLines == 0 && hunk.OrigStartLine > 0 {
+		hunk.OrigStartLine--
+	}
+	if hunk.NewLines == 0 && hunk.NewStartLine > 0 {
+		hunk.NewStartLine--
+	}
+	return hunk
+}
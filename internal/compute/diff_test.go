@@ -0,0 +1,80 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/hexops/autogold"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	test := func(a, b string) string {
+		d, err := unifiedDiff("a.txt", []byte(a), []byte(b))
+		if err != nil {
+			return err.Error()
+		}
+		return d
+	}
+
+	autogold.Want("no changes", "").Equal(t, test("a\nb\n", "a\nb\n"))
+
+	autogold.Want("change with context", `diff --git a/a.txt b/a.txt
+--- a/a.txt
++++ b/a.txt
+@@ -2,7 +2,7 @@
+ 2
+ 3
+ 4
+-5
++five
+ 6
+ 7
+ 8
+`).Equal(t, test("1\n2\n3\n4\n5\n6\n7\n8\n9\n", "1\n2\n3\n4\nfive\n6\n7\n8\n9\n"))
+
+	autogold.Want("separate hunks", `diff --git a/a.txt b/a.txt
+--- a/a.txt
++++ b/a.txt
+@@ -1,4 +1,4 @@
+-1
++one
+ 2
+ 3
+ 4
+@@ -7,4 +7,4 @@
+ 7
+ 8
+ 9
+-10
++ten
+`).Equal(t, test("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n", "one\n2\n3\n4\n5\n6\n7\n8\n9\nten\n"))
+
+	autogold.Want("insertion at start", `diff --git a/a.txt b/a.txt
+--- a/a.txt
++++ b/a.txt
+@@ -0,0 +1,1 @@
++new
+`).Equal(t, test("", "new\n"))
+
+	autogold.Want("deletion of all lines", `diff --git a/a.txt b/a.txt
+--- a/a.txt
++++ b/a.txt
+@@ -1,1 +0,0 @@
+-old
+`).Equal(t, test("old\n", ""))
+
+	autogold.Want("no newline at end of file", `diff --git a/a.txt b/a.txt
+--- a/a.txt
++++ b/a.txt
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++c
+\ No newline at end of file
+`).Equal(t, test("a\nb", "a\nc"))
+}
+
+func TestCombineDiffs(t *testing.T) {
+	patch := CombineDiffs([]*Text{{Value: "diff a\n", Kind: "diff"}, {Value: "", Kind: "diff"}, {Value: "diff b\n", Kind: "diff"}})
+	autogold.Want("combined patch", &Text{Value: "diff a\ndiff b\n", Kind: "patch"}).Equal(t, patch)
+}
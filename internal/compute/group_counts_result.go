@@ -0,0 +1,14 @@
+package compute
+
+// Group is a distinct computed value and the number of times it occurred.
+type Group struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// GroupCounts are the groups with the highest counts, ordered by decreasing
+// count. OtherCount is the total count of the groups that were left out.
+type GroupCounts struct {
+	Groups     []Group `json:"groups"`
+	OtherCount int     `json:"otherCount"`
+}
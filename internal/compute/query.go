@@ -3,6 +3,8 @@ package compute
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
@@ -32,6 +34,8 @@ func (q Query) ToSearchQuery() (string, error) {
 		searchPattern = c.MatchPattern.String()
 	case *Output:
 		searchPattern = c.MatchPattern.String()
+	case *Count:
+		searchPattern = c.MatchPattern.String()
 	default:
 		return "", errors.Errorf("unsupported query conversion for compute command %T", c)
 	}
@@ -104,10 +108,15 @@ func toRegexpPattern(value string) (MatchPattern, error) {
 
 var ComputePredicateRegistry = query.PredicateRegistry{
 	query.FieldContent: {
-		"replace":            func() query.Predicate { return query.EmptyPredicate{} },
-		"replace.regexp":     func() query.Predicate { return query.EmptyPredicate{} },
-		"replace.structural": func() query.Predicate { return query.EmptyPredicate{} },
-		"output":             func() query.Predicate { return query.EmptyPredicate{} },
+		"replace":                 func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.regexp":          func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.structural":      func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.diff":            func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.regexp.diff":     func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.structural.diff": func() query.Predicate { return query.EmptyPredicate{} },
+		"output":                  func() query.Predicate { return query.EmptyPredicate{} },
+		"count":                   func() query.Predicate { return query.EmptyPredicate{} },
+		"count.top":               func() query.Predicate { return query.EmptyPredicate{} },
 	},
 }
 
@@ -144,6 +153,10 @@ func parseReplace(pattern *query.Pattern) (Command, bool, error) {
 		return nil, false, err
 	}
 
+	// The .diff variants return the replacements as a unified diff.
+	diff := strings.HasSuffix(name, ".diff")
+	name = strings.TrimSuffix(name, ".diff")
+
 	var matchPattern MatchPattern
 	switch name {
 	case "replace", "replace.regexp":
@@ -160,7 +173,7 @@ func parseReplace(pattern *query.Pattern) (Command, bool, error) {
 		return nil, false, nil
 	}
 
-	return &Replace{MatchPattern: matchPattern, ReplacePattern: right}, true, nil
+	return &Replace{MatchPattern: matchPattern, ReplacePattern: right, Diff: diff}, true, nil
 }
 
 func parseOutput(pattern *query.Pattern) (Command, bool, error) {
@@ -190,6 +203,49 @@ func parseOutput(pattern *query.Pattern) (Command, bool, error) {
 	return &Output{MatchPattern: matchPattern, OutputPattern: right, Separator: "\n"}, true, nil
 }
 
+func parseCount(pattern *query.Pattern) (Command, bool, error) {
+	name, args, ok := parseContentPredicate(pattern)
+	if !ok {
+		return nil, false, nil
+	}
+
+	limit := defaultGroupLimit
+	switch name {
+	case "count":
+	case "count.top":
+		// The limit precedes the pattern, as in count.top(10, pattern -> $1).
+		parts := strings.SplitN(args, ",", 2)
+		if len(parts) != 2 {
+			return nil, false, errors.New("count.top command expects a limit and a pattern separated by a comma")
+		}
+		var err error
+		limit, err = strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil || limit <= 0 {
+			return nil, false, errors.Errorf("count.top command expects a positive limit, got %q", parts[0])
+		}
+		args = strings.TrimSpace(parts[1])
+	default:
+		// unrecognized name
+		return nil, false, nil
+	}
+
+	// Without a right hand side, the whole match is the group.
+	left, right := args, "$0"
+	if arrowSyntax.MatchString(args) {
+		var err error
+		left, right, err = parseArrowSyntax(args)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	matchPattern, err := toRegexpPattern(left)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "count command")
+	}
+	return &Count{MatchPattern: matchPattern, GroupPattern: right, Limit: limit}, true, nil
+}
+
 func parseMatchOnly(pattern *query.Pattern) (Command, bool, error) {
 	rp, err := toRegexpPattern(pattern.Value)
 	if err != nil {
@@ -217,6 +273,7 @@ func first(parsers ...commandParser) commandParser {
 }
 
 var parseCommand = first(
+	parseCount,
 	parseReplace,
 	parseOutput,
 	parseMatchOnly,
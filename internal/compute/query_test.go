@@ -46,6 +46,26 @@ func TestParse(t *testing.T) {
 	autogold.Want("replace no left hand side",
 		"Command: `Replace in place: () -> (b)`").
 		Equal(t, test("content:replace(->b)"))
+
+	autogold.Want("replace as diff",
+		"Command: `Replace as diff: (a) -> (b)`").
+		Equal(t, test("content:replace.diff(a -> b)"))
+
+	autogold.Want("structural replace as diff",
+		"Command: `Replace as diff: (:[x]) -> (b)`").
+		Equal(t, test("content:replace.structural.diff(:[x] -> b)"))
+
+	autogold.Want("count",
+		"Command: `Count groups: (a(\\w+)) -> ($1) limit: 100`").
+		Equal(t, test(`content:count(a(\w+) -> $1)`))
+
+	autogold.Want("count top",
+		"Command: `Count groups: (a) -> ($0) limit: 5`").
+		Equal(t, test("content:count.top(5, a)"))
+
+	autogold.Want("count top without limit",
+		"count.top command expects a positive limit, got \"a\"").
+		Equal(t, test("content:count.top(a, b)"))
 }
 
 func TestToSearchQuery(t *testing.T) {
@@ -3,6 +3,7 @@ package compute
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/sourcegraph/sourcegraph/internal/comby"
@@ -13,9 +14,16 @@ import (
 type Replace struct {
 	MatchPattern   MatchPattern
 	ReplacePattern string
+
+	// Diff is true if the result is a unified diff of the changes instead of
+	// the replaced file content. See CombineDiffs.
+	Diff bool
 }
 
 func (c *Replace) String() string {
+	if c.Diff {
+		return fmt.Sprintf("Replace as diff: (%s) -> (%s)", c.MatchPattern.String(), c.ReplacePattern)
+	}
 	return fmt.Sprintf("Replace in place: (%s) -> (%s)", c.MatchPattern.String(), c.ReplacePattern)
 }
 
@@ -49,5 +57,30 @@ func (c *Replace) Run(ctx context.Context, fm *result.FileMatch) (Result, error)
 	if err != nil {
 		return nil, err
 	}
-	return replace(ctx, content, c.MatchPattern, c.ReplacePattern)
+	replaced, err := replace(ctx, content, c.MatchPattern, c.ReplacePattern)
+	if err != nil || !c.Diff {
+		return replaced, err
+	}
+	return replaceDiff(fm.Path, content, replaced)
+}
+
+// replaceDiff returns the changes of the replacement in content as a unified
+// diff.
+func replaceDiff(path string, content []byte, replaced *Text) (*Text, error) {
+	d, err := unifiedDiff(path, content, []byte(replaced.Value))
+	if err != nil {
+		return nil, err
+	}
+	return &Text{Value: d, Kind: "diff"}, nil
+}
+
+// CombineDiffs combines the diffs returned by a Replace command with Diff set
+// for the files of one repository into a single patch, which can be applied
+// with `git apply`.
+func CombineDiffs(diffs []*Text) *Text {
+	var b strings.Builder
+	for _, d := range diffs {
+		b.WriteString(d.Value)
+	}
+	return &Text{Value: b.String(), Kind: "patch"}
 }
@@ -27,6 +27,34 @@ func Test_replace(t *testing.T) {
 			ReplacePattern: "a bit more $1",
 		}))
 
+	diff := func(input string, cmd *Replace) string {
+		result, err := replace(context.Background(), []byte(input), cmd.MatchPattern, cmd.ReplacePattern)
+		if err != nil {
+			return err.Error()
+		}
+		result, err = replaceDiff("README.md", []byte(input), result)
+		if err != nil {
+			return err.Error()
+		}
+		return result.Value
+	}
+
+	autogold.Want(
+		"regexp search replace as diff",
+		`diff --git a/README.md b/README.md
+--- a/README.md
++++ b/README.md
+@@ -1,2 +1,2 @@
+ # Title
+-needs more queryrunner
++needs a bit more queryrunner
+`).
+		Equal(t, diff("# Title\nneeds more queryrunner\n", &Replace{
+			MatchPattern:   &Regexp{Value: regexp.MustCompile(`more (\w+)`)},
+			ReplacePattern: "a bit more $1",
+			Diff:           true,
+		}))
+
 	// If we are not on CI skip the test if comby is not installed.
 	if os.Getenv("CI") == "" && !comby.Exists() {
 		t.Skip("comby is not installed on the PATH. Try running 'bash <(curl -sL get.comby.dev)'.")
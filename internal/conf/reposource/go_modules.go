@@ -0,0 +1,107 @@
+package reposource
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+const goModulesRepoNamePrefix = "go/"
+
+// GoModule is a Go module, such as `golang.org/x/mod`.
+type GoModule struct {
+	Path string
+}
+
+// ParseGoModule parses a Go module path, such as `golang.org/x/mod`.
+func ParseGoModule(path string) (GoModule, error) {
+	if err := module.CheckPath(path); err != nil {
+		return GoModule{}, err
+	}
+	return GoModule{Path: path}, nil
+}
+
+// ParseGoModuleFromRepoURL returns the Go module from a URL path of the form
+// `go/<module path>`, without a leading `/`.
+func ParseGoModuleFromRepoURL(urlPath string) (GoModule, error) {
+	if !strings.HasPrefix(urlPath, goModulesRepoNamePrefix) {
+		return GoModule{}, fmt.Errorf("failed to parse a Go module from the path %s", urlPath)
+	}
+	return ParseGoModule(strings.TrimPrefix(urlPath, goModulesRepoNamePrefix))
+}
+
+// PackageSyntax returns the module path, as used in go.mod files.
+func (m GoModule) PackageSyntax() string {
+	return m.Path
+}
+
+func (m GoModule) SortText() string {
+	return m.Path
+}
+
+func (m GoModule) MatchesDependencyString(dependency string) bool {
+	return strings.HasPrefix(dependency, m.Path+"@")
+}
+
+func (m GoModule) RepoName() api.RepoName {
+	return api.RepoName(goModulesRepoNamePrefix + m.Path)
+}
+
+func (m GoModule) CloneURL() string {
+	cloneURL := url.URL{Path: string(m.RepoName())}
+	return cloneURL.String()
+}
+
+// GoDependency is a version of a Go module.
+type GoDependency struct {
+	GoModule
+	Version string
+}
+
+// ParseGoDependency parses a dependency string of the form `path@version`,
+// such as `golang.org/x/mod@v0.5.1`, into a GoDependency.
+func ParseGoDependency(dependency string) (GoDependency, error) {
+	i := strings.LastIndex(dependency, "@")
+	if i <= 0 {
+		return GoDependency{}, fmt.Errorf("dependency %q must be of the form path@version", dependency)
+	}
+	path, version := dependency[:i], dependency[i+1:]
+	if err := module.Check(path, version); err != nil {
+		return GoDependency{}, err
+	}
+	return GoDependency{GoModule: GoModule{Path: path}, Version: version}, nil
+}
+
+// ModuleVersion returns the dependency as a module.Version, which is the
+// representation used by the golang.org/x/mod packages.
+func (d GoDependency) ModuleVersion() module.Version {
+	return module.Version{Path: d.Path, Version: d.Version}
+}
+
+func (d GoDependency) PackageManagerSyntax() string {
+	return fmt.Sprintf("%s@%s", d.Path, d.Version)
+}
+
+// GitTagFromVersion returns the version itself, since Go module versions are
+// the git tags of the module repositories (like `v1.2.3`) already.
+func (d GoDependency) GitTagFromVersion() string {
+	return d.Version
+}
+
+// SortGoDependencies sorts the dependencies by the semantic version in
+// descending order. The latest version of a dependency becomes the first
+// element of the slice.
+func SortGoDependencies(dependencies []GoDependency) {
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].GoModule == dependencies[j].GoModule {
+			return semver.Compare(dependencies[i].Version, dependencies[j].Version) > 0
+		}
+		return dependencies[i].SortText() > dependencies[j].SortText()
+	})
+}
@@ -0,0 +1,65 @@
+package reposource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParseGoDependency(t *testing.T) {
+	for dependency, want := range map[string]GoDependency{
+		"golang.org/x/mod@v0.5.1":                                         {GoModule: GoModule{Path: "golang.org/x/mod"}, Version: "v0.5.1"},
+		"github.com/Masterminds/semver@v1.5.0":                            {GoModule: GoModule{Path: "github.com/Masterminds/semver"}, Version: "v1.5.0"},
+		"github.com/sourcegraph/zoekt@v0.0.0-20211108135652-f8e8ada171c7": {GoModule: GoModule{Path: "github.com/sourcegraph/zoekt"}, Version: "v0.0.0-20211108135652-f8e8ada171c7"},
+		"gopkg.in/yaml.v2@v2.4.0":                                         {GoModule: GoModule{Path: "gopkg.in/yaml.v2"}, Version: "v2.4.0"},
+	} {
+		got, err := ParseGoDependency(dependency)
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+		assert.Equal(t, dependency, got.PackageManagerSyntax())
+		assert.Equal(t, want.Version, got.GitTagFromVersion())
+	}
+
+	for _, dependency := range []string{"golang.org/x/mod", "golang.org/x/mod@", "golang.org/x/mod@0.5.1", "@v1.0.0", "../mod@v1.0.0", "github.com/foo/bar/v2@v1.0.0"} {
+		_, err := ParseGoDependency(dependency)
+		assert.NotNil(t, err, dependency)
+	}
+}
+
+func TestParseGoModuleFromRepoURL(t *testing.T) {
+	mod, err := ParseGoModuleFromRepoURL("go/golang.org/x/mod")
+	assert.Nil(t, err)
+	assert.Equal(t, GoModule{Path: "golang.org/x/mod"}, mod)
+	assert.Equal(t, api.RepoName("go/golang.org/x/mod"), mod.RepoName())
+	assert.True(t, mod.MatchesDependencyString("golang.org/x/mod@v0.5.1"))
+	assert.False(t, mod.MatchesDependencyString("golang.org/x/mod/v2@v2.0.0"))
+
+	_, err = ParseGoModuleFromRepoURL("npm/react")
+	assert.NotNil(t, err)
+}
+
+func TestSortGoDependencies(t *testing.T) {
+	dependencies := []GoDependency{
+		mustParseGoDependency(t, "golang.org/x/mod@v0.4.2"),
+		mustParseGoDependency(t, "golang.org/x/mod@v0.10.0"),
+		mustParseGoDependency(t, "golang.org/x/mod@v0.5.1"),
+		mustParseGoDependency(t, "golang.org/x/mod@v0.0.0-20211013180041-c96bc1413d57"),
+	}
+	SortGoDependencies(dependencies)
+
+	var versions []string
+	for _, d := range dependencies {
+		versions = append(versions, d.Version)
+	}
+	assert.Equal(t, []string{"v0.10.0", "v0.5.1", "v0.4.2", "v0.0.0-20211013180041-c96bc1413d57"}, versions)
+}
+
+func mustParseGoDependency(t *testing.T, dependency string) GoDependency {
+	d, err := ParseGoDependency(dependency)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
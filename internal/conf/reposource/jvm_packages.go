@@ -72,10 +72,20 @@ func (d MavenDependency) IsJDK() bool {
 	return d.MavenModule.IsJDK()
 }
 
+// PackageSyntax returns the name of the module without the version.
+func (d MavenDependency) PackageSyntax() string {
+	return d.MavenModule.CoursierSyntax()
+}
+
 func (d MavenDependency) CoursierSyntax() string {
 	return fmt.Sprintf("%s:%s:%s", d.MavenModule.GroupID, d.MavenModule.ArtifactID, d.Version)
 }
 
+// PackageManagerSyntax returns the dependency in Coursier syntax.
+func (d MavenDependency) PackageManagerSyntax() string {
+	return d.CoursierSyntax()
+}
+
 func (d MavenDependency) GitTagFromVersion() string {
 	return "v" + d.Version
 }
@@ -0,0 +1,114 @@
+package reposource
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+const npmPackagesRepoNamePrefix = "npm/"
+
+// NPMPackage is an npm package, such as `react` or `@types/react`.
+type NPMPackage struct {
+	// Scope is the part of the package name between the `@` and the `/`, or
+	// the empty string for unscoped packages.
+	Scope string
+	Name  string
+}
+
+// npmPackageNameRegex matches the package name rules of npm, see
+// https://docs.npmjs.com/cli/v7/configuring-npm/package-json#name
+var npmPackageNameRegex = regexp.MustCompile(`^(?:@([a-z0-9-~][a-z0-9-._~]*)/)?([a-z0-9-~][a-z0-9-._~]*)$`)
+
+// ParseNPMPackage parses an npm package name of the form `(@scope/)?name`.
+func ParseNPMPackage(packageName string) (NPMPackage, error) {
+	match := npmPackageNameRegex.FindStringSubmatch(packageName)
+	if match == nil {
+		return NPMPackage{}, fmt.Errorf("%q is not a valid npm package name", packageName)
+	}
+	return NPMPackage{Scope: match[1], Name: match[2]}, nil
+}
+
+// ParseNPMPackageFromRepoURL returns the npm package from a URL path of the
+// form `npm/(@scope/)?name`, without a leading `/`.
+func ParseNPMPackageFromRepoURL(urlPath string) (NPMPackage, error) {
+	if !strings.HasPrefix(urlPath, npmPackagesRepoNamePrefix) {
+		return NPMPackage{}, fmt.Errorf("failed to parse an npm package from the path %s", urlPath)
+	}
+	return ParseNPMPackage(strings.TrimPrefix(urlPath, npmPackagesRepoNamePrefix))
+}
+
+// PackageSyntax returns the name of the package as used in package.json files,
+// such as `@types/react`.
+func (p NPMPackage) PackageSyntax() string {
+	if p.Scope == "" {
+		return p.Name
+	}
+	return fmt.Sprintf("@%s/%s", p.Scope, p.Name)
+}
+
+func (p NPMPackage) SortText() string {
+	return p.PackageSyntax()
+}
+
+func (p NPMPackage) MatchesDependencyString(dependency string) bool {
+	return strings.HasPrefix(dependency, p.PackageSyntax()+"@")
+}
+
+func (p NPMPackage) RepoName() api.RepoName {
+	return api.RepoName(npmPackagesRepoNamePrefix + p.PackageSyntax())
+}
+
+func (p NPMPackage) CloneURL() string {
+	cloneURL := url.URL{Path: string(p.RepoName())}
+	return cloneURL.String()
+}
+
+// NPMDependency is a version of an npm package.
+type NPMDependency struct {
+	NPMPackage
+	Version string
+}
+
+// ParseNPMDependency parses a dependency string of the form
+// `(@scope/)?name@version` into an NPMDependency.
+func ParseNPMDependency(dependency string) (NPMDependency, error) {
+	// The version separator is the last `@`, since the scope starts with one.
+	i := strings.LastIndex(dependency, "@")
+	if i <= 0 {
+		return NPMDependency{}, fmt.Errorf("dependency %q must be of the form (@scope/)?name@version", dependency)
+	}
+	pkg, err := ParseNPMPackage(dependency[:i])
+	if err != nil {
+		return NPMDependency{}, err
+	}
+	version := dependency[i+1:]
+	if version == "" {
+		return NPMDependency{}, fmt.Errorf("dependency %q has an empty version", dependency)
+	}
+	return NPMDependency{NPMPackage: pkg, Version: version}, nil
+}
+
+func (d NPMDependency) PackageManagerSyntax() string {
+	return fmt.Sprintf("%s@%s", d.PackageSyntax(), d.Version)
+}
+
+func (d NPMDependency) GitTagFromVersion() string {
+	return "v" + d.Version
+}
+
+// SortNPMDependencies sorts the dependencies by the semantic version in
+// descending order. The latest version of a dependency becomes the first
+// element of the slice.
+func SortNPMDependencies(dependencies []NPMDependency) {
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].NPMPackage == dependencies[j].NPMPackage {
+			return versionGreaterThan(dependencies[i].Version, dependencies[j].Version)
+		}
+		return dependencies[i].SortText() > dependencies[j].SortText()
+	})
+}
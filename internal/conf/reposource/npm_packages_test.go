@@ -0,0 +1,69 @@
+package reposource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParseNPMDependency(t *testing.T) {
+	for dependency, want := range map[string]NPMDependency{
+		"react@17.0.2":                   {NPMPackage: NPMPackage{Name: "react"}, Version: "17.0.2"},
+		"@types/react@17.0.2":            {NPMPackage: NPMPackage{Scope: "types", Name: "react"}, Version: "17.0.2"},
+		"@sourcegraph/prettierrc@2.2.0":  {NPMPackage: NPMPackage{Scope: "sourcegraph", Name: "prettierrc"}, Version: "2.2.0"},
+		"left-pad@1.3.0-beta.1+build.42": {NPMPackage: NPMPackage{Name: "left-pad"}, Version: "1.3.0-beta.1+build.42"},
+	} {
+		got, err := ParseNPMDependency(dependency)
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+		assert.Equal(t, dependency, got.PackageManagerSyntax())
+	}
+
+	for _, dependency := range []string{"react", "@types/react", "react@", "@types@1.0.0", "React@1.0.0", "../react@1.0.0"} {
+		_, err := ParseNPMDependency(dependency)
+		assert.NotNil(t, err, dependency)
+	}
+}
+
+func TestParseNPMPackageFromRepoURL(t *testing.T) {
+	pkg, err := ParseNPMPackageFromRepoURL("npm/@types/react")
+	assert.Nil(t, err)
+	assert.Equal(t, NPMPackage{Scope: "types", Name: "react"}, pkg)
+	assert.Equal(t, api.RepoName("npm/@types/react"), pkg.RepoName())
+	assert.True(t, pkg.MatchesDependencyString("@types/react@17.0.2"))
+	assert.False(t, pkg.MatchesDependencyString("@types/react-dom@17.0.2"))
+
+	pkg, err = ParseNPMPackageFromRepoURL("npm/react")
+	assert.Nil(t, err)
+	assert.Equal(t, api.RepoName("npm/react"), pkg.RepoName())
+
+	_, err = ParseNPMPackageFromRepoURL("maven/org.example/example")
+	assert.NotNil(t, err)
+}
+
+func TestSortNPMDependencies(t *testing.T) {
+	parse := func(dependency string) NPMDependency {
+		d, err := ParseNPMDependency(dependency)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	dependencies := []NPMDependency{
+		parse("a@1.2.0"),
+		parse("b@1.11.0"),
+		parse("b@1.2.0"),
+		parse("b@1.2.0-rc.1"),
+		parse("@a/b@1.0.0"),
+	}
+	SortNPMDependencies(dependencies)
+	assert.Equal(t, []NPMDependency{
+		parse("b@1.11.0"),
+		parse("b@1.2.0"),
+		parse("b@1.2.0-rc.1"),
+		parse("a@1.2.0"),
+		parse("@a/b@1.0.0"),
+	}, dependencies)
+}
@@ -34,6 +34,9 @@ type MockUserEmailsStore struct {
 	// GetPrimaryEmailFunc is an instance of a mock function object
 	// controlling the behavior of the method GetPrimaryEmail.
 	GetPrimaryEmailFunc *UserEmailsStoreGetPrimaryEmailFunc
+	// GetPrimaryEmailsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPrimaryEmails.
+	GetPrimaryEmailsFunc *UserEmailsStoreGetPrimaryEmailsFunc
 	// GetVerifiedEmailsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetVerifiedEmails.
 	GetVerifiedEmailsFunc *UserEmailsStoreGetVerifiedEmailsFunc
@@ -101,6 +104,11 @@ func NewMockUserEmailsStore() *MockUserEmailsStore {
 				return "", false, nil
 			},
 		},
+		GetPrimaryEmailsFunc: &UserEmailsStoreGetPrimaryEmailsFunc{
+			defaultHook: func(context.Context, ...int32) ([]*database.UserEmail, error) {
+				return nil, nil
+			},
+		},
 		GetVerifiedEmailsFunc: &UserEmailsStoreGetVerifiedEmailsFunc{
 			defaultHook: func(context.Context, ...string) ([]*database.UserEmail, error) {
 				return nil, nil
@@ -177,6 +185,9 @@ func NewMockUserEmailsStoreFrom(i database.UserEmailsStore) *MockUserEmailsStore
 		GetPrimaryEmailFunc: &UserEmailsStoreGetPrimaryEmailFunc{
 			defaultHook: i.GetPrimaryEmail,
 		},
+		GetPrimaryEmailsFunc: &UserEmailsStoreGetPrimaryEmailsFunc{
+			defaultHook: i.GetPrimaryEmails,
+		},
 		GetVerifiedEmailsFunc: &UserEmailsStoreGetVerifiedEmailsFunc{
 			defaultHook: i.GetVerifiedEmails,
 		},
@@ -877,6 +888,125 @@ func (c UserEmailsStoreGetPrimaryEmailFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// UserEmailsStoreGetPrimaryEmailsFunc describes the behavior when the
+// GetPrimaryEmails method of the parent MockUserEmailsStore instance is
+// invoked.
+type UserEmailsStoreGetPrimaryEmailsFunc struct {
+	defaultHook func(context.Context, ...int32) ([]*database.UserEmail, error)
+	hooks       []func(context.Context, ...int32) ([]*database.UserEmail, error)
+	history     []UserEmailsStoreGetPrimaryEmailsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPrimaryEmails delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockUserEmailsStore) GetPrimaryEmails(v0 context.Context, v1 ...int32) ([]*database.UserEmail, error) {
+	r0, r1 := m.GetPrimaryEmailsFunc.nextHook()(v0, v1...)
+	m.GetPrimaryEmailsFunc.appendCall(UserEmailsStoreGetPrimaryEmailsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPrimaryEmails
+// method of the parent MockUserEmailsStore instance is invoked and the hook
+// queue is empty.
+func (f *UserEmailsStoreGetPrimaryEmailsFunc) SetDefaultHook(hook func(context.Context, ...int32) ([]*database.UserEmail, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPrimaryEmails method of the parent MockUserEmailsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *UserEmailsStoreGetPrimaryEmailsFunc) PushHook(hook func(context.Context, ...int32) ([]*database.UserEmail, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *UserEmailsStoreGetPrimaryEmailsFunc) SetDefaultReturn(r0 []*database.UserEmail, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...int32) ([]*database.UserEmail, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *UserEmailsStoreGetPrimaryEmailsFunc) PushReturn(r0 []*database.UserEmail, r1 error) {
+	f.PushHook(func(context.Context, ...int32) ([]*database.UserEmail, error) {
+		return r0, r1
+	})
+}
+
+func (f *UserEmailsStoreGetPrimaryEmailsFunc) nextHook() func(context.Context, ...int32) ([]*database.UserEmail, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UserEmailsStoreGetPrimaryEmailsFunc) appendCall(r0 UserEmailsStoreGetPrimaryEmailsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UserEmailsStoreGetPrimaryEmailsFuncCall
+// objects describing the invocations of this function.
+func (f *UserEmailsStoreGetPrimaryEmailsFunc) History() []UserEmailsStoreGetPrimaryEmailsFuncCall {
+	f.mutex.Lock()
+	history := make([]UserEmailsStoreGetPrimaryEmailsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UserEmailsStoreGetPrimaryEmailsFuncCall is an object that describes an
+// invocation of method GetPrimaryEmails on an instance of
+// MockUserEmailsStore.
+type UserEmailsStoreGetPrimaryEmailsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []int32
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.UserEmail
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c UserEmailsStoreGetPrimaryEmailsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UserEmailsStoreGetPrimaryEmailsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // UserEmailsStoreGetVerifiedEmailsFunc describes the behavior when the
 // GetVerifiedEmails method of the parent MockUserEmailsStore instance is
 // invoked.
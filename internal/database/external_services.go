@@ -69,6 +69,7 @@ type externalServiceStore struct {
 	gitHubValidators          []func(*schema.GitHubConnection) error
 	gitLabValidators          []func(*schema.GitLabConnection, []schema.AuthProviders) error
 	bitbucketServerValidators []func(*schema.BitbucketServerConnection) error
+	bitbucketCloudValidators  []func(*schema.BitbucketCloudConnection) error
 	perforceValidators        []func(*schema.PerforceConnection) error
 
 	key encryption.Key
@@ -81,6 +82,7 @@ func (e *externalServiceStore) copy() *externalServiceStore {
 		gitHubValidators:          e.gitHubValidators,
 		gitLabValidators:          e.gitLabValidators,
 		bitbucketServerValidators: e.bitbucketServerValidators,
+		bitbucketCloudValidators:  e.bitbucketCloudValidators,
 		perforceValidators:        e.perforceValidators,
 	}
 }
@@ -97,6 +99,7 @@ func NewExternalServiceStoreWithValidators(
 	gitHubValidators []func(*schema.GitHubConnection) error,
 	gitLabValidators []func(*schema.GitLabConnection, []schema.AuthProviders) error,
 	bitbucketServerValidators []func(*schema.BitbucketServerConnection) error,
+	bitbucketCloudValidators []func(*schema.BitbucketCloudConnection) error,
 	perforceValidators []func(*schema.PerforceConnection) error,
 ) ExternalServiceStore {
 	return &externalServiceStore{
@@ -104,6 +107,7 @@ func NewExternalServiceStoreWithValidators(
 		gitHubValidators:          gitHubValidators,
 		gitLabValidators:          gitLabValidators,
 		bitbucketServerValidators: bitbucketServerValidators,
+		bitbucketCloudValidators:  bitbucketCloudValidators,
 		perforceValidators:        perforceValidators,
 	}
 }
@@ -155,7 +159,9 @@ var ExternalServiceKinds = map[string]ExternalServiceKind{
 	extsvc.KindGitHub:          {CodeHost: true, JSONSchema: schema.GitHubSchemaJSON},
 	extsvc.KindGitLab:          {CodeHost: true, JSONSchema: schema.GitLabSchemaJSON},
 	extsvc.KindGitolite:        {CodeHost: true, JSONSchema: schema.GitoliteSchemaJSON},
+	extsvc.KindGoModules:       {CodeHost: true, JSONSchema: schema.GoModulesSchemaJSON},
 	extsvc.KindJVMPackages:     {CodeHost: true, JSONSchema: schema.JVMPackagesSchemaJSON},
+	extsvc.KindNPMPackages:     {CodeHost: true, JSONSchema: schema.NPMPackagesSchemaJSON},
 	extsvc.KindPerforce:        {CodeHost: true, JSONSchema: schema.PerforceSchemaJSON},
 	extsvc.KindPhabricator:     {CodeHost: true, JSONSchema: schema.PhabricatorSchemaJSON},
 	extsvc.KindOther:           {CodeHost: true, JSONSchema: schema.OtherExternalServiceSchemaJSON},
@@ -461,7 +467,14 @@ func (e *externalServiceStore) validateBitbucketServerConnection(ctx context.Con
 }
 
 func (e *externalServiceStore) validateBitbucketCloudConnection(ctx context.Context, id int64, c *schema.BitbucketCloudConnection) error {
-	return e.validateDuplicateRateLimits(ctx, id, extsvc.KindBitbucketCloud, c)
+	err := new(multierror.Error)
+	for _, validate := range e.bitbucketCloudValidators {
+		err = multierror.Append(err, validate(c))
+	}
+
+	err = multierror.Append(err, e.validateDuplicateRateLimits(ctx, id, extsvc.KindBitbucketCloud, c))
+
+	return err.ErrorOrNil()
 }
 
 func (e *externalServiceStore) validatePerforceConnection(ctx context.Context, id int64, c *schema.PerforceConnection) error {
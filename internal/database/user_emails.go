@@ -53,6 +53,7 @@ type UserEmailsStore interface {
 	GetInitialSiteAdminEmail(ctx context.Context) (email string, err error)
 	GetLatestVerificationSentEmail(ctx context.Context, email string) (*UserEmail, error)
 	GetPrimaryEmail(ctx context.Context, id int32) (email string, verified bool, err error)
+	GetPrimaryEmails(ctx context.Context, userIDs ...int32) ([]*UserEmail, error)
 	GetVerifiedEmails(ctx context.Context, emails ...string) ([]*UserEmail, error)
 	ListByUser(ctx context.Context, opt UserEmailsListOptions) ([]*UserEmail, error)
 	Remove(ctx context.Context, userID int32, email string) error
@@ -118,6 +119,25 @@ func (s *userEmailsStore) GetPrimaryEmail(ctx context.Context, id int32) (email
 	return email, verified, nil
 }
 
+// GetPrimaryEmails returns the primary emails of the given users. Users without a primary
+// email are excluded from the results list.
+func (s *userEmailsStore) GetPrimaryEmails(ctx context.Context, userIDs ...int32) ([]*UserEmail, error) {
+	if Mocks.UserEmails.GetPrimaryEmails != nil {
+		return Mocks.UserEmails.GetPrimaryEmails(ctx, userIDs...)
+	}
+
+	if len(userIDs) == 0 {
+		return []*UserEmail{}, nil
+	}
+
+	items := make([]*sqlf.Query, len(userIDs))
+	for i := range userIDs {
+		items[i] = sqlf.Sprintf("%s", userIDs[i])
+	}
+	q := sqlf.Sprintf("WHERE user_id IN (%s) AND is_primary ORDER BY user_id ASC", sqlf.Join(items, ","))
+	return s.getBySQL(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
+}
+
 // SetPrimaryEmail sets the primary email for a user.
 // The address must be verified.
 // All other addresses for the user will be set as not primary.
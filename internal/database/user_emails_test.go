@@ -533,6 +533,64 @@ func TestUserEmails_GetLatestVerificationSentEmail(t *testing.T) {
 	}
 }
 
+func TestUserEmails_GetPrimaryEmails(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t)
+	ctx := context.Background()
+
+	alice, err := Users(db).Create(ctx, NewUser{
+		Email:           "alice@example.com",
+		Username:        "alice",
+		EmailIsVerified: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := Users(db).Create(ctx, NewUser{
+		Email:                 "bob@example.com",
+		Username:              "bob",
+		EmailVerificationCode: "c",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := UserEmails(db).Add(ctx, alice.ID, "alice2@example.com", nil); err != nil {
+		t.Fatal(err)
+	}
+	carol, err := Users(db).Create(ctx, NewUser{Username: "carol"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emails, err := UserEmails(db).GetPrimaryEmails(ctx, alice.ID, bob.ID, carol.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type primaryEmail struct {
+		UserID   int32
+		Email    string
+		Verified bool
+	}
+	var got []primaryEmail
+	for _, email := range emails {
+		got = append(got, primaryEmail{UserID: email.UserID, Email: email.Email, Verified: email.VerifiedAt != nil})
+	}
+	want := []primaryEmail{
+		{UserID: alice.ID, Email: "alice@example.com", Verified: true},
+		{UserID: bob.ID, Email: "bob@example.com", Verified: false},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected primary emails (-want +got):\n%s", diff)
+	}
+
+	if emails, err := UserEmails(db).GetPrimaryEmails(ctx); err != nil || len(emails) != 0 {
+		t.Fatalf("expected no emails for no users, got %v (%v)", emails, err)
+	}
+}
+
 func TestUserEmails_GetVerifiedEmails(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
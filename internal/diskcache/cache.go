@@ -78,6 +78,55 @@ func (s *Store) Open(ctx context.Context, key string, fetcher Fetcher) (file *Fi
 // OpenWithPath will open a file from the local cache with key. If missing, fetcher
 // will fill the cache first. Open also performs single-flighting for fetcher.
 func (s *Store) OpenWithPath(ctx context.Context, key string, fetcher FetcherWithPath) (file *File, err error) {
+	return s.openWithPath(ctx, "", key, fetcher)
+}
+
+// OpenInDirWithPath is like OpenWithPath, but groups the cache entry with the
+// other entries opened with the same dir. The entries of a group are listed
+// by ListInDir.
+func (s *Store) OpenInDirWithPath(ctx context.Context, dir, key string, fetcher FetcherWithPath) (file *File, err error) {
+	return s.openWithPath(ctx, dir, key, fetcher)
+}
+
+// ListInDir returns the paths of the cache entries opened with
+// OpenInDirWithPath and the given dir, most recently used first.
+func (s *Store) ListInDir(dir string) ([]string, error) {
+	entries, err := os.ReadDir(s.dirPath(dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	type entryInfo struct {
+		path    string
+		modTime time.Time
+	}
+	infos := make([]entryInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".zip") {
+			continue
+		}
+		fi, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		infos = append(infos, entryInfo{path: filepath.Join(s.dirPath(dir), entry.Name()), modTime: fi.ModTime()})
+	}
+	sort.SliceStable(infos, func(i, j int) bool { return infos[i].modTime.After(infos[j].modTime) })
+
+	paths := make([]string, 0, len(infos))
+	for _, info := range infos {
+		paths = append(paths, info.path)
+	}
+	return paths, nil
+}
+
+func (s *Store) openWithPath(ctx context.Context, dir, key string, fetcher FetcherWithPath) (file *File, err error) {
 	span, ctx := ot.StartSpanFromContext(ctx, "Cached Fetch")
 	if s.Component != "" {
 		ext.Component.Set(span, s.Component)
@@ -99,7 +148,7 @@ func (s *Store) OpenWithPath(ctx context.Context, key string, fetcher FetcherWit
 		return nil, errors.New("diskcache.Store.Dir must be set")
 	}
 
-	path := s.path(key)
+	path := filepath.Join(s.dirPath(dir), hashKey(key)) + ".zip"
 	span.LogKV("key", key, "path", path)
 
 	// First do a fast-path, assume already on disk
@@ -139,12 +188,20 @@ func (s *Store) OpenWithPath(ctx context.Context, key string, fetcher FetcherWit
 	}
 }
 
-// path returns the path for key.
-func (s *Store) path(key string) string {
-	// path uses a sha256 hash of the key since we want to use it for the
-	// disk name.
+// dirPath returns the directory for the cache entries in dir. The entries
+// opened without a dir are stored in Store.Dir itself.
+func (s *Store) dirPath(dir string) string {
+	if dir == "" {
+		return s.Dir
+	}
+	return filepath.Join(s.Dir, hashKey(dir))
+}
+
+// hashKey returns a sha256 hash of key since we want to use it for the disk
+// name.
+func hashKey(key string) string {
 	h := sha256.Sum256([]byte(key))
-	return filepath.Join(s.Dir, hex.EncodeToString(h[:])) + ".zip"
+	return hex.EncodeToString(h[:])
 }
 
 func doFetch(ctx context.Context, path string, fetcher FetcherWithPath) (file *File, err error) {
@@ -168,18 +225,23 @@ func doFetch(ctx context.Context, path string, fetcher FetcherWithPath) (file *F
 	// Just in case we failed due to something bad on the FS, remove
 	_ = os.Remove(path)
 
-	// Fetch since we still can't open up the file
-	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
-		return nil, errors.Wrap(err, "could not create archive cache dir")
-	}
-
 	// We write to a temporary path to prevent another Open finding a
 	// partially written file. We ensure the file is writeable and truncate
-	// it.
+	// it. Evict removes empty subdirectories, so we create the directory
+	// again if it disappeared in between.
 	tmpPath := path + ".part"
-	f, err = os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create temporary archive cache item")
+	for attempt := 0; ; attempt++ {
+		// Fetch since we still can't open up the file
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return nil, errors.Wrap(err, "could not create archive cache dir")
+		}
+		f, err = os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err == nil {
+			break
+		}
+		if !os.IsNotExist(err) || attempt > 0 {
+			return nil, errors.Wrap(err, "failed to create temporary archive cache item")
+		}
 	}
 	f.Close()
 	defer os.Remove(tmpPath)
@@ -230,23 +292,37 @@ func (s *Store) Evict(maxCacheSizeBytes int64) (stats EvictStats, err error) {
 		return strings.HasSuffix(fi.Name(), ".zip")
 	}
 
-	entries, err := os.ReadDir(s.Dir)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return EvictStats{
-				CacheSize: 0,
-				Evicted:   0,
-			}, nil
-		}
-		return stats, errors.Wrapf(err, "failed to ReadDir %s", s.Dir)
+	// Cache entries are stored in Store.Dir and its direct subdirectories.
+	type entry struct {
+		path string
+		fs.FileInfo
 	}
-
-	list := make([]fs.FileInfo, len(entries))
-	for i := range entries {
-		list[i], err = entries[i].Info()
+	var list []entry
+	err = filepath.WalkDir(s.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			if path != s.Dir && filepath.Dir(path) != s.Dir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		fi, err := d.Info()
 		if err != nil {
-			return stats, err
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
 		}
+		list = append(list, entry{path: path, FileInfo: fi})
+		return nil
+	})
+	if err != nil {
+		return stats, errors.Wrapf(err, "failed to walk %s", s.Dir)
 	}
 
 	// Sum up the total size of all zips
@@ -275,7 +351,7 @@ func (s *Store) Evict(maxCacheSizeBytes int64) (stats EvictStats, err error) {
 		if !isZip(fi) {
 			continue
 		}
-		path := filepath.Join(s.Dir, fi.Name())
+		path := fi.path
 		if s.BeforeEvict != nil {
 			s.BeforeEvict(path)
 		}
@@ -286,6 +362,12 @@ func (s *Store) Evict(maxCacheSizeBytes int64) (stats EvictStats, err error) {
 		}
 		stats.Evicted++
 		size -= fi.Size()
+
+		// Remove the subdirectory of the entry once it is empty. Removing a
+		// directory that still has entries fails, which is fine.
+		if dir := filepath.Dir(path); dir != s.Dir {
+			_ = os.Remove(dir)
+		}
 	}
 
 	return stats, nil
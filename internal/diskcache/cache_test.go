@@ -5,7 +5,10 @@ import (
 	"context"
 	"io"
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestOpen(t *testing.T) {
@@ -60,3 +63,79 @@ func TestOpen(t *testing.T) {
 		t.Fatal("Item was not properly evicted")
 	}
 }
+
+func TestOpenInDir(t *testing.T) {
+	dir, err := os.MkdirTemp("", "diskcache_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := &Store{
+		Dir:       dir,
+		Component: "test",
+	}
+
+	open := func(dir, key string) *File {
+		f, err := store.OpenInDirWithPath(context.Background(), dir, key, func(ctx context.Context, path string) error {
+			return os.WriteFile(path, []byte(key), 0600)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		return f
+	}
+
+	if paths, err := store.ListInDir("a"); err != nil || len(paths) != 0 {
+		t.Fatalf("expected no entry in an empty cache, got %q (%v)", paths, err)
+	}
+
+	a1 := open("a", "1")
+	a2 := open("a", "2")
+	b1 := open("b", "1")
+	if a1.Path == b1.Path {
+		t.Fatal("expected entries with the same key in different dirs to be distinct")
+	}
+
+	// Make a1 the most recently used entry of a.
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(a1.Path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if paths, err := store.ListInDir("a"); err != nil || !reflect.DeepEqual(paths, []string{a1.Path, a2.Path}) {
+		t.Fatalf("unexpected entries: got %q (%v), want %q", paths, err, []string{a1.Path, a2.Path})
+	}
+
+	// Evict the two least recently used entries, which live in subdirectories.
+	earlier := time.Now().Add(-time.Minute)
+	if err := os.Chtimes(b1.Path, earlier, earlier); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(a2.Path, earlier, earlier); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := store.Evict(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.CacheSize != 3 || stats.Evicted != 2 {
+		t.Fatalf("unexpected evict stats: %+v", stats)
+	}
+	for _, f := range []*File{a2, b1} {
+		if _, err := os.Stat(f.Path); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be evicted", f.Path)
+		}
+	}
+
+	// The directory of b is empty now and removed, while a still has a1.
+	if _, err := os.Stat(filepath.Dir(b1.Path)); !os.IsNotExist(err) {
+		t.Errorf("expected the empty directory of b to be removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Dir(a1.Path)); err != nil {
+		t.Errorf("expected the directory of a to be kept, got %v", err)
+	}
+
+	// An evicted directory is created again when needed.
+	open("b", "1")
+}
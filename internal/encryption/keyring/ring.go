@@ -93,6 +93,13 @@ func NewRing(ctx context.Context, keyConfig *schema.EncryptionKeys) (*Ring, erro
 		}
 	}
 
+	if keyConfig.CodeMonitorWebhookKey != nil {
+		r.CodeMonitorWebhookKey, err = NewKey(ctx, keyConfig.CodeMonitorWebhookKey, keyConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if keyConfig.ExternalServiceKey != nil {
 		r.ExternalServiceKey, err = NewKey(ctx, keyConfig.ExternalServiceKey, keyConfig)
 		if err != nil {
@@ -119,6 +126,7 @@ func NewRing(ctx context.Context, keyConfig *schema.EncryptionKeys) (*Ring, erro
 
 type Ring struct {
 	BatchChangesCredentialKey encryption.Key
+	CodeMonitorWebhookKey     encryption.Key
 	ExternalServiceKey        encryption.Key
 	UserExternalAccountKey    encryption.Key
 	WebhookLogKey             encryption.Key
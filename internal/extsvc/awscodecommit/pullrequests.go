@@ -0,0 +1,386 @@
+package awscodecommit
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	codecommittypes "github.com/aws/aws-sdk-go-v2/service/codecommit/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/cockroachdb/errors"
+)
+
+// ErrPullRequestNotFound is returned when the requested AWS CodeCommit pull
+// request does not exist.
+var ErrPullRequestNotFound = errors.New("AWS CodeCommit pull request not found")
+
+// ErrNotMergeable is returned by MergePullRequest when the pull request cannot
+// be merged, for example because of conflicts or unsatisfied approval rules.
+var ErrNotMergeable = errors.New("AWS CodeCommit pull request cannot be merged")
+
+// PullRequestStatus defines the possible statuses of an AWS CodeCommit pull
+// request. Merged pull requests are CLOSED, with the merge recorded on their
+// target.
+type PullRequestStatus string
+
+// Known PullRequestStatuses.
+const (
+	PullRequestStatusOpen   PullRequestStatus = PullRequestStatus(codecommittypes.PullRequestStatusEnumOpen)
+	PullRequestStatusClosed PullRequestStatus = PullRequestStatus(codecommittypes.PullRequestStatusEnumClosed)
+)
+
+// PullRequest is an AWS CodeCommit pull request, together with the approvals
+// it received and the evaluation of its approval rules.
+type PullRequest struct {
+	ID               string               // the system-generated ID of the pull request
+	Title            string               // the title of the pull request
+	Description      string               // the description of the pull request
+	AuthorARN        string               // the ARN of the user who created the pull request
+	Status           PullRequestStatus    // the status of the pull request
+	RevisionID       string               // the revision the approvals and evaluation belong to
+	CreationDate     time.Time            // the date the pull request was created
+	LastActivityDate time.Time            // the date of the most recent activity on the pull request
+	URL              string               // the URL of the pull request in the AWS console
+	Targets          []*PullRequestTarget // the branches the pull request merges
+	ApprovalRules    []*ApprovalRule      // the approval rules applied to the pull request
+	Approvals        []*Approval          // the current approvals of the pull request
+	Evaluation       *Evaluation          // the evaluation of the approval rules
+}
+
+// Target returns the first target of the pull request. Pull requests created
+// through Sourcegraph always have exactly one target.
+func (pr *PullRequest) Target() *PullRequestTarget {
+	if len(pr.Targets) == 0 {
+		return &PullRequestTarget{}
+	}
+	return pr.Targets[0]
+}
+
+// IsMerged reports whether all targets of the pull request have been merged.
+func (pr *PullRequest) IsMerged() bool {
+	if len(pr.Targets) == 0 {
+		return false
+	}
+	for _, t := range pr.Targets {
+		if !t.IsMerged {
+			return false
+		}
+	}
+	return true
+}
+
+// PullRequestTarget is a source and destination branch pair of a pull request.
+type PullRequestTarget struct {
+	RepositoryName       string // the name of the repository
+	SourceReference      string // the branch containing the changes, e.g. refs/heads/feature
+	SourceCommit         string // the tip of the source branch
+	DestinationReference string // the branch the changes are merged into
+	DestinationCommit    string // the tip of the destination branch
+	MergeBase            string // the merge base of source and destination
+	IsMerged             bool   // whether the target has been merged
+	MergeCommitID        string // the merge commit, if merged
+}
+
+// ApprovalRule is an approval rule that applies to a pull request.
+type ApprovalRule struct {
+	Name    string // the name of the approval rule
+	Content string // the JSON content of the approval rule
+}
+
+// ApprovalState is the state of an approval.
+type ApprovalState string
+
+// Known ApprovalStates. Approvals are stored with the state AWS CodeCommit
+// reports for them; only ApprovalStateApprove counts as an approval.
+const (
+	ApprovalStateApprove ApprovalState = ApprovalState(codecommittypes.ApprovalStateApprove)
+	ApprovalStateRevoke  ApprovalState = ApprovalState(codecommittypes.ApprovalStateRevoke)
+)
+
+// Approval is the approval of a pull request by a user.
+type Approval struct {
+	UserARN string        // the ARN of the approving user
+	State   ApprovalState // the state of the approval
+}
+
+// Evaluation is the result of evaluating the approval rules of a pull request.
+type Evaluation struct {
+	Approved          bool     // whether all approval rules are satisfied
+	Overridden        bool     // whether the approval rules have been overridden
+	RulesSatisfied    []string // the names of the satisfied approval rules
+	RulesNotSatisfied []string // the names of the approval rules not satisfied yet
+}
+
+// CreatePullRequestInput is the payload used to create a pull request.
+type CreatePullRequestInput struct {
+	RepositoryName       string
+	Title                string
+	Description          string
+	SourceReference      string
+	DestinationReference string
+}
+
+// CreatePullRequest creates a new pull request and returns it.
+func (c *Client) CreatePullRequest(ctx context.Context, in *CreatePullRequestInput) (*PullRequest, error) {
+	svc := codecommit.NewFromConfig(c.aws)
+	result, err := svc.CreatePullRequest(ctx, &codecommit.CreatePullRequestInput{
+		Title:       aws.String(in.Title),
+		Description: aws.String(in.Description),
+		Targets: []codecommittypes.Target{{
+			RepositoryName:       aws.String(in.RepositoryName),
+			SourceReference:      aws.String(in.SourceReference),
+			DestinationReference: aws.String(in.DestinationReference),
+		}},
+	})
+	if err != nil {
+		return nil, &wrappedError{err: err}
+	}
+	return c.loadPullRequest(ctx, svc, result.PullRequest)
+}
+
+// GetPullRequest gets the pull request with the given ID, including its
+// approvals and the evaluation of its approval rules.
+func (c *Client) GetPullRequest(ctx context.Context, id string) (*PullRequest, error) {
+	svc := codecommit.NewFromConfig(c.aws)
+	result, err := svc.GetPullRequest(ctx, &codecommit.GetPullRequestInput{PullRequestId: aws.String(id)})
+	if err != nil {
+		if errors.HasType(err, &codecommittypes.PullRequestDoesNotExistException{}) {
+			return nil, ErrPullRequestNotFound
+		}
+		return nil, &wrappedError{err: err}
+	}
+	return c.loadPullRequest(ctx, svc, result.PullRequest)
+}
+
+// FindOpenPullRequest returns the most recent open pull request that was
+// created in the given repository with the client's credentials, if it merges
+// sourceRef into destinationRef. Otherwise, ErrPullRequestNotFound is
+// returned.
+//
+// The AWS CodeCommit API only lists pull request IDs, and each listed pull
+// request has to be fetched to compare its branches. Only the most recent one
+// is fetched, which is the one left behind if creating a changeset was
+// interrupted after the pull request had been created.
+func (c *Client) FindOpenPullRequest(ctx context.Context, repoName, sourceRef, destinationRef string) (*PullRequest, error) {
+	identity, err := sts.NewFromConfig(c.aws).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, &wrappedError{err: errors.Wrap(err, "getting caller identity")}
+	}
+
+	svc := codecommit.NewFromConfig(c.aws)
+	list, err := svc.ListPullRequests(ctx, &codecommit.ListPullRequestsInput{
+		RepositoryName:    aws.String(repoName),
+		AuthorArn:         identity.Arn,
+		PullRequestStatus: codecommittypes.PullRequestStatusEnumOpen,
+		MaxResults:        aws.Int32(1),
+	})
+	if err != nil {
+		return nil, &wrappedError{err: err}
+	}
+	if len(list.PullRequestIds) == 0 {
+		return nil, ErrPullRequestNotFound
+	}
+
+	result, err := svc.GetPullRequest(ctx, &codecommit.GetPullRequestInput{PullRequestId: aws.String(list.PullRequestIds[0])})
+	if err != nil {
+		return nil, &wrappedError{err: err}
+	}
+	for _, t := range result.PullRequest.PullRequestTargets {
+		if aws.ToString(t.SourceReference) == sourceRef && aws.ToString(t.DestinationReference) == destinationRef {
+			return c.loadPullRequest(ctx, svc, result.PullRequest)
+		}
+	}
+	return nil, ErrPullRequestNotFound
+}
+
+// UpdatePullRequestTitle replaces the title of the pull request with the
+// given ID.
+func (c *Client) UpdatePullRequestTitle(ctx context.Context, id, title string) error {
+	svc := codecommit.NewFromConfig(c.aws)
+	_, err := svc.UpdatePullRequestTitle(ctx, &codecommit.UpdatePullRequestTitleInput{
+		PullRequestId: aws.String(id),
+		Title:         aws.String(title),
+	})
+	if err != nil {
+		return &wrappedError{err: err}
+	}
+	return nil
+}
+
+// UpdatePullRequestDescription replaces the description of the pull request
+// with the given ID.
+func (c *Client) UpdatePullRequestDescription(ctx context.Context, id, description string) error {
+	svc := codecommit.NewFromConfig(c.aws)
+	_, err := svc.UpdatePullRequestDescription(ctx, &codecommit.UpdatePullRequestDescriptionInput{
+		PullRequestId: aws.String(id),
+		Description:   aws.String(description),
+	})
+	if err != nil {
+		return &wrappedError{err: err}
+	}
+	return nil
+}
+
+// UpdatePullRequestStatus closes or reopens the pull request with the given
+// ID.
+func (c *Client) UpdatePullRequestStatus(ctx context.Context, id string, status PullRequestStatus) error {
+	svc := codecommit.NewFromConfig(c.aws)
+	_, err := svc.UpdatePullRequestStatus(ctx, &codecommit.UpdatePullRequestStatusInput{
+		PullRequestId:     aws.String(id),
+		PullRequestStatus: codecommittypes.PullRequestStatusEnum(status),
+	})
+	if err != nil {
+		return &wrappedError{err: err}
+	}
+	return nil
+}
+
+// MergePullRequest merges the pull request with the given ID, if the tip of
+// its source branch is still sourceCommit. If squash is true, a squash merge
+// is performed, otherwise a three-way merge. If the pull request cannot be
+// merged, an error wrapping ErrNotMergeable is returned.
+func (c *Client) MergePullRequest(ctx context.Context, repoName, id, sourceCommit string, squash bool) error {
+	svc := codecommit.NewFromConfig(c.aws)
+
+	var err error
+	if squash {
+		_, err = svc.MergePullRequestBySquash(ctx, &codecommit.MergePullRequestBySquashInput{
+			PullRequestId:  aws.String(id),
+			RepositoryName: aws.String(repoName),
+			SourceCommitId: aws.String(sourceCommit),
+		})
+	} else {
+		_, err = svc.MergePullRequestByThreeWay(ctx, &codecommit.MergePullRequestByThreeWayInput{
+			PullRequestId:  aws.String(id),
+			RepositoryName: aws.String(repoName),
+			SourceCommitId: aws.String(sourceCommit),
+		})
+	}
+	if err != nil {
+		if isNotMergeable(err) {
+			return errors.Wrap(ErrNotMergeable, err.Error())
+		}
+		return &wrappedError{err: err}
+	}
+	return nil
+}
+
+// CreatePullRequestComment posts a comment on the pull request with the given
+// ID. The comment refers to the changes between beforeCommit and afterCommit,
+// usually the destination and source commits of the pull request.
+func (c *Client) CreatePullRequestComment(ctx context.Context, repoName, id, beforeCommit, afterCommit, content string) error {
+	svc := codecommit.NewFromConfig(c.aws)
+	_, err := svc.PostCommentForPullRequest(ctx, &codecommit.PostCommentForPullRequestInput{
+		PullRequestId:  aws.String(id),
+		RepositoryName: aws.String(repoName),
+		BeforeCommitId: aws.String(beforeCommit),
+		AfterCommitId:  aws.String(afterCommit),
+		Content:        aws.String(content),
+	})
+	if err != nil {
+		return &wrappedError{err: err}
+	}
+	return nil
+}
+
+// loadPullRequest converts the given pull request and loads its approvals and
+// the evaluation of its approval rules.
+func (c *Client) loadPullRequest(ctx context.Context, svc *codecommit.Client, p *codecommittypes.PullRequest) (*PullRequest, error) {
+	pr := fromPullRequest(p, c.aws.Region)
+
+	approvals, err := svc.GetPullRequestApprovalStates(ctx, &codecommit.GetPullRequestApprovalStatesInput{
+		PullRequestId: aws.String(pr.ID),
+		RevisionId:    aws.String(pr.RevisionID),
+	})
+	if err != nil {
+		return nil, &wrappedError{err: errors.Wrap(err, "loading approval states")}
+	}
+	pr.Approvals = make([]*Approval, 0, len(approvals.Approvals))
+	for _, a := range approvals.Approvals {
+		pr.Approvals = append(pr.Approvals, &Approval{
+			UserARN: aws.ToString(a.UserArn),
+			State:   ApprovalState(a.ApprovalState),
+		})
+	}
+
+	evaluation, err := svc.EvaluatePullRequestApprovalRules(ctx, &codecommit.EvaluatePullRequestApprovalRulesInput{
+		PullRequestId: aws.String(pr.ID),
+		RevisionId:    aws.String(pr.RevisionID),
+	})
+	if err != nil {
+		return nil, &wrappedError{err: errors.Wrap(err, "evaluating approval rules")}
+	}
+	if e := evaluation.Evaluation; e != nil {
+		pr.Evaluation = &Evaluation{
+			Approved:          e.Approved,
+			Overridden:        e.Overridden,
+			RulesSatisfied:    e.ApprovalRulesSatisfied,
+			RulesNotSatisfied: e.ApprovalRulesNotSatisfied,
+		}
+	}
+
+	return pr, nil
+}
+
+func fromPullRequest(p *codecommittypes.PullRequest, region string) *PullRequest {
+	pr := PullRequest{
+		ID:          aws.ToString(p.PullRequestId),
+		Title:       aws.ToString(p.Title),
+		Description: aws.ToString(p.Description),
+		AuthorARN:   aws.ToString(p.AuthorArn),
+		Status:      PullRequestStatus(p.PullRequestStatus),
+		RevisionID:  aws.ToString(p.RevisionId),
+	}
+	if p.CreationDate != nil {
+		pr.CreationDate = *p.CreationDate
+	}
+	if p.LastActivityDate != nil {
+		pr.LastActivityDate = *p.LastActivityDate
+	}
+
+	for _, t := range p.PullRequestTargets {
+		target := &PullRequestTarget{
+			RepositoryName:       aws.ToString(t.RepositoryName),
+			SourceReference:      aws.ToString(t.SourceReference),
+			SourceCommit:         aws.ToString(t.SourceCommit),
+			DestinationReference: aws.ToString(t.DestinationReference),
+			DestinationCommit:    aws.ToString(t.DestinationCommit),
+			MergeBase:            aws.ToString(t.MergeBase),
+		}
+		if m := t.MergeMetadata; m != nil {
+			target.IsMerged = m.IsMerged
+			target.MergeCommitID = aws.ToString(m.MergeCommitId)
+		}
+		pr.Targets = append(pr.Targets, target)
+	}
+
+	for _, r := range p.ApprovalRules {
+		pr.ApprovalRules = append(pr.ApprovalRules, &ApprovalRule{
+			Name:    aws.ToString(r.ApprovalRuleName),
+			Content: aws.ToString(r.ApprovalRuleContent),
+		})
+	}
+
+	pr.URL = pullRequestURL(region, pr.Target().RepositoryName, pr.ID)
+	return &pr
+}
+
+// pullRequestURL returns the URL of the pull request in the AWS console.
+func pullRequestURL(region, repoName, id string) string {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     region + ".console.aws.amazon.com",
+		Path:     "/codesuite/codecommit/repositories/" + repoName + "/pull-requests/" + id + "/details",
+		RawQuery: url.Values{"region": {region}}.Encode(),
+	}
+	return u.String()
+}
+
+func isNotMergeable(err error) bool {
+	return errors.HasType(err, &codecommittypes.ManualMergeRequiredException{}) ||
+		errors.HasType(err, &codecommittypes.TipOfSourceReferenceIsDifferentException{}) ||
+		errors.HasType(err, &codecommittypes.PullRequestApprovalRulesNotSatisfiedException{}) ||
+		errors.HasType(err, &codecommittypes.PullRequestAlreadyClosedException{})
+}
@@ -0,0 +1,81 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"net/url"
+
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+)
+
+// Email is an email address of a Bitbucket Cloud account.
+type Email struct {
+	Email       string `json:"email"`
+	IsPrimary   bool   `json:"is_primary"`
+	IsConfirmed bool   `json:"is_confirmed"`
+}
+
+// CurrentUserEmails returns the email addresses of the account belonging to
+// the credentials the client authenticates with.
+func (c *Client) CurrentUserEmails(ctx context.Context) ([]*Email, error) {
+	var (
+		emails []*Email
+		token  *PageToken
+		err    error
+	)
+	for {
+		var page []*Email
+		if token.HasMore() {
+			token, err = c.reqPage(ctx, token.Next, &page)
+		} else {
+			token, err = c.page(ctx, "/2.0/user/emails", nil, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, page...)
+		if !token.HasMore() {
+			return emails, nil
+		}
+	}
+}
+
+// OAuthEndpoint returns the OAuth 2.0 endpoint of the Bitbucket Cloud at the
+// given base URL, such as https://bitbucket.org.
+func OAuthEndpoint(baseURL *url.URL) oauth2.Endpoint {
+	return oauth2.Endpoint{
+		AuthURL:  baseURL.ResolveReference(&url.URL{Path: "/site/oauth2/authorize"}).String(),
+		TokenURL: baseURL.ResolveReference(&url.URL{Path: "/site/oauth2/access_token"}).String(),
+	}
+}
+
+// GetExternalAccountData returns the deserialized account and token from the
+// external account data JSON blob in a typesafe way.
+func GetExternalAccountData(data *extsvc.AccountData) (acct *Account, tok *oauth2.Token, err error) {
+	var (
+		a Account
+		t oauth2.Token
+	)
+
+	if data.Data != nil {
+		if err := data.GetAccountData(&a); err != nil {
+			return nil, nil, err
+		}
+		acct = &a
+	}
+	if data.AuthData != nil {
+		if err := data.GetAuthData(&t); err != nil {
+			return nil, nil, err
+		}
+		tok = &t
+	}
+	return acct, tok, nil
+}
+
+// SetExternalAccountData sets the account and token into the external account
+// data blob.
+func SetExternalAccountData(data *extsvc.AccountData, acct *Account, token *oauth2.Token) {
+	data.SetAccountData(acct)
+	data.SetAuthData(token)
+}
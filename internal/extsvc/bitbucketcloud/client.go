@@ -1,6 +1,7 @@
 package bitbucketcloud
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/opentracing-contrib/go-stdlib/nethttp"
 	"golang.org/x/time/rate"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
@@ -50,6 +52,10 @@ type Client struct {
 	// The username and app password credentials for accessing the server.
 	Username, AppPassword string
 
+	// Token is an OAuth access token. If set, it is used instead of the
+	// username and app password.
+	Token string
+
 	// RateLimit is the self-imposed rate limiter (since Bitbucket does not have a concept
 	// of rate limiting in HTTP response headers).
 	RateLimit *rate.Limiter
@@ -86,6 +92,36 @@ func NewClient(apiURL *url.URL, httpClient httpcli.Doer) *Client {
 	}
 }
 
+// WithAuthenticator returns a copy of the Client that authenticates with the
+// given authenticator. Bitbucket Cloud supports username and app password
+// credentials, and OAuth access tokens of users that signed in through a
+// Bitbucket Cloud OAuth consumer.
+func (c *Client) WithAuthenticator(a auth.Authenticator) (*Client, error) {
+	cc := *c
+	cc.Username, cc.AppPassword, cc.Token = "", "", ""
+	switch a := a.(type) {
+	case *auth.BasicAuth:
+		cc.Username, cc.AppPassword = a.Username, a.Password
+	case *auth.BasicAuthWithSSH:
+		cc.Username, cc.AppPassword = a.Username, a.Password
+	case *auth.OAuthBearerToken:
+		cc.Token = a.Token
+	default:
+		return nil, errors.Errorf("authenticator type unsupported for Bitbucket Cloud clients: %T", a)
+	}
+	return &cc, nil
+}
+
+// CurrentUser returns the account belonging to the credentials the client
+// authenticates with.
+func (c *Client) CurrentUser(ctx context.Context) (*Account, error) {
+	var user Account
+	if err := c.send(ctx, "GET", "/2.0/user", nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
 // Repos returns a list of repositories that are fetched and populated based on given account
 // name and pagination criteria. If the account requested is a team, results will be filtered
 // down to the ones that the app password's user has access to.
@@ -144,6 +180,23 @@ func (c *Client) reqPage(ctx context.Context, url string, results interface{}) (
 	return &next, nil
 }
 
+func (c *Client) send(ctx context.Context, method, path string, payload, result interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		bs, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(bs)
+	}
+
+	req, err := http.NewRequest(method, path, body)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, req, result)
+}
+
 func (c *Client) do(ctx context.Context, req *http.Request, result interface{}) error {
 	req.URL = c.URL.ResolveReference(req.URL)
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
@@ -195,6 +248,10 @@ func (c *Client) do(ctx context.Context, req *http.Request, result interface{})
 }
 
 func (c *Client) authenticate(req *http.Request) error {
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+		return nil
+	}
 	req.SetBasicAuth(c.Username, c.AppPassword)
 	return nil
 }
@@ -277,3 +334,7 @@ func (e *httpError) Unauthorized() bool {
 func (e *httpError) NotFound() bool {
 	return e.StatusCode == http.StatusNotFound
 }
+
+func (e *httpError) BadRequest() bool {
+	return e.StatusCode == http.StatusBadRequest
+}
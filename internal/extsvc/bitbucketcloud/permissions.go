@@ -0,0 +1,79 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Permission is the level of access that a user has to a repository.
+type Permission string
+
+// Known Permissions.
+const (
+	PermissionRead  Permission = "read"
+	PermissionWrite Permission = "write"
+	PermissionAdmin Permission = "admin"
+)
+
+// RepoPermission is the effective permission of a user on a repository.
+type RepoPermission struct {
+	Permission Permission `json:"permission"`
+	User       *Account   `json:"user"`
+	Repository *Repo      `json:"repository"`
+}
+
+// CurrentUserRepoPermissions returns the effective permissions of the user the
+// client authenticates as on all the repositories they can access, which
+// includes repositories they can access through workspace and group
+// membership. Public repositories the user has no explicit permission on are
+// not included.
+//
+// If the argument pageToken.Next is not empty, it will be used directly as the
+// URL to make the request.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-permissions-repositories-get
+func (c *Client) CurrentUserRepoPermissions(ctx context.Context, pageToken *PageToken) ([]*RepoPermission, *PageToken, error) {
+	var perms []*RepoPermission
+	var next *PageToken
+	var err error
+	if pageToken.HasMore() {
+		next, err = c.reqPage(ctx, pageToken.Next, &perms)
+	} else {
+		next, err = c.page(ctx, "/2.0/user/permissions/repositories", nil, pageToken, &perms)
+	}
+	return perms, next, err
+}
+
+// RepoUserPermissions returns the effective permissions of all users that can
+// access the given repository of the given workspace. The user the client
+// authenticates as must be an administrator of the workspace.
+//
+// If the argument pageToken.Next is not empty, it will be used directly as the
+// URL to make the request.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-repositories-repo-slug-get
+func (c *Client) RepoUserPermissions(ctx context.Context, pageToken *PageToken, workspace, repoSlug string) ([]*RepoPermission, *PageToken, error) {
+	var perms []*RepoPermission
+	var next *PageToken
+	var err error
+	if pageToken.HasMore() {
+		next, err = c.reqPage(ctx, pageToken.Next, &perms)
+	} else {
+		path := fmt.Sprintf("/2.0/workspaces/%s/permissions/repositories/%s", url.PathEscape(workspace), url.PathEscape(repoSlug))
+		next, err = c.page(ctx, path, nil, pageToken, &perms)
+	}
+	return perms, next, err
+}
+
+// RepoByUUID returns the repository with the given UUID, which includes the
+// surrounding braces.
+func (c *Client) RepoByUUID(ctx context.Context, uuid string) (*Repo, error) {
+	var repo Repo
+	// Bitbucket Cloud accepts "{}" in place of the workspace when a repository
+	// is identified by its UUID.
+	if err := c.send(ctx, "GET", "/2.0/repositories/%7B%7D/"+url.PathEscape(uuid), nil, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
@@ -0,0 +1,131 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+)
+
+func TestClient_CurrentUserRepoPermissions(t *testing.T) {
+	var cli *Client
+	cli = newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/user/permissions/repositories" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got, want := r.Header.Get("Authorization"), "Bearer token"; got != want {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"values": [{"permission": "admin", "repository": {"uuid": "{b}", "full_name": "sglocal/b"}}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"values": [{"permission": "read", "repository": {"uuid": "{a}", "full_name": "sglocal/a"}}], "next": "%s/2.0/user/permissions/repositories?page=2"}`, cli.URL)
+	})
+	cli, err := cli.WithAuthenticator(&auth.OAuthBearerToken{Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	perms, next, err := cli.CurrentUserRepoPermissions(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.HasMore() {
+		t.Fatal("expected another page")
+	}
+	more, next, err := cli.CurrentUserRepoPermissions(ctx, next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.HasMore() {
+		t.Fatal("unexpected next page")
+	}
+
+	want := []*RepoPermission{
+		{Permission: PermissionRead, Repository: &Repo{UUID: "{a}", FullName: "sglocal/a"}},
+		{Permission: PermissionAdmin, Repository: &Repo{UUID: "{b}", FullName: "sglocal/b"}},
+	}
+	if diff := cmp.Diff(want, append(perms, more...)); diff != "" {
+		t.Fatalf("unexpected permissions (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_RepoUserPermissions(t *testing.T) {
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/workspaces/sglocal/permissions/repositories/mux" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"values": [{"permission": "write", "user": {"uuid": "{u}", "nickname": "alice"}}]}`)
+	})
+	cli.Username, cli.AppPassword = "admin", "secret"
+
+	perms, next, err := cli.RepoUserPermissions(context.Background(), nil, "sglocal", "mux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.HasMore() {
+		t.Fatal("unexpected next page")
+	}
+	want := []*RepoPermission{{Permission: PermissionWrite, User: &Account{UUID: "{u}", Nickname: "alice"}}}
+	if diff := cmp.Diff(want, perms); diff != "" {
+		t.Fatalf("unexpected permissions (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_RepoByUUID(t *testing.T) {
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/repositories/{}/{e1e75436}" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"uuid": "{e1e75436}", "slug": "mux", "full_name": "sglocal/mux"}`)
+	})
+
+	repo, err := cli.RepoByUUID(context.Background(), "{e1e75436}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&Repo{UUID: "{e1e75436}", Slug: "mux", FullName: "sglocal/mux"}, repo); diff != "" {
+		t.Fatalf("unexpected repo (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_CurrentUserEmails(t *testing.T) {
+	var cli *Client
+	cli = newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/user/emails" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"values": [{"email": "alice@example.com", "is_primary": true, "is_confirmed": true}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"values": [{"email": "old@example.com"}], "next": "%s/2.0/user/emails?page=2"}`, cli.URL)
+	})
+
+	emails, err := cli.CurrentUserEmails(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Email{
+		{Email: "old@example.com"},
+		{Email: "alice@example.com", IsPrimary: true, IsConfirmed: true},
+	}
+	if diff := cmp.Diff(want, emails); diff != "" {
+		t.Fatalf("unexpected emails (-want +got):\n%s", diff)
+	}
+}
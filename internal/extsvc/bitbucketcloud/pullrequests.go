@@ -0,0 +1,267 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+// ErrPullRequestNotFound is returned by LoadPullRequest when the pull request
+// has been deleted on the upstream code host, or when the credential used
+// cannot view the repository.
+var ErrPullRequestNotFound = errors.New("pull request not found")
+
+// ErrNotMergeable is returned by MergePullRequest when the pull request cannot
+// be merged, for example because of conflicts or unmet merge checks.
+var ErrNotMergeable = errors.New("pull request cannot be merged")
+
+// PullRequestState defines the possible states of a Bitbucket Cloud pull
+// request.
+type PullRequestState string
+
+// Known PullRequestStates.
+const (
+	PullRequestStateOpen       PullRequestState = "OPEN"
+	PullRequestStateMerged     PullRequestState = "MERGED"
+	PullRequestStateDeclined   PullRequestState = "DECLINED"
+	PullRequestStateSuperseded PullRequestState = "SUPERSEDED"
+)
+
+// PullRequest is a Bitbucket Cloud pull request.
+type PullRequest struct {
+	ID                int                 `json:"id"`
+	Title             string              `json:"title"`
+	Description       string              `json:"description"`
+	State             PullRequestState    `json:"state"`
+	Author            Account             `json:"author"`
+	Source            PullRequestEndpoint `json:"source"`
+	Destination       PullRequestEndpoint `json:"destination"`
+	Participants      []*Participant      `json:"participants"`
+	CloseSourceBranch bool                `json:"close_source_branch"`
+	CreatedOn         time.Time           `json:"created_on"`
+	UpdatedOn         time.Time           `json:"updated_on"`
+	Links             PullRequestLinks    `json:"links"`
+
+	Statuses []*PullRequestStatus `json:"statuses,omitempty"`
+}
+
+// PullRequestLinks are the links of a pull request.
+type PullRequestLinks struct {
+	HTML Link `json:"html"`
+}
+
+// PullRequestEndpoint is the source or destination of a pull request.
+type PullRequestEndpoint struct {
+	Branch     PullRequestBranch  `json:"branch"`
+	Commit     *PullRequestCommit `json:"commit,omitempty"`
+	Repository *Repo              `json:"repository,omitempty"`
+}
+
+// PullRequestBranch is a branch referenced by a pull request endpoint.
+type PullRequestBranch struct {
+	Name string `json:"name"`
+}
+
+// PullRequestCommit is a commit referenced by a pull request endpoint. The
+// hash is usually abbreviated.
+type PullRequestCommit struct {
+	Hash string `json:"hash"`
+}
+
+// Account is a Bitbucket Cloud user or team.
+type Account struct {
+	UUID        string `json:"uuid"`
+	AccountID   string `json:"account_id"`
+	Nickname    string `json:"nickname"`
+	DisplayName string `json:"display_name"`
+}
+
+// ParticipantRole defines the role of a participant in a pull request.
+type ParticipantRole string
+
+// Known ParticipantRoles.
+const (
+	ParticipantRoleParticipant ParticipantRole = "PARTICIPANT"
+	ParticipantRoleReviewer    ParticipantRole = "REVIEWER"
+)
+
+// ParticipantState defines the review state of a participant.
+type ParticipantState string
+
+// Known ParticipantStates. Participants that haven't reviewed the pull request
+// have an empty state.
+const (
+	ParticipantStateApproved         ParticipantState = "approved"
+	ParticipantStateChangesRequested ParticipantState = "changes_requested"
+)
+
+// Participant is a user that participated in a pull request, either as a
+// reviewer or by commenting.
+type Participant struct {
+	User           Account          `json:"user"`
+	Role           ParticipantRole  `json:"role"`
+	Approved       bool             `json:"approved"`
+	State          ParticipantState `json:"state"`
+	ParticipatedOn time.Time        `json:"participated_on"`
+}
+
+// Key is a unique key identifying this participant in the context of its
+// pull request.
+func (p *Participant) Key() string { return p.User.UUID }
+
+// PullRequestStatusState defines the state of a commit status.
+type PullRequestStatusState string
+
+// Known PullRequestStatusStates.
+const (
+	PullRequestStatusStateSuccessful PullRequestStatusState = "SUCCESSFUL"
+	PullRequestStatusStateFailed     PullRequestStatusState = "FAILED"
+	PullRequestStatusStateInProgress PullRequestStatusState = "INPROGRESS"
+	PullRequestStatusStateStopped    PullRequestStatusState = "STOPPED"
+)
+
+// PullRequestStatus is a build status reported on the head commit of a pull
+// request.
+type PullRequestStatus struct {
+	UUID        string                 `json:"uuid"`
+	Key         string                 `json:"key"`
+	Name        string                 `json:"name"`
+	URL         string                 `json:"url"`
+	State       PullRequestStatusState `json:"state"`
+	Description string                 `json:"description"`
+	CreatedOn   time.Time              `json:"created_on"`
+	UpdatedOn   time.Time              `json:"updated_on"`
+}
+
+// PullRequestInput is the payload used to create or update a pull request.
+type PullRequestInput struct {
+	Title             string              `json:"title"`
+	Description       string              `json:"description"`
+	Source            PullRequestEndpoint `json:"source"`
+	Destination       PullRequestEndpoint `json:"destination"`
+	CloseSourceBranch bool                `json:"close_source_branch"`
+}
+
+// CreatePullRequest opens a new pull request in the repository with the given
+// full name (e.g. "workspace/slug"). If an open pull request for the same
+// source and destination branches already exists, Bitbucket Cloud updates and
+// returns it instead.
+func (c *Client) CreatePullRequest(ctx context.Context, repoFullName string, in *PullRequestInput) (*PullRequest, error) {
+	if in.Source.Branch.Name == "" {
+		return nil, errors.New("source branch empty")
+	}
+	if in.Destination.Branch.Name == "" {
+		return nil, errors.New("destination branch empty")
+	}
+
+	var pr PullRequest
+	if err := c.send(ctx, "POST", pullRequestsPath(repoFullName), in, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// LoadPullRequest loads the pull request with the given ID.
+func (c *Client) LoadPullRequest(ctx context.Context, repoFullName string, id int) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, "GET", pullRequestPath(repoFullName, id, ""), nil, &pr); err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, ErrPullRequestNotFound
+		}
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// UpdatePullRequest updates the title, description and destination branch of
+// the pull request with the given ID.
+func (c *Client) UpdatePullRequest(ctx context.Context, repoFullName string, id int, in *PullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, "PUT", pullRequestPath(repoFullName, id, ""), in, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// DeclinePullRequest declines the pull request with the given ID. Declined
+// pull requests cannot be reopened on Bitbucket Cloud.
+func (c *Client) DeclinePullRequest(ctx context.Context, repoFullName string, id int) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, "POST", pullRequestPath(repoFullName, id, "/decline"), nil, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// MergePullRequest merges the pull request with the given ID. If squash is
+// true, a squash merge is performed. If the pull request is not in a
+// mergeable state, ErrNotMergeable is returned.
+func (c *Client) MergePullRequest(ctx context.Context, repoFullName string, id int, squash bool) (*PullRequest, error) {
+	strategy := "merge_commit"
+	if squash {
+		strategy = "squash"
+	}
+	in := struct {
+		MergeStrategy string `json:"merge_strategy"`
+	}{MergeStrategy: strategy}
+
+	var pr PullRequest
+	if err := c.send(ctx, "POST", pullRequestPath(repoFullName, id, "/merge"), &in, &pr); err != nil {
+		if errcode.IsBadRequest(err) {
+			return nil, errors.Wrap(ErrNotMergeable, err.Error())
+		}
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// CreatePullRequestComment posts a comment with the given markdown text on
+// the pull request with the given ID.
+func (c *Client) CreatePullRequestComment(ctx context.Context, repoFullName string, id int, text string) error {
+	in := struct {
+		Content struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+	}{}
+	in.Content.Raw = text
+
+	return c.send(ctx, "POST", pullRequestPath(repoFullName, id, "/comments"), &in, nil)
+}
+
+// PullRequestStatuses returns all build statuses reported on the head commit
+// of the pull request with the given ID.
+func (c *Client) PullRequestStatuses(ctx context.Context, repoFullName string, id int) ([]*PullRequestStatus, error) {
+	var (
+		statuses []*PullRequestStatus
+		next     *PageToken
+		err      error
+	)
+	for {
+		var page []*PullRequestStatus
+		if next.HasMore() {
+			next, err = c.reqPage(ctx, next.Next, &page)
+		} else {
+			next, err = c.page(ctx, pullRequestPath(repoFullName, id, "/statuses"), nil, &PageToken{Pagelen: 100}, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, page...)
+		if !next.HasMore() {
+			return statuses, nil
+		}
+	}
+}
+
+func pullRequestsPath(repoFullName string) string {
+	return fmt.Sprintf("/2.0/repositories/%s/pullrequests", repoFullName)
+}
+
+func pullRequestPath(repoFullName string, id int, suffix string) string {
+	return pullRequestsPath(repoFullName) + "/" + strconv.Itoa(id) + suffix
+}
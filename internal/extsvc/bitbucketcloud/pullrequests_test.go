@@ -0,0 +1,161 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+)
+
+func newPullRequestTestClient(t *testing.T, h http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(u, nil)
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	var body PullRequestInput
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/2.0/repositories/sglocal/mux/pullrequests" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 7, "state": "OPEN", "title": "Add README"}`))
+	})
+
+	in := &PullRequestInput{Title: "Add README"}
+	in.Source.Branch.Name = "add-readme"
+	in.Destination.Branch.Name = "master"
+
+	pr, err := cli.CreatePullRequest(context.Background(), "sglocal/mux", in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.ID != 7 || pr.State != PullRequestStateOpen {
+		t.Errorf("unexpected pull request: %+v", pr)
+	}
+	if body.Source.Branch.Name != "add-readme" || body.Destination.Branch.Name != "master" {
+		t.Errorf("unexpected request body: %+v", body)
+	}
+
+	if _, err := cli.CreatePullRequest(context.Background(), "sglocal/mux", &PullRequestInput{}); err == nil {
+		t.Error("expected error for empty branches")
+	}
+}
+
+func TestClient_LoadPullRequest_NotFound(t *testing.T) {
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := cli.LoadPullRequest(context.Background(), "sglocal/mux", 1)
+	if err != ErrPullRequestNotFound {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_MergePullRequest(t *testing.T) {
+	var strategy string
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			MergeStrategy string `json:"merge_strategy"`
+		}
+		json.NewDecoder(r.Body).Decode(&in)
+		strategy = in.MergeStrategy
+
+		if r.URL.Path == "/2.0/repositories/sglocal/mux/pullrequests/2/merge" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"message": "merge conflicts"}}`))
+			return
+		}
+		w.Write([]byte(`{"id": 1, "state": "MERGED"}`))
+	})
+
+	pr, err := cli.MergePullRequest(context.Background(), "sglocal/mux", 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.State != PullRequestStateMerged {
+		t.Errorf("unexpected state: %q", pr.State)
+	}
+	if strategy != "squash" {
+		t.Errorf("unexpected merge strategy: %q", strategy)
+	}
+
+	_, err = cli.MergePullRequest(context.Background(), "sglocal/mux", 2, false)
+	if !errors.Is(err, ErrNotMergeable) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if strategy != "merge_commit" {
+		t.Errorf("unexpected merge strategy: %q", strategy)
+	}
+}
+
+func TestClient_PullRequestStatuses(t *testing.T) {
+	var srvURL string
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"values": [{"uuid": "{b}", "state": "FAILED"}]}`))
+			return
+		}
+		fmt.Fprintf(w, `{"values": [{"uuid": "{a}", "state": "SUCCESSFUL"}], "next": "%s%s?page=2"}`, srvURL, r.URL.Path)
+	})
+	srvURL = cli.URL.String()
+
+	statuses, err := cli.PullRequestStatuses(context.Background(), "sglocal/mux", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("unexpected number of statuses: %d", len(statuses))
+	}
+	if statuses[0].UUID != "{a}" || statuses[1].State != PullRequestStatusStateFailed {
+		t.Errorf("unexpected statuses: %+v, %+v", statuses[0], statuses[1])
+	}
+}
+
+func TestClient_WithAuthenticator(t *testing.T) {
+	cli := NewClient(&url.URL{Scheme: "https", Host: "api.bitbucket.org"}, nil)
+
+	other, err := cli.WithAuthenticator(&auth.BasicAuth{Username: "user", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Username != "user" || other.AppPassword != "secret" {
+		t.Errorf("credentials not set: %q/%q", other.Username, other.AppPassword)
+	}
+	if cli.Username != "" || cli.AppPassword != "" {
+		t.Error("original client was modified")
+	}
+
+	bearer, err := other.WithAuthenticator(&auth.OAuthBearerToken{Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bearer.Token != "token" || bearer.Username != "" || bearer.AppPassword != "" {
+		t.Errorf("unexpected credentials: %q/%q/%q", bearer.Username, bearer.AppPassword, bearer.Token)
+	}
+
+	if _, err := cli.WithAuthenticator(&auth.OAuthClient{}); err == nil {
+		t.Error("expected error for unsupported authenticator")
+	}
+}
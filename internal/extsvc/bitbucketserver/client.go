@@ -505,6 +505,15 @@ type UpdatePullRequestInput struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	ToRef       Ref    `json:"toRef"`
+
+	// Reviewers replaces the reviewers of the pull request, if set.
+	Reviewers []ReviewerInput `json:"reviewers,omitempty"`
+}
+
+// ReviewerInput identifies a reviewer of a pull request by the name of the
+// user.
+type ReviewerInput struct {
+	User User `json:"user"`
 }
 
 func (c *Client) UpdatePullRequest(ctx context.Context, in *UpdatePullRequestInput) (*PullRequest, error) {
@@ -1008,6 +1008,235 @@ func (c *V4Client) MergePullRequest(ctx context.Context, pr *PullRequest, squash
 	return nil
 }
 
+const requestReviewsMutation = `
+mutation RequestReviews($input: RequestReviewsInput!) {
+  requestReviews(input: $input) {
+    pullRequest {
+      ...pr
+    }
+  }
+}
+`
+
+// RequestReviews requests reviews of the PullRequest on GitHub from the users
+// with the given logins and the teams with the given names, which are of the
+// form `org/team-slug`. Existing review requests are kept.
+func (c *V4Client) RequestReviews(ctx context.Context, pr *PullRequest, logins, teams []string) error {
+	userIDs, teamIDs, err := c.reviewerIDs(ctx, logins, teams)
+	if err != nil {
+		return err
+	}
+
+	version := c.determineGitHubVersion(ctx)
+	prFragment, err := pullRequestFragments(version)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		RequestReviews struct {
+			PullRequest struct {
+				PullRequest
+				Participants  struct{ Nodes []Actor }
+				TimelineItems TimelineItemConnection
+			} `json:"pullRequest"`
+		} `json:"requestReviews"`
+	}
+
+	input := map[string]interface{}{"input": struct {
+		PullRequestID string   `json:"pullRequestId"`
+		UserIDs       []string `json:"userIds"`
+		TeamIDs       []string `json:"teamIds"`
+		Union         bool     `json:"union"`
+	}{
+		PullRequestID: pr.ID,
+		UserIDs:       userIDs,
+		TeamIDs:       teamIDs,
+		Union:         true,
+	}}
+	if err := c.requestGraphQL(ctx, prFragment+"\n"+requestReviewsMutation, input, &result); err != nil {
+		return err
+	}
+
+	ti := result.RequestReviews.PullRequest.TimelineItems
+	*pr = result.RequestReviews.PullRequest.PullRequest
+	pr.TimelineItems = ti.Nodes
+	pr.Participants = result.RequestReviews.PullRequest.Participants.Nodes
+
+	items, err := c.loadRemainingTimelineItems(ctx, pr.ID, ti.PageInfo)
+	if err != nil {
+		return err
+	}
+	pr.TimelineItems = append(pr.TimelineItems, items...)
+	return nil
+}
+
+// reviewerIDs resolves the logins of users and the `org/team-slug` names of
+// teams to their GraphQL node IDs with a single query.
+func (c *V4Client) reviewerIDs(ctx context.Context, logins, teams []string) (userIDs, teamIDs []string, err error) {
+	if len(logins) == 0 && len(teams) == 0 {
+		return []string{}, []string{}, nil
+	}
+
+	var params, fields []string
+	vars := map[string]interface{}{}
+	for i, login := range logins {
+		params = append(params, fmt.Sprintf("$user%d: String!", i))
+		fields = append(fields, fmt.Sprintf("user%d: user(login: $user%d) { id }", i, i))
+		vars[fmt.Sprintf("user%d", i)] = login
+	}
+	for i, team := range teams {
+		org, slug, ok := splitTeamName(team)
+		if !ok {
+			return nil, nil, errors.Errorf("team %q must be of the form org/team-slug", team)
+		}
+		params = append(params, fmt.Sprintf("$org%d: String!", i), fmt.Sprintf("$team%d: String!", i))
+		fields = append(fields, fmt.Sprintf("org%d: organization(login: $org%d) { team(slug: $team%d) { id } }", i, i, i))
+		vars[fmt.Sprintf("org%d", i)] = org
+		vars[fmt.Sprintf("team%d", i)] = slug
+	}
+	q := fmt.Sprintf("query ReviewerIDs(%s) {\n%s\n}", strings.Join(params, ", "), strings.Join(fields, "\n"))
+
+	var result map[string]*struct {
+		ID   string
+		Team *struct{ ID string }
+	}
+	if err := c.requestGraphQL(ctx, q, vars, &result); err != nil {
+		return nil, nil, err
+	}
+
+	userIDs = make([]string, 0, len(logins))
+	for i, login := range logins {
+		user := result[fmt.Sprintf("user%d", i)]
+		if user == nil {
+			return nil, nil, errors.Errorf("GitHub user %q not found", login)
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+	teamIDs = make([]string, 0, len(teams))
+	for i, team := range teams {
+		org := result[fmt.Sprintf("org%d", i)]
+		if org == nil || org.Team == nil {
+			return nil, nil, errors.Errorf("GitHub team %q not found", team)
+		}
+		teamIDs = append(teamIDs, org.Team.ID)
+	}
+	return userIDs, teamIDs, nil
+}
+
+func splitTeamName(team string) (org, slug string, ok bool) {
+	i := strings.Index(team, "/")
+	if i <= 0 || i == len(team)-1 {
+		return "", "", false
+	}
+	return team[:i], team[i+1:], true
+}
+
+const addLabelsToPullRequestMutation = `
+mutation AddLabelsToPullRequest($input: AddLabelsToLabelableInput!) {
+  addLabelsToLabelable(input: $input) {
+    labelable {
+      ... on PullRequest {
+        ...pr
+      }
+    }
+  }
+}
+`
+
+// AddLabelsToPullRequest adds the labels with the given names to the
+// PullRequest on GitHub. The labels must exist in the repository of the pull
+// request.
+func (c *V4Client) AddLabelsToPullRequest(ctx context.Context, pr *PullRequest, labels []string) error {
+	labelIDs, err := c.labelIDs(ctx, pr, labels)
+	if err != nil {
+		return err
+	}
+
+	version := c.determineGitHubVersion(ctx)
+	prFragment, err := pullRequestFragments(version)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		AddLabelsToLabelable struct {
+			Labelable struct {
+				PullRequest
+				Participants  struct{ Nodes []Actor }
+				TimelineItems TimelineItemConnection
+			} `json:"labelable"`
+		} `json:"addLabelsToLabelable"`
+	}
+
+	input := map[string]interface{}{"input": struct {
+		LabelableID string   `json:"labelableId"`
+		LabelIDs    []string `json:"labelIds"`
+	}{
+		LabelableID: pr.ID,
+		LabelIDs:    labelIDs,
+	}}
+	if err := c.requestGraphQL(ctx, prFragment+"\n"+addLabelsToPullRequestMutation, input, &result); err != nil {
+		return err
+	}
+
+	ti := result.AddLabelsToLabelable.Labelable.TimelineItems
+	*pr = result.AddLabelsToLabelable.Labelable.PullRequest
+	pr.TimelineItems = ti.Nodes
+	pr.Participants = result.AddLabelsToLabelable.Labelable.Participants.Nodes
+
+	items, err := c.loadRemainingTimelineItems(ctx, pr.ID, ti.PageInfo)
+	if err != nil {
+		return err
+	}
+	pr.TimelineItems = append(pr.TimelineItems, items...)
+	return nil
+}
+
+// labelIDs resolves the names of labels in the repository of the pull request
+// to their GraphQL node IDs with a single query.
+func (c *V4Client) labelIDs(ctx context.Context, pr *PullRequest, labels []string) ([]string, error) {
+	params := []string{"$pr: ID!"}
+	fields := make([]string, 0, len(labels))
+	vars := map[string]interface{}{"pr": pr.ID}
+	for i, label := range labels {
+		params = append(params, fmt.Sprintf("$label%d: String!", i))
+		fields = append(fields, fmt.Sprintf("label%d: label(name: $label%d) { id }", i, i))
+		vars[fmt.Sprintf("label%d", i)] = label
+	}
+	q := fmt.Sprintf(`query LabelIDs(%s) {
+  node(id: $pr) {
+    ... on PullRequest {
+      repository {
+        %s
+      }
+    }
+  }
+}`, strings.Join(params, ", "), strings.Join(fields, "\n"))
+
+	var result struct {
+		Node *struct {
+			Repository map[string]*struct{ ID string }
+		}
+	}
+	if err := c.requestGraphQL(ctx, q, vars, &result); err != nil {
+		return nil, err
+	}
+	if result.Node == nil {
+		return nil, errors.Errorf("GitHub pull request %q not found", pr.ID)
+	}
+
+	ids := make([]string, 0, len(labels))
+	for i, label := range labels {
+		l := result.Node.Repository[fmt.Sprintf("label%d", i)]
+		if l == nil {
+			return nil, errors.Errorf("label %q does not exist in the repository", label)
+		}
+		ids = append(ids, l.ID)
+	}
+	return ids, nil
+}
+
 func (c *V4Client) loadRemainingTimelineItems(ctx context.Context, prID string, pageInfo PageInfo) (items []TimelineItem, err error) {
 	version := c.determineGitHubVersion(ctx)
 	timelineItemTypes, err := timelineItemTypes(version)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -11,9 +13,11 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/httptestutil"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
 )
 
@@ -476,6 +480,121 @@ func TestMergePullRequest(t *testing.T) {
 	})
 }
 
+// graphQLResponsesDoer responds to the GraphQL requests it receives with the
+// given response bodies in order, and records the variables of the requests.
+type graphQLResponsesDoer struct {
+	responses []string
+	variables []map[string]interface{}
+}
+
+func (d *graphQLResponsesDoer) Do(req *http.Request) (*http.Response, error) {
+	var body struct {
+		Variables map[string]interface{}
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	d.variables = append(d.variables, body.Variables)
+
+	if len(d.responses) == 0 {
+		return nil, errors.New("unexpected request")
+	}
+	resp := d.responses[0]
+	d.responses = d.responses[1:]
+	return &http.Response{
+		Request:    req,
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(resp)),
+	}, nil
+}
+
+func TestRequestReviews(t *testing.T) {
+	rcache.SetupForTest(t)
+
+	doer := &graphQLResponsesDoer{responses: []string{
+		`{"data": {"user0": {"id": "U_alice"}, "org0": {"team": {"id": "T_core"}}}}`,
+		`{"data": {"requestReviews": {"pullRequest": {"id": "PR_1", "number": 1, "timelineItems": {"pageInfo": {"hasNextPage": false}}}}}}`,
+	}}
+	uri, _ := url.Parse("https://api.github.com")
+	cli := NewV4Client(uri, nil, doer)
+
+	pr := &PullRequest{ID: "PR_1"}
+	if err := cli.RequestReviews(context.Background(), pr, []string{"alice"}, []string{"sourcegraph/core"}); err != nil {
+		t.Fatal(err)
+	}
+	if pr.Number != 1 {
+		t.Fatalf("pull request not updated: %+v", pr)
+	}
+
+	wantVariables := []map[string]interface{}{
+		{"user0": "alice", "org0": "sourcegraph", "team0": "core"},
+		{"input": map[string]interface{}{
+			"pullRequestId": "PR_1",
+			"userIds":       []interface{}{"U_alice"},
+			"teamIds":       []interface{}{"T_core"},
+			"union":         true,
+		}},
+	}
+	if diff := cmp.Diff(wantVariables, doer.variables); diff != "" {
+		t.Fatalf("unexpected request variables (-want +got):\n%s", diff)
+	}
+
+	t.Run("unknown user", func(t *testing.T) {
+		doer := &graphQLResponsesDoer{responses: []string{`{"data": {"user0": null}}`}}
+		cli := NewV4Client(uri, nil, doer)
+		err := cli.RequestReviews(context.Background(), &PullRequest{ID: "PR_1"}, []string{"nobody"}, nil)
+		if err == nil || !strings.Contains(err.Error(), `GitHub user "nobody" not found`) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid team", func(t *testing.T) {
+		err := cli.RequestReviews(context.Background(), &PullRequest{ID: "PR_1"}, nil, []string{"core"})
+		if err == nil || !strings.Contains(err.Error(), "must be of the form org/team-slug") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestAddLabelsToPullRequest(t *testing.T) {
+	rcache.SetupForTest(t)
+
+	doer := &graphQLResponsesDoer{responses: []string{
+		`{"data": {"node": {"repository": {"label0": {"id": "L_bug"}, "label1": {"id": "L_batch"}}}}}`,
+		`{"data": {"addLabelsToLabelable": {"labelable": {"id": "PR_1", "number": 1, "timelineItems": {"pageInfo": {"hasNextPage": false}}}}}}`,
+	}}
+	uri, _ := url.Parse("https://api.github.com")
+	cli := NewV4Client(uri, nil, doer)
+
+	pr := &PullRequest{ID: "PR_1"}
+	if err := cli.AddLabelsToPullRequest(context.Background(), pr, []string{"bug", "batch-change"}); err != nil {
+		t.Fatal(err)
+	}
+	if pr.Number != 1 {
+		t.Fatalf("pull request not updated: %+v", pr)
+	}
+
+	wantVariables := []map[string]interface{}{
+		{"pr": "PR_1", "label0": "bug", "label1": "batch-change"},
+		{"input": map[string]interface{}{
+			"labelableId": "PR_1",
+			"labelIds":    []interface{}{"L_bug", "L_batch"},
+		}},
+	}
+	if diff := cmp.Diff(wantVariables, doer.variables); diff != "" {
+		t.Fatalf("unexpected request variables (-want +got):\n%s", diff)
+	}
+
+	t.Run("unknown label", func(t *testing.T) {
+		doer := &graphQLResponsesDoer{responses: []string{`{"data": {"node": {"repository": {"label0": null}}}}`}}
+		cli := NewV4Client(uri, nil, doer)
+		err := cli.AddLabelsToPullRequest(context.Background(), &PullRequest{ID: "PR_1"}, []string{"missing"})
+		if err == nil || !strings.Contains(err.Error(), `label "missing" does not exist`) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
 func TestEstimateGraphQLCost(t *testing.T) {
 	for _, tc := range []struct {
 		name  string
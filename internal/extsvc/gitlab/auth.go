@@ -28,3 +28,20 @@ func (pat *SudoableToken) Authenticate(req *http.Request) error {
 func (pat *SudoableToken) Hash() string {
 	return fmt.Sprintf("pat::sudoku:%s::%s", pat.Sudo, pat.Token)
 }
+
+// JobToken represents a CI job token, which is scoped to the project of the job that it
+// was issued for.
+type JobToken struct {
+	Token string
+}
+
+var _ auth.Authenticator = &JobToken{}
+
+func (t *JobToken) Authenticate(req *http.Request) error {
+	req.Header.Set("Job-Token", t.Token)
+	return nil
+}
+
+func (t *JobToken) Hash() string {
+	return "job::" + t.Token
+}
@@ -63,3 +63,27 @@ func TestSudoableToken(t *testing.T) {
 		}
 	})
 }
+
+func TestJobToken(t *testing.T) {
+	token := JobToken{Token: "abcdef"}
+
+	req, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := token.Authenticate(req); err != nil {
+		t.Errorf("unexpected non-nil error: %v", err)
+	}
+
+	if have, want := req.Header.Get("Job-Token"), "abcdef"; have != want {
+		t.Errorf("unexpected Job-Token header: have=%q want=%q", have, want)
+	}
+	if have := req.Header.Get("Private-Token"); have != "" {
+		t.Errorf("unexpected Private-Token header: %v", have)
+	}
+
+	if (&JobToken{Token: "abcdef"}).Hash() == (&SudoableToken{Token: "abcdef"}).Hash() {
+		t.Error("job token hash collides with personal access token hash")
+	}
+}
@@ -0,0 +1,36 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+)
+
+// Job is a GitLab CI job.
+type Job struct {
+	ID       int64       `json:"id"`
+	Name     string      `json:"name"`
+	Ref      string      `json:"ref"`
+	Status   string      `json:"status"`
+	Pipeline JobPipeline `json:"pipeline"`
+}
+
+// JobPipeline is the pipeline that a job belongs to.
+type JobPipeline struct {
+	ID        int64 `json:"id"`
+	ProjectID int   `json:"project_id"`
+}
+
+// GetCurrentJob returns the job that the client's job token was issued for. This requires
+// the client to be authenticated with a JobToken.
+func (c *Client) GetCurrentJob(ctx context.Context) (*Job, error) {
+	req, err := http.NewRequest("GET", "job", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job Job
+	if _, _, err := c.do(ctx, req, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
@@ -0,0 +1,40 @@
+package gitlab
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_GetCurrentJob(t *testing.T) {
+	mock := mockHTTPResponseBody{
+		responseBody: `
+{
+	"id": 42,
+	"name": "lsif",
+	"ref": "main",
+	"status": "running",
+	"pipeline": {"id": 7, "project_id": 1, "ref": "main", "status": "running"}
+}
+`,
+	}
+	c := newTestClient(t)
+	c.httpClient = &mock
+
+	job, err := c.GetCurrentJob(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Job{
+		ID:       42,
+		Name:     "lsif",
+		Ref:      "main",
+		Status:   "running",
+		Pipeline: JobPipeline{ID: 7, ProjectID: 1},
+	}
+	if diff := cmp.Diff(want, job); diff != "" {
+		t.Errorf("unexpected job (-want +got):\n%s", diff)
+	}
+}
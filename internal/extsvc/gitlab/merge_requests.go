@@ -42,6 +42,7 @@ type MergeRequest struct {
 	WebURL         string            `json:"web_url"`
 	WorkInProgress bool              `json:"work_in_progress"`
 	Author         User              `json:"author"`
+	Reviewers      []User            `json:"reviewers"`
 
 	DiffRefs DiffRefs `json:"diff_refs"`
 
@@ -283,6 +284,71 @@ func (c *Client) MergeMergeRequest(ctx context.Context, project *Project, mr *Me
 	return resp, nil
 }
 
+// SetMergeRequestReviewers replaces the reviewers of the merge request with
+// the users with the given IDs. Callers that want to keep the existing
+// reviewers must include them.
+func (c *Client) SetMergeRequestReviewers(ctx context.Context, project *Project, mr *MergeRequest, reviewerIDs []int32) (*MergeRequest, error) {
+	if MockSetMergeRequestReviewers != nil {
+		return MockSetMergeRequestReviewers(c, ctx, project, mr, reviewerIDs)
+	}
+
+	payload := struct {
+		ReviewerIDs []int32 `json:"reviewer_ids"`
+	}{
+		ReviewerIDs: reviewerIDs,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling payload")
+	}
+
+	time.Sleep(c.rateLimitMonitor.RecommendedWaitForBackgroundOp(1))
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("projects/%d/merge_requests/%d", project.ID, mr.IID), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to set reviewers of a merge request")
+	}
+
+	resp := &MergeRequest{}
+	if _, _, err := c.do(ctx, req, resp); err != nil {
+		return nil, errors.Wrap(err, "sending request to set reviewers of a merge request")
+	}
+
+	return resp, nil
+}
+
+// AddMergeRequestLabels adds the given labels to the merge request. Labels
+// that don't exist in the project yet are created by GitLab.
+func (c *Client) AddMergeRequestLabels(ctx context.Context, project *Project, mr *MergeRequest, labels []string) (*MergeRequest, error) {
+	if MockAddMergeRequestLabels != nil {
+		return MockAddMergeRequestLabels(c, ctx, project, mr, labels)
+	}
+
+	payload := struct {
+		AddLabels string `json:"add_labels"`
+	}{
+		AddLabels: strings.Join(labels, ","),
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling payload")
+	}
+
+	time.Sleep(c.rateLimitMonitor.RecommendedWaitForBackgroundOp(1))
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("projects/%d/merge_requests/%d", project.ID, mr.IID), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to add labels to a merge request")
+	}
+
+	resp := &MergeRequest{}
+	if _, _, err := c.do(ctx, req, resp); err != nil {
+		return nil, errors.Wrap(err, "sending request to add labels to a merge request")
+	}
+
+	return resp, nil
+}
+
 func (c *Client) CreateMergeRequestNote(ctx context.Context, project *Project, mr *MergeRequest, body string) error {
 	if MockCreateMergeRequestNote != nil {
 		return MockCreateMergeRequestNote(c, ctx, project, mr, body)
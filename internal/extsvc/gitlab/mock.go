@@ -11,6 +11,10 @@ var MockListUsers func(c *Client, ctx context.Context, urlStr string) (users []*
 // MockGetUser, if non-nil, will be called instead of Client.GetUser
 var MockGetUser func(c *Client, ctx context.Context, id string) (*User, error)
 
+// MockGetUserByUsername, if non-nil, will be called instead of
+// Client.GetUserByUsername
+var MockGetUserByUsername func(c *Client, ctx context.Context, username string) (*User, error)
+
 // MockGetProject, if non-nil, will be called instead of Client.GetProject
 var MockGetProject func(c *Client, ctx context.Context, op GetProjectOp) (*Project, error)
 
@@ -49,6 +53,14 @@ var MockUpdateMergeRequest func(c *Client, ctx context.Context, project *Project
 // Client.MergeMergeRequest
 var MockMergeMergeRequest func(c *Client, ctx context.Context, project *Project, mr *MergeRequest, squash bool) (*MergeRequest, error)
 
+// MockSetMergeRequestReviewers, if non-nil, will be called instead of
+// Client.SetMergeRequestReviewers
+var MockSetMergeRequestReviewers func(c *Client, ctx context.Context, project *Project, mr *MergeRequest, reviewerIDs []int32) (*MergeRequest, error)
+
+// MockAddMergeRequestLabels, if non-nil, will be called instead of
+// Client.AddMergeRequestLabels
+var MockAddMergeRequestLabels func(c *Client, ctx context.Context, project *Project, mr *MergeRequest, labels []string) (*MergeRequest, error)
+
 // MockCreateMergeRequestNote, if non-nil, will be called instead of
 // Client.CreateMergeRequestNote
 var MockCreateMergeRequestNote func(c *Client, ctx context.Context, project *Project, mr *MergeRequest, body string) error
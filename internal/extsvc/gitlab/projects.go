@@ -181,6 +181,57 @@ func (c *Client) getProjectFromAPI(ctx context.Context, id int, pathWithNamespac
 	return proj, err
 }
 
+// AccessLevel is the level of access that a member has to a GitLab project or group.
+type AccessLevel int
+
+// Access level constants. See https://docs.gitlab.com/ee/api/members.html#valid-access-levels.
+const (
+	AccessLevelGuest      AccessLevel = 10
+	AccessLevelReporter   AccessLevel = 20
+	AccessLevelDeveloper  AccessLevel = 30
+	AccessLevelMaintainer AccessLevel = 40
+	AccessLevelOwner      AccessLevel = 50
+)
+
+// ProjectPermissions describes the access of the authenticated user to a project, either
+// through a direct membership of the project or through a membership of its parent group.
+type ProjectPermissions struct {
+	ProjectAccess *ProjectAccess `json:"project_access"`
+	GroupAccess   *ProjectAccess `json:"group_access"`
+}
+
+type ProjectAccess struct {
+	AccessLevel AccessLevel `json:"access_level"`
+}
+
+// AccessLevel returns the highest access level granted by either membership.
+func (p *ProjectPermissions) AccessLevel() AccessLevel {
+	var level AccessLevel
+	for _, access := range []*ProjectAccess{p.ProjectAccess, p.GroupAccess} {
+		if access != nil && access.AccessLevel > level {
+			level = access.AccessLevel
+		}
+	}
+	return level
+}
+
+// GetProjectPermissions returns the access of the authenticated user to the project with the
+// given ID. Unlike GetProject, the result is never cached as it is specific to the user.
+func (c *Client) GetProjectPermissions(ctx context.Context, id int) (*ProjectPermissions, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var proj struct {
+		Permissions ProjectPermissions `json:"permissions"`
+	}
+	if _, _, err := c.do(ctx, req, &proj); err != nil {
+		return nil, err
+	}
+	return &proj.Permissions, nil
+}
+
 // ListProjects lists GitLab projects.
 func (c *Client) ListProjects(ctx context.Context, urlStr string) (projs []*Project, nextPageURL *string, err error) {
 	if MockListProjects != nil {
@@ -100,3 +100,68 @@ func TestClient_GetProject_nonexistent(t *testing.T) {
 		t.Error("proj != nil")
 	}
 }
+
+func TestClient_GetProjectPermissions(t *testing.T) {
+	mock := mockHTTPResponseBody{
+		responseBody: `
+{
+	"id": 1,
+	"path_with_namespace": "n1/n2/r",
+	"permissions": {
+		"project_access": {"access_level": 20, "notification_level": 3},
+		"group_access": {"access_level": 30, "notification_level": 3}
+	}
+}
+`,
+	}
+	c := newTestClient(t)
+	c.httpClient = &mock
+
+	for i := 0; i < 2; i++ {
+		perms, err := c.GetProjectPermissions(context.Background(), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := perms.AccessLevel(), AccessLevelDeveloper; have != want {
+			t.Errorf("unexpected access level: have=%d want=%d", have, want)
+		}
+	}
+
+	// Permissions are specific to the authenticated user and must not be cached.
+	if mock.count != 2 {
+		t.Errorf("mock.count == %d, expected permissions to be fetched twice", mock.count)
+	}
+}
+
+func TestProjectPermissions_AccessLevel(t *testing.T) {
+	for name, tc := range map[string]struct {
+		perms ProjectPermissions
+		want  AccessLevel
+	}{
+		"no access": {
+			perms: ProjectPermissions{},
+			want:  0,
+		},
+		"project access only": {
+			perms: ProjectPermissions{ProjectAccess: &ProjectAccess{AccessLevel: AccessLevelMaintainer}},
+			want:  AccessLevelMaintainer,
+		},
+		"group access only": {
+			perms: ProjectPermissions{GroupAccess: &ProjectAccess{AccessLevel: AccessLevelReporter}},
+			want:  AccessLevelReporter,
+		},
+		"higher group access": {
+			perms: ProjectPermissions{
+				ProjectAccess: &ProjectAccess{AccessLevel: AccessLevelGuest},
+				GroupAccess:   &ProjectAccess{AccessLevel: AccessLevelOwner},
+			},
+			want: AccessLevelOwner,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := tc.perms.AccessLevel(); have != tc.want {
+				t.Errorf("unexpected access level: have=%d want=%d", have, tc.want)
+			}
+		})
+	}
+}
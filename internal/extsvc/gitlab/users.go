@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/peterhellberg/link"
 )
@@ -68,3 +69,41 @@ func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
 	}
 	return &usr, nil
 }
+
+// GetUserByUsername returns the user with the given username, or an error
+// that satisfies errcode.IsNotFound if there is no such user.
+func (c *Client) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	if MockGetUserByUsername != nil {
+		return MockGetUserByUsername(c, ctx, username)
+	}
+
+	q := make(url.Values)
+	q.Set("username", username)
+	req, err := http.NewRequest("GET", "users?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*User
+	if _, _, err := c.do(ctx, req, &users); err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, &UserNotFoundError{Username: username}
+	}
+	return users[0], nil
+}
+
+// UserNotFoundError is returned by GetUserByUsername if no user with the
+// username exists.
+type UserNotFoundError struct {
+	Username string
+}
+
+func (e *UserNotFoundError) Error() string {
+	return fmt.Sprintf("GitLab user %q not found", e.Username)
+}
+
+func (e *UserNotFoundError) NotFound() bool {
+	return true
+}
@@ -0,0 +1,429 @@
+// Package goproxy implements a client for the GOPROXY protocol, which is used
+// to resolve Go modules and download their zips, and verifies the downloaded
+// zips against a Go checksum database.
+package goproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb"
+	"golang.org/x/mod/sumdb/dirhash"
+	"golang.org/x/mod/sumdb/note"
+	modzip "golang.org/x/mod/zip"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// DefaultProxyURL is the proxy used by connections that don't configure any.
+const DefaultProxyURL = "https://proxy.golang.org"
+
+// DefaultChecksumDatabase is the verifier key of the public Go checksum
+// database, which is used by connections that don't configure one.
+const DefaultChecksumDatabase = "sum.golang.org+033de0ae+Ac4zctda0e5eza+HJyk9SxEdh+s3Ux18htTTAD8OuAn8"
+
+// defaultRequestsPerHour is the rate limit of connections that don't
+// configure one, which matches the default of the Go modules schema.
+const defaultRequestsPerHour = 57600
+
+var (
+	observationContext = &observation.Context{
+		Logger:     log15.Root(),
+		Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+		Registerer: prometheus.DefaultRegisterer,
+	}
+	operations = NewOperationsFromMetrics(observationContext)
+)
+
+// Client fetches module versions from Go module proxies.
+type Client struct {
+	urls    []string
+	doer    httpcli.Doer
+	limiter *rate.Limiter
+
+	// sumDB holds the configuration and cache of the checksum database that
+	// verifies downloaded zips. It is nil if verification is off.
+	sumDB *sumDBOps
+}
+
+// NewClient returns a client for the proxies and the checksum database of the
+// given connection. If doer is nil, httpcli.ExternalDoer is used. The state of
+// the checksum database is kept in sumDBCache, which should be shared by all
+// clients of a process. If sumDBCache is nil, the client gets its own
+// in-memory cache.
+func NewClient(config *schema.GoModulesConnection, doer httpcli.Doer, sumDBCache *SumDBCache) (*Client, error) {
+	if doer == nil {
+		doer = httpcli.ExternalDoer
+	}
+
+	urls := make([]string, 0, len(config.Urls))
+	for _, u := range config.Urls {
+		urls = append(urls, strings.TrimSuffix(u, "/"))
+	}
+	if len(urls) == 0 {
+		urls = []string{DefaultProxyURL}
+	}
+
+	limit := rate.Limit(defaultRequestsPerHour / 3600.0)
+	if config.RateLimit != nil {
+		limit = rate.Inf
+		if config.RateLimit.Enabled {
+			limit = rate.Limit(config.RateLimit.RequestsPerHour / 3600)
+		}
+	}
+
+	limiter := ratelimit.DefaultRegistry.GetOrSet(strings.Join(urls, ","), rate.NewLimiter(limit, 100))
+
+	if sumDBCache == nil {
+		sumDBCache = NewSumDBCache("")
+	}
+	sumDB, err := sumDBCache.get(config.ChecksumDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		urls:    urls,
+		doer:    doer,
+		limiter: limiter,
+		sumDB:   sumDB,
+	}, nil
+}
+
+// Exists returns true if one of the proxies has the version of the module.
+func (c *Client) Exists(ctx context.Context, dependency reposource.GoDependency) (exists bool, err error) {
+	ctx, endObservation := operations.exists.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("dependency", dependency.PackageManagerSyntax()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	resp, err := c.get(ctx, dependency, "info")
+	if errcode.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+	return true, nil
+}
+
+// GetZip downloads the zip of the module version to the file at zipFile and
+// verifies its hash against the checksum database.
+func (c *Client) GetZip(ctx context.Context, dependency reposource.GoDependency, zipFile string) (err error) {
+	ctx, endObservation := operations.getZip.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("dependency", dependency.PackageManagerSyntax()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	resp, err := c.get(ctx, dependency, "zip")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	f, err := os.Create(zipFile)
+	if err != nil {
+		return err
+	}
+	// Read one byte more than allowed to detect zips that are too large.
+	n, err := io.Copy(f, io.LimitReader(resp.Body, modzip.MaxZipFile+1))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if n > modzip.MaxZipFile {
+		return errors.Errorf("zip of %s is larger than %d bytes", dependency.PackageManagerSyntax(), modzip.MaxZipFile)
+	}
+
+	return c.verify(ctx, dependency, zipFile)
+}
+
+// verify checks that the hash of the zip is the one listed in the go.sum lines
+// of the checksum database for the dependency.
+func (c *Client) verify(ctx context.Context, dependency reposource.GoDependency, zipFile string) error {
+	if c.sumDB == nil {
+		return nil
+	}
+
+	hash, err := dirhash.HashZip(zipFile, dirhash.Hash1)
+	if err != nil {
+		return errors.Wrapf(err, "hashing zip of %s", dependency.PackageManagerSyntax())
+	}
+
+	// sumdb.Client doesn't take a context, so a client is created per lookup
+	// with ops that send their requests with ctx. The cache is shared.
+	ops := &sumDBLookupOps{sumDBOps: c.sumDB, ctx: ctx, doer: c.doer, limiter: c.limiter}
+	lines, err := sumdb.NewClient(ops).Lookup(dependency.Path, dependency.Version)
+	if err != nil {
+		return errors.Wrap(err, "looking up checksum")
+	}
+
+	want := fmt.Sprintf("%s %s %s", dependency.Path, dependency.Version, hash)
+	for _, line := range lines {
+		if line == want {
+			return nil
+		}
+	}
+	return errors.Errorf("checksum mismatch for %s: downloaded zip has hash %s, which is not in the checksum database", dependency.PackageManagerSyntax(), hash)
+}
+
+// get sends a GET request for the file with the given extension of the module
+// version to the proxies in order, until one doesn't respond with 404 or 410,
+// like the go command does for a comma-separated GOPROXY list. The caller
+// must close the response body.
+func (c *Client) get(ctx context.Context, dependency reposource.GoDependency, ext string) (*http.Response, error) {
+	escapedPath, err := module.EscapePath(dependency.Path)
+	if err != nil {
+		return nil, err
+	}
+	escapedVersion, err := module.EscapeVersion(dependency.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proxyURL := range c.urls {
+		if err = c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		u := fmt.Sprintf("%s/%s/@v/%s.%s", proxyURL, escapedPath, escapedVersion, ext)
+		var resp *http.Response
+		resp, err = get(ctx, c.doer, u)
+		if err == nil {
+			return resp, nil
+		}
+		if !errcode.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+func get(ctx context.Context, doer httpcli.Doer, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, &httpError{URL: u, StatusCode: resp.StatusCode, Body: body}
+	}
+	return resp, nil
+}
+
+type httpError struct {
+	URL        string
+	StatusCode int
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Go module proxy request to %s failed with status %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+// NotFound is true for both 404 and 410 responses, since proxies respond
+// with either of them for unknown module versions.
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound || e.StatusCode == http.StatusGone
+}
+
+// SumDBCache holds the state of the checksum databases used by clients: the
+// latest signed tree head of each database, and the tiles and lookups that
+// were already verified against it. Tree heads are persisted to a directory,
+// so that a checksum database can't roll back to an older tree after a
+// restart. Tiles are only cached in memory.
+type SumDBCache struct {
+	dir string
+
+	mu  sync.Mutex
+	dbs map[string]*sumDBOps
+}
+
+// NewSumDBCache returns a cache that persists tree heads to files in dir,
+// which is created if it doesn't exist. If dir is empty, nothing is persisted.
+func NewSumDBCache(dir string) *SumDBCache {
+	return &SumDBCache{dir: dir, dbs: map[string]*sumDBOps{}}
+}
+
+// get returns the ops for the checksum database configured in the GOSUMDB
+// format `<verifier key> [<url>]`, or nil if it is "off".
+func (c *SumDBCache) get(config string) (*sumDBOps, error) {
+	if config == "" {
+		config = DefaultChecksumDatabase
+	}
+	if config == "off" {
+		return nil, nil
+	}
+
+	fields := strings.Fields(config)
+	if len(fields) > 2 {
+		return nil, errors.Errorf("invalid checksum database %q: expected a verifier key and an optional URL", config)
+	}
+	verifier, err := note.NewVerifier(fields[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid checksum database key %q", fields[0])
+	}
+
+	url := "https://" + verifier.Name()
+	if len(fields) == 2 {
+		url = strings.TrimSuffix(fields[1], "/")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := fields[0] + " " + url
+	if ops, ok := c.dbs[id]; ok {
+		return ops, nil
+	}
+
+	ops := &sumDBOps{key: fields[0], url: url, cache: map[string][]byte{}}
+	if c.dir != "" {
+		// The name of the file is derived from the key and the URL, since
+		// the name of the key is chosen by whoever configures it.
+		ops.latestFile = filepath.Join(c.dir, fmt.Sprintf("%x.latest", sha256.Sum256([]byte(id))))
+		if ops.latest, err = os.ReadFile(ops.latestFile); err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, "reading checksum database tree head")
+		}
+	}
+	c.dbs[id] = ops
+	return ops, nil
+}
+
+// sumDBOps implements sumdb.ClientOps except ReadRemote for one checksum
+// database. Only the latest tree head is persisted, to latestFile.
+type sumDBOps struct {
+	key        string
+	url        string
+	latestFile string
+
+	mu     sync.Mutex
+	latest []byte
+	cache  map[string][]byte
+}
+
+// sumDBLookupOps implements sumdb.ClientOps for the lookups of a single
+// request, so that requests to the checksum database use its context and the
+// doer and rate limiter of the client.
+type sumDBLookupOps struct {
+	*sumDBOps
+	ctx     context.Context
+	doer    httpcli.Doer
+	limiter *rate.Limiter
+}
+
+var _ sumdb.ClientOps = &sumDBLookupOps{}
+
+func (o *sumDBLookupOps) ReadRemote(path string) ([]byte, error) {
+	if err := o.limiter.Wait(o.ctx); err != nil {
+		return nil, err
+	}
+	resp, err := get(o.ctx, o.doer, o.url+path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+func (o *sumDBOps) ReadConfig(file string) ([]byte, error) {
+	if file == "key" {
+		return []byte(o.key), nil
+	}
+	if strings.HasSuffix(file, "/latest") {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		return o.latest, nil
+	}
+	return nil, errors.Errorf("unknown checksum database config file %q", file)
+}
+
+func (o *sumDBOps) WriteConfig(file string, old, new []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !bytes.Equal(old, o.latest) {
+		return sumdb.ErrWriteConflict
+	}
+	if o.latestFile != "" {
+		if err := writeFileAtomically(o.latestFile, new); err != nil {
+			return errors.Wrap(err, "persisting checksum database tree head")
+		}
+	}
+	o.latest = new
+	return nil
+}
+
+// writeFileAtomically writes data to a temporary file next to name and renames
+// it to name, so that a crash never leaves a partially written file behind.
+func writeFileAtomically(name string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), name)
+}
+
+func (o *sumDBOps) ReadCache(file string) ([]byte, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if data, ok := o.cache[file]; ok {
+		return data, nil
+	}
+	return nil, errors.Errorf("%q is not cached", file)
+}
+
+func (o *sumDBOps) WriteCache(file string, data []byte) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.cache[file] = data
+}
+
+func (o *sumDBOps) Log(msg string) {
+	log15.Debug("Go checksum database", "msg", msg)
+}
+
+func (o *sumDBOps) SecurityError(msg string) {
+	log15.Error("Go checksum database returned inconsistent data", "msg", msg)
+}
@@ -0,0 +1,143 @@
+package goproxy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/mod/sumdb"
+	"golang.org/x/mod/sumdb/dirhash"
+	"golang.org/x/mod/sumdb/note"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestClient(t *testing.T) {
+	dependency, err := reposource.ParseGoDependency("github.com/sourcegraph/Example@v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	moduleZip := createZip(t, dependency, map[string]string{"go.mod": "module github.com/sourcegraph/Example\n"})
+
+	// The first proxy doesn't have any modules, so that the client has to
+	// fall back to the second one.
+	empty := httptest.NewServer(http.NotFoundHandler())
+	defer empty.Close()
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/github.com/sourcegraph/!example/@v/v1.0.0.info":
+			fmt.Fprint(w, `{"Version":"v1.0.0"}`)
+		case "/github.com/sourcegraph/!example/@v/v1.0.0.zip":
+			_, _ = w.Write(moduleZip)
+		default:
+			http.Error(w, "gone", http.StatusGone)
+		}
+	}))
+	defer proxy.Close()
+
+	hash := hashZip(t, moduleZip)
+	skey, vkey, err := note.GenerateKey(rand.Reader, "sumdb.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sumDB := httptest.NewServer(sumdb.NewServer(sumdb.NewTestServer(skey, func(path, vers string) ([]byte, error) {
+		return []byte(fmt.Sprintf("%s %s %s\n", path, vers, hash)), nil
+	})))
+	defer sumDB.Close()
+
+	sumDBDir := t.TempDir()
+	newClient := func(checksumDatabase string) *Client {
+		client, err := NewClient(&schema.GoModulesConnection{
+			Urls:             []string{empty.URL, proxy.URL + "/"},
+			ChecksumDatabase: checksumDatabase,
+		}, nil, NewSumDBCache(sumDBDir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+	ctx := context.Background()
+	client := newClient(vkey + " " + sumDB.URL)
+
+	exists, err := client.Exists(ctx, dependency)
+	if err != nil || !exists {
+		t.Fatalf("expected dependency to exist, got %v, %v", exists, err)
+	}
+
+	zipFile := filepath.Join(t.TempDir(), "module.zip")
+	if err := client.GetZip(ctx, dependency, zipFile); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := os.ReadFile(zipFile); err != nil || !bytes.Equal(data, moduleZip) {
+		t.Fatalf("unexpected zip, %v", err)
+	}
+
+	// The verified tree head is persisted, so that it is loaded by new caches.
+	if latest := newClient(vkey + " " + sumDB.URL).sumDB.latest; len(latest) == 0 {
+		t.Fatal("expected the tree head of the checksum database to be persisted")
+	}
+
+	missing := dependency
+	missing.Version = "v0.0.1"
+	exists, err = client.Exists(ctx, missing)
+	if err != nil || exists {
+		t.Fatalf("expected dependency to not exist, got %v, %v", exists, err)
+	}
+
+	// A proxy serving a tampered zip is detected.
+	moduleZip = createZip(t, dependency, map[string]string{"go.mod": "module github.com/sourcegraph/Example\n", "evil.go": "package evil\n"})
+	err = client.GetZip(ctx, dependency, zipFile)
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("expected a checksum mismatch, got %v", err)
+	}
+
+	// Unless the verification is off.
+	if err := newClient("off").GetZip(ctx, dependency, zipFile); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewClient(&schema.GoModulesConnection{ChecksumDatabase: "not-a-key"}, nil, nil); err == nil {
+		t.Fatal("expected an error for an invalid checksum database key")
+	}
+}
+
+func createZip(t *testing.T, dependency reposource.GoDependency, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(dependency.PackageManagerSyntax() + "/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func hashZip(t *testing.T, data []byte) string {
+	t.Helper()
+	zipFile := filepath.Join(t.TempDir(), "hash.zip")
+	if err := os.WriteFile(zipFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := dirhash.HashZip(zipFile, dirhash.Hash1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hash
+}
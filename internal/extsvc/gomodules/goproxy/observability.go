@@ -0,0 +1,35 @@
+package goproxy
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type Operations struct {
+	exists *observation.Operation
+	getZip *observation.Operation
+}
+
+func NewOperationsFromMetrics(observationContext *observation.Context) *Operations {
+	metrics := metrics.NewOperationMetrics(
+		observationContext.Registerer,
+		"codeintel_goproxy",
+		metrics.WithLabels("op"),
+		metrics.WithCountHelp("Total number of method invocations."),
+	)
+
+	op := func(name string) *observation.Operation {
+		return observationContext.Operation(observation.Op{
+			Name:              fmt.Sprintf("codeintel.goproxy.%s", name),
+			MetricLabelValues: []string{name},
+			Metrics:           metrics,
+		})
+	}
+
+	return &Operations{
+		exists: op("Exists"),
+		getZip: op("GetZip"),
+	}
+}
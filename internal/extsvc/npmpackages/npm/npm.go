@@ -0,0 +1,325 @@
+// Package npm implements a client for the npm registry API, which is used to
+// resolve npm packages and download their tarballs.
+package npm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// DefaultRegistryURL is the registry used by connections that don't configure
+// one.
+const DefaultRegistryURL = "https://registry.npmjs.org"
+
+// defaultRequestsPerHour is the rate limit of connections that don't
+// configure one, which matches the default of the npm packages schema.
+const defaultRequestsPerHour = 3000
+
+// maxTarballSize is the size limit of downloaded tarballs. It is a variable so
+// that tests can lower it.
+var maxTarballSize int64 = 500 << 20
+
+var (
+	observationContext = &observation.Context{
+		Logger:     log15.Root(),
+		Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+		Registerer: prometheus.DefaultRegisterer,
+	}
+	operations = NewOperationsFromMetrics(observationContext)
+)
+
+// Client fetches package metadata and tarballs from an npm registry.
+type Client struct {
+	registryURL string
+	// registryHost is the host of registryURL. Credentials are only sent to
+	// it, since tarballs may be hosted elsewhere.
+	registryHost string
+	credentials  string
+	doer         httpcli.Doer
+	limiter      *rate.Limiter
+}
+
+// NewClient returns a client for the registry of the given connection. If
+// doer is nil, httpcli.ExternalDoer is used.
+func NewClient(config *schema.NPMPackagesConnection, doer httpcli.Doer) *Client {
+	if doer == nil {
+		doer = httpcli.ExternalDoer
+	}
+
+	registryURL := strings.TrimSuffix(config.Registry, "/")
+	if registryURL == "" {
+		registryURL = DefaultRegistryURL
+	}
+
+	limit := rate.Limit(defaultRequestsPerHour / 3600.0)
+	if config.RateLimit != nil {
+		limit = rate.Inf
+		if config.RateLimit.Enabled {
+			limit = rate.Limit(config.RateLimit.RequestsPerHour / 3600)
+		}
+	}
+
+	var registryHost string
+	if u, err := url.Parse(registryURL); err == nil {
+		registryHost = u.Host
+	}
+
+	return &Client{
+		registryURL:  registryURL,
+		registryHost: registryHost,
+		credentials:  config.Credentials,
+		doer:         doer,
+		limiter:      ratelimit.DefaultRegistry.GetOrSet(registryURL, rate.NewLimiter(limit, 100)),
+	}
+}
+
+// versionInfo is the subset of the metadata of a package version returned by
+// `GET /<package>/<version>` that we use.
+type versionInfo struct {
+	Dist struct {
+		Tarball string `json:"tarball"`
+		// Integrity is the Subresource Integrity string of the tarball, such
+		// as "sha512-<base64>". Older packages only have Shasum.
+		Integrity string `json:"integrity"`
+		// Shasum is the hex-encoded SHA-1 of the tarball.
+		Shasum string `json:"shasum"`
+	} `json:"dist"`
+}
+
+// Exists returns true if the registry has the version of the package.
+func (c *Client) Exists(ctx context.Context, dependency reposource.NPMDependency) (exists bool, err error) {
+	ctx, endObservation := operations.exists.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("dependency", dependency.PackageManagerSyntax()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	_, err = c.versionInfo(ctx, dependency)
+	if errcode.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// FetchTarball downloads the gzipped tarball of the package version and
+// verifies it against the integrity or shasum of the registry's metadata. The
+// tarball is buffered in a temporary file, which is removed when the returned
+// reader is closed. The caller must close the returned reader.
+func (c *Client) FetchTarball(ctx context.Context, dependency reposource.NPMDependency) (_ io.ReadCloser, err error) {
+	ctx, endObservation := operations.fetchTarball.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("dependency", dependency.PackageManagerSyntax()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	info, err := c.versionInfo(ctx, dependency)
+	if err != nil {
+		return nil, err
+	}
+	if info.Dist.Tarball == "" {
+		return nil, errors.Errorf("no tarball for dependency %s", dependency.PackageManagerSyntax())
+	}
+	verifier, err := newTarballVerifier(info.Dist.Integrity, info.Dist.Shasum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "checksum of %s", dependency.PackageManagerSyntax())
+	}
+
+	resp, err := c.get(ctx, info.Dist.Tarball)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.ContentLength > maxTarballSize {
+		return nil, tarballTooLargeError(dependency)
+	}
+
+	f, err := os.CreateTemp("", "npm-tarball")
+	if err != nil {
+		return nil, err
+	}
+	tarball := &tempFile{File: f}
+	defer func() {
+		if err != nil {
+			tarball.Close()
+		}
+	}()
+
+	// Read one byte more than allowed to detect tarballs that are too large.
+	n, err := io.Copy(io.MultiWriter(f, verifier.hash), io.LimitReader(resp.Body, maxTarballSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > maxTarballSize {
+		return nil, tarballTooLargeError(dependency)
+	}
+	if err := verifier.verify(); err != nil {
+		return nil, errors.Wrapf(err, "tarball of %s", dependency.PackageManagerSyntax())
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return tarball, nil
+}
+
+func tarballTooLargeError(dependency reposource.NPMDependency) error {
+	return errors.Errorf("tarball of %s is larger than %d bytes", dependency.PackageManagerSyntax(), maxTarballSize)
+}
+
+// tempFile is a temporary file that is removed when it is closed.
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	if removeErr := os.Remove(f.Name()); err == nil {
+		err = removeErr
+	}
+	return err
+}
+
+// tarballVerifier checks the hash of a tarball against its expected digest.
+type tarballVerifier struct {
+	hash   hash.Hash
+	digest []byte
+}
+
+// integrityHashes are the supported algorithms of Subresource Integrity
+// strings, from strongest to weakest.
+var integrityHashes = []struct {
+	name    string
+	newHash func() hash.Hash
+}{
+	{"sha512", sha512.New},
+	{"sha384", sha512.New384},
+	{"sha256", sha256.New},
+	{"sha1", sha1.New},
+}
+
+// newTarballVerifier returns a verifier for the strongest hash of the given
+// Subresource Integrity string or, if it has no supported hash, the SHA-1 in
+// shasum. Tarballs without either can't be verified and are rejected.
+func newTarballVerifier(integrity, shasum string) (*tarballVerifier, error) {
+	digests := map[string]string{}
+	for _, field := range strings.Fields(integrity) {
+		// Options follow a `?`, such as in "sha512-<base64>?opt".
+		field = strings.SplitN(field, "?", 2)[0]
+		if parts := strings.SplitN(field, "-", 2); len(parts) == 2 {
+			digests[parts[0]] = parts[1]
+		}
+	}
+	for _, h := range integrityHashes {
+		if encoded, ok := digests[h.name]; ok {
+			digest, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid integrity %q", integrity)
+			}
+			return &tarballVerifier{hash: h.newHash(), digest: digest}, nil
+		}
+	}
+
+	if shasum != "" {
+		digest, err := hex.DecodeString(shasum)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid shasum %q", shasum)
+		}
+		return &tarballVerifier{hash: sha1.New(), digest: digest}, nil
+	}
+
+	return nil, errors.New("the registry lists no integrity or shasum")
+}
+
+// verify returns an error if the hash of the data written so far doesn't
+// match the expected digest.
+func (v *tarballVerifier) verify() error {
+	if digest := v.hash.Sum(nil); !bytes.Equal(digest, v.digest) {
+		return errors.Errorf("checksum mismatch: got %x, want %x", digest, v.digest)
+	}
+	return nil
+}
+
+func (c *Client) versionInfo(ctx context.Context, dependency reposource.NPMDependency) (*versionInfo, error) {
+	// Scoped package names are escaped as in @scope%2fname.
+	u := fmt.Sprintf("%s/%s/%s", c.registryURL, url.PathEscape(dependency.PackageSyntax()), url.PathEscape(dependency.Version))
+	resp, err := c.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var info versionInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, errors.Wrapf(err, "decoding metadata of %s", dependency.PackageManagerSyntax())
+	}
+	return &info, nil
+}
+
+// get sends a GET request and returns the response if its status is 200. The
+// caller must close the response body.
+func (c *Client) get(ctx context.Context, u string) (*http.Response, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Tarball URLs come from the registry's metadata and may point to other
+	// hosts, which must not see the credentials.
+	if c.credentials != "" && req.URL.Host == c.registryHost {
+		req.Header.Set("Authorization", "Bearer "+c.credentials)
+	}
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, &httpError{URL: u, StatusCode: resp.StatusCode, Body: body}
+	}
+	return resp, nil
+}
+
+type httpError struct {
+	URL        string
+	StatusCode int
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("npm registry request to %s failed with status %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
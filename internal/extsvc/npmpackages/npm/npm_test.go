@@ -0,0 +1,169 @@
+package npm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestClient(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.Header.Get("Authorization"), "Bearer secret"; have != want {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.EscapedPath() {
+		case "/@types%2Freact/17.0.2":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"dist": map[string]string{
+					"tarball": server.URL + "/react.tgz",
+					"shasum":  "e10f6e70661d167ef514ab6e6d98607438c6a8c6",
+				},
+			})
+		case "/react.tgz":
+			_, _ = w.Write([]byte("tarball"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&schema.NPMPackagesConnection{Registry: server.URL + "/", Credentials: "secret"}, nil)
+	ctx := context.Background()
+	dependency, err := reposource.ParseNPMDependency("@types/react@17.0.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exists, err := client.Exists(ctx, dependency)
+	if err != nil || !exists {
+		t.Fatalf("expected dependency to exist, got %v, %v", exists, err)
+	}
+
+	tarball, err := client.FetchTarball(ctx, dependency)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tarball.Close()
+	if data, err := io.ReadAll(tarball); err != nil || string(data) != "tarball" {
+		t.Fatalf("unexpected tarball %q, %v", data, err)
+	}
+
+	dependency.Version = "0.0.1"
+	exists, err = client.Exists(ctx, dependency)
+	if err != nil || exists {
+		t.Fatalf("expected dependency to not exist, got %v, %v", exists, err)
+	}
+
+	unauthorized := NewClient(&schema.NPMPackagesConnection{Registry: server.URL}, nil)
+	if _, err := unauthorized.Exists(ctx, dependency); err == nil {
+		t.Fatal("expected an error for requests without credentials")
+	}
+}
+
+func TestClient_FetchTarball(t *testing.T) {
+	old := maxTarballSize
+	maxTarballSize = 10
+	t.Cleanup(func() { maxTarballSize = old })
+
+	// Sizes of the tarballs by package name. The registry lists a checksum
+	// of other contents for the tampered package.
+	sizes := map[string]int{"small": 10, "large": 11, "tampered": 5}
+	contents := func(name string) []byte { return bytes.Repeat([]byte("x"), sizes[name]) }
+
+	tarballs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Credentials are only sent to the registry.
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			http.Error(w, "unexpected credentials "+auth, http.StatusBadRequest)
+			return
+		}
+		name := strings.TrimPrefix(r.URL.Path, "/")
+		if r.URL.Query().Get("stream") == "" {
+			w.Header().Set("Content-Length", strconv.Itoa(sizes[name]))
+		} else {
+			// Flushing first prevents the Content-Length from being set.
+			w.(http.Flusher).Flush()
+		}
+		_, _ = w.Write(contents(name))
+	}))
+	defer tarballs.Close()
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.Header.Get("Authorization"), "Bearer secret"; have != want {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		// Paths are /<name>/<version>. Tarballs of version 2.0.0 are sent
+		// without a Content-Length, and version 3.0.0 only has a shasum.
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		name, version := parts[0], parts[1]
+		tarball := tarballs.URL + "/" + name
+		if version == "2.0.0" {
+			tarball += "?stream=true"
+		}
+		checksummed := contents(name)
+		if name == "tampered" {
+			checksummed = []byte("other")
+		}
+		dist := map[string]string{"tarball": tarball}
+		if version == "3.0.0" {
+			sum := sha1.Sum(checksummed)
+			dist["shasum"] = hex.EncodeToString(sum[:])
+		} else {
+			sum := sha512.Sum512(checksummed)
+			dist["integrity"] = "sha512-" + base64.StdEncoding.EncodeToString(sum[:])
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"dist": dist})
+	}))
+	defer registry.Close()
+
+	// The default doer caches responses in redis, which isn't under test.
+	client := NewClient(&schema.NPMPackagesConnection{Registry: registry.URL, Credentials: "secret"}, http.DefaultClient)
+
+	for _, tc := range []struct {
+		dependency string
+		wantErr    bool
+	}{
+		{dependency: "small@1.0.0", wantErr: false},
+		{dependency: "small@2.0.0", wantErr: false},
+		{dependency: "large@1.0.0", wantErr: true},
+		{dependency: "large@2.0.0", wantErr: true},
+		{dependency: "small@3.0.0", wantErr: false},
+		{dependency: "tampered@1.0.0", wantErr: true},
+		{dependency: "tampered@3.0.0", wantErr: true},
+	} {
+		t.Run(tc.dependency, func(t *testing.T) {
+			dependency, err := reposource.ParseNPMDependency(tc.dependency)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tarball, err := client.FetchTarball(context.Background(), dependency)
+			if err == nil {
+				defer tarball.Close()
+				var data []byte
+				data, err = io.ReadAll(tarball)
+				if err == nil && len(data) != sizes[dependency.Name] {
+					t.Fatalf("unexpected tarball %q", data)
+				}
+			}
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package npm
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type Operations struct {
+	exists       *observation.Operation
+	fetchTarball *observation.Operation
+}
+
+func NewOperationsFromMetrics(observationContext *observation.Context) *Operations {
+	metrics := metrics.NewOperationMetrics(
+		observationContext.Registerer,
+		"codeintel_npm",
+		metrics.WithLabels("op"),
+		metrics.WithCountHelp("Total number of method invocations."),
+	)
+
+	op := func(name string) *observation.Operation {
+		return observationContext.Operation(observation.Op{
+			Name:              fmt.Sprintf("codeintel.npm.%s", name),
+			MetricLabelValues: []string{name},
+			Metrics:           metrics,
+		})
+	}
+
+	return &Operations{
+		exists:       op("Exists"),
+		fetchTarball: op("FetchTarball"),
+	}
+}
@@ -83,6 +83,8 @@ const (
 	KindPerforce        = "PERFORCE"
 	KindPhabricator     = "PHABRICATOR"
 	KindJVMPackages     = "JVMPACKAGES"
+	KindNPMPackages     = "NPMPACKAGES"
+	KindGoModules       = "GOMODULES"
 	KindOther           = "OTHER"
 )
 
@@ -122,6 +124,12 @@ const (
 	// TypeJVMPackages is the (api.ExternalRepoSpec).ServiceType value for Maven packages (Java/JVM ecosystem libraries).
 	TypeJVMPackages = "jvmPackages"
 
+	// TypeNPMPackages is the (api.ExternalRepoSpec).ServiceType value for npm packages (JavaScript/TypeScript ecosystem libraries).
+	TypeNPMPackages = "npmPackages"
+
+	// TypeGoModules is the (api.ExternalRepoSpec).ServiceType value for Go modules.
+	TypeGoModules = "goModules"
+
 	// TypeOther is the (api.ExternalRepoSpec).ServiceType value for other projects.
 	TypeOther = "other"
 
@@ -150,6 +158,10 @@ func KindToType(kind string) string {
 		return TypePerforce
 	case KindJVMPackages:
 		return TypeJVMPackages
+	case KindNPMPackages:
+		return TypeNPMPackages
+	case KindGoModules:
+		return TypeGoModules
 	case KindOther:
 		return TypeOther
 	default:
@@ -179,6 +191,10 @@ func TypeToKind(t string) string {
 		return KindPhabricator
 	case TypeJVMPackages:
 		return KindJVMPackages
+	case TypeNPMPackages:
+		return KindNPMPackages
+	case TypeGoModules:
+		return KindGoModules
 	case TypeOther:
 		return KindOther
 	default:
@@ -191,6 +207,8 @@ var (
 	bbsLower = strings.ToLower(TypeBitbucketServer)
 	bbcLower = strings.ToLower(TypeBitbucketCloud)
 	jvmLower = strings.ToLower(TypeJVMPackages)
+	npmLower = strings.ToLower(TypeNPMPackages)
+	goLower  = strings.ToLower(TypeGoModules)
 )
 
 // ParseServiceType will return a ServiceType constant after doing a case insensitive match on s.
@@ -215,6 +233,10 @@ func ParseServiceType(s string) (string, bool) {
 		return TypePhabricator, true
 	case jvmLower:
 		return TypeJVMPackages, true
+	case npmLower:
+		return TypeNPMPackages, true
+	case goLower:
+		return TypeGoModules, true
 	case TypeOther:
 		return TypeOther, true
 	default:
@@ -244,6 +266,10 @@ func ParseServiceKind(s string) (string, bool) {
 		return KindPhabricator, true
 	case KindJVMPackages:
 		return KindJVMPackages, true
+	case KindNPMPackages:
+		return KindNPMPackages, true
+	case KindGoModules:
+		return KindGoModules, true
 	case KindOther:
 		return KindOther, true
 	default:
@@ -285,6 +311,10 @@ func ParseConfig(kind, config string) (cfg interface{}, _ error) {
 		cfg = &schema.PhabricatorConnection{}
 	case KindJVMPackages:
 		cfg = &schema.JVMPackagesConnection{}
+	case KindNPMPackages:
+		cfg = &schema.NPMPackagesConnection{}
+	case KindGoModules:
+		cfg = &schema.GoModulesConnection{}
 	case KindOther:
 		cfg = &schema.OtherExternalServiceConnection{}
 	default:
@@ -410,6 +440,20 @@ func GetLimitFromConfig(kind string, config interface{}) (rlc RateLimitConfig, e
 			rlc.IsDefault = false
 		}
 		rlc.BaseURL = "maven"
+	case *schema.NPMPackagesConnection:
+		rlc.Limit = rate.Limit(3000.0 / 3600.0)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = "npm"
+	case *schema.GoModulesConnection:
+		rlc.Limit = rate.Limit(57600.0 / 3600.0)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = "go"
 	default:
 		return rlc, ErrRateLimitUnsupported{codehostKind: kind}
 	}
@@ -507,6 +551,10 @@ func UniqueCodeHostIdentifier(kind, config string) (string, error) {
 		return c.P4Port, nil
 	case *schema.JVMPackagesConnection:
 		return KindJVMPackages, nil
+	case *schema.NPMPackagesConnection:
+		return KindNPMPackages, nil
+	case *schema.GoModulesConnection:
+		return KindGoModules, nil
 	default:
 		return "", errors.Errorf("unknown external service kind: %s", kind)
 	}
@@ -226,8 +226,25 @@ func (c *Client) Archive(ctx context.Context, repo api.RepoName, opt ArchiveOpti
 		return nil, err
 	}
 
-	u := c.ArchiveURL(repo, opt)
-	resp, err := c.do(ctx, repo, "GET", u.String(), nil)
+	var resp *http.Response
+	if len(opt.Paths) == 0 {
+		u := c.ArchiveURL(repo, opt)
+		resp, err = c.do(ctx, repo, "GET", u.String(), nil)
+	} else {
+		// There may be too many paths to fit in the URL, so we send them in
+		// the request body.
+		var payload []byte
+		payload, err = json.Marshal(&protocol.ArchiveRequest{
+			Repo:    repo,
+			Treeish: opt.Treeish,
+			Format:  opt.Format,
+			Paths:   opt.Paths,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, err = c.do(ctx, repo, "POST", "http://"+c.AddrForRepo(repo)+"/archive", payload)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -14,6 +14,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/cockroachdb/errors"
@@ -98,19 +99,36 @@ func TestClient_Archive(t *testing.T) {
 	}
 	defer os.RemoveAll(root)
 
+	simpleRemote := createSimpleGitRepo(t, root)
 	tests := map[api.RepoName]struct {
-		remote string
-		want   map[string]string
-		err    error
+		remote  string
+		paths   []string
+		want    map[string]string
+		err     error
+		readErr string
 	}{
 		"simple": {
-			remote: createSimpleGitRepo(t, root),
+			remote: simpleRemote,
 			want: map[string]string{
 				"dir1/":      "",
 				"dir1/file1": "infile1",
 				"file 2":     "infile2",
 			},
 		},
+		"simple-with-paths": {
+			remote: simpleRemote,
+			paths:  []string{"file 2", "dir1/file1"},
+			want: map[string]string{
+				"dir1/":      "",
+				"dir1/file1": "infile1",
+				"file 2":     "infile2",
+			},
+		},
+		"simple-with-glob-path": {
+			remote:  simpleRemote,
+			paths:   []string{"dir1/*"},
+			readErr: "pathspec ':(literal)dir1/*' did not match any files",
+		},
 		"repo-with-dotgit-dir": {
 			remote: createRepoWithDotGitDir(t, root),
 			want:   map[string]string{"file1": "hello\n", ".git/mydir/file2": "milton\n", ".git/mydir/": "", ".git/": ""},
@@ -150,7 +168,7 @@ func TestClient_Archive(t *testing.T) {
 				}
 			}
 
-			rc, err := cli.Archive(ctx, name, gitserver.ArchiveOptions{Treeish: "HEAD", Format: "zip"})
+			rc, err := cli.Archive(ctx, name, gitserver.ArchiveOptions{Treeish: "HEAD", Format: "zip", Paths: test.paths})
 			if have, want := fmt.Sprint(err), fmt.Sprint(test.err); have != want {
 				t.Errorf("archive: have err %v, want %v", have, want)
 			}
@@ -161,6 +179,12 @@ func TestClient_Archive(t *testing.T) {
 
 			defer rc.Close()
 			data, err := io.ReadAll(rc)
+			if test.readErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.readErr) {
+					t.Fatalf("read: have err %v, want %q", err, test.readErr)
+				}
+				return
+			}
 			if err != nil {
 				t.Fatal(err)
 			}
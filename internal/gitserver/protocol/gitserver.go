@@ -24,6 +24,10 @@ type RevisionSpecifier struct {
 	// the manpage gitrevisions(7).
 	RevSpec string
 
+	// ExcludeRevSpec is a revspec whose reachable commits are excluded. See
+	// "^<rev>" in gitrevisions(7).
+	ExcludeRevSpec string
+
 	// RefGlob is a reference glob to pass to git. See the documentation for
 	// "--glob" in git-log.
 	RefGlob string
@@ -83,6 +87,16 @@ type Signature struct {
 	Date  time.Time
 }
 
+// ArchiveRequest is a request to produce an archive of a git repository. It
+// is sent in the body of a POST request to /archive, as there may be too many
+// paths to fit in the query string of a GET request.
+type ArchiveRequest struct {
+	Repo    api.RepoName `json:"repo"`
+	Treeish string       `json:"treeish"`
+	Format  string       `json:"format"`
+	Paths   []string     `json:"paths"`
+}
+
 // ExecRequest is a request to execute a command inside a git repository.
 //
 // Note that this request is deserialized by both gitserver and the frontend's
@@ -85,6 +85,31 @@ func (d *DiffModifiesFile) String() string {
 	return fmt.Sprintf("%T(%s)", d, d.Expr)
 }
 
+// TrailerMatches is a predicate that matches if the commit message has a
+// trailer like `Co-authored-by: ...` whose key equals Key, ignoring case, and
+// whose value matches the regex pattern.
+type TrailerMatches struct {
+	Key        string
+	Expr       string
+	IgnoreCase bool
+}
+
+func (t *TrailerMatches) String() string {
+	return fmt.Sprintf("%T(%s: %s)", t, t.Key, t.Expr)
+}
+
+// ParentCount is a predicate that matches if the number of parents of the
+// commit is at least Min and, unless Max is negative, at most Max. Merge
+// commits are only searched if the query contains a ParentCount node.
+type ParentCount struct {
+	Min int
+	Max int
+}
+
+func (p *ParentCount) String() string {
+	return fmt.Sprintf("%T(%d, %d)", p, p.Min, p.Max)
+}
+
 // Boolean is a predicate that will either always match or never match
 type Boolean struct {
 	Value bool
@@ -219,6 +244,8 @@ func RegisterGob() {
 		gob.Register(&MessageMatches{})
 		gob.Register(&DiffMatches{})
 		gob.Register(&DiffModifiesFile{})
+		gob.Register(&TrailerMatches{})
+		gob.Register(&ParentCount{})
 		gob.Register(&Boolean{})
 		gob.Register(&Operator{})
 	})
@@ -242,11 +242,11 @@ func estimatedNodeCost(n Node) float64 {
 		return sum
 	case *Boolean:
 		return 0
-	case *CommitBefore, *CommitAfter:
+	case *CommitBefore, *CommitAfter, *ParentCount:
 		return 1
 	case *AuthorMatches, *CommitterMatches:
 		return 5
-	case *MessageMatches:
+	case *MessageMatches, *TrailerMatches:
 		return 10
 	case *DiffModifiesFile:
 		return 1000
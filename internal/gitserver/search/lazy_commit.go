@@ -76,6 +76,57 @@ func (l *LazyCommit) ParentIDs() []api.CommitID {
 	return commitIDs
 }
 
+// Trailer is a `Key: value` line in the trailer block of a commit message.
+// ValueStart and ValueEnd are the byte offsets of the value in the message.
+type Trailer struct {
+	Key        []byte
+	ValueStart int
+	ValueEnd   int
+}
+
+// Trailers parses the trailers from the last paragraph of the commit message.
+// Like git, it never considers the first paragraph, which is the subject.
+func (l *LazyCommit) Trailers() []Trailer {
+	start := bytes.LastIndex(l.Message, []byte("\n\n"))
+	if start < 0 {
+		return nil
+	}
+
+	var trailers []Trailer
+	for offset := start + 2; offset < len(l.Message); {
+		end := bytes.IndexByte(l.Message[offset:], '\n')
+		if end < 0 {
+			end = len(l.Message)
+		} else {
+			end += offset
+		}
+
+		line := l.Message[offset:end]
+		if colon := bytes.IndexByte(line, ':'); colon > 0 && isTrailerKey(line[:colon]) {
+			value := bytes.TrimSpace(line[colon+1:])
+			valueStart := offset + colon + 1 + bytes.Index(line[colon+1:], value)
+			trailers = append(trailers, Trailer{
+				Key:        line[:colon],
+				ValueStart: valueStart,
+				ValueEnd:   valueStart + len(value),
+			})
+		}
+		offset = end + 1
+	}
+	return trailers
+}
+
+// isTrailerKey returns whether key consists of alphanumeric characters and
+// dashes only, like `Signed-off-by`.
+func isTrailerKey(key []byte) bool {
+	for _, c := range key {
+		if !(c == '-' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
 func (l *LazyCommit) RefNames() []string {
 	return strings.Split(string(l.RawCommit.RefNames), ", ")
 }
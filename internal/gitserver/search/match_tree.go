@@ -35,6 +35,11 @@ func ToMatchTree(q protocol.Node) (MatchTree, error) {
 	case *protocol.DiffModifiesFile:
 		re, err := casetransform.CompileRegexp(v.Expr, v.IgnoreCase)
 		return &DiffModifiesFile{re}, err
+	case *protocol.TrailerMatches:
+		re, err := casetransform.CompileRegexp(v.Expr, v.IgnoreCase)
+		return &TrailerMatches{Key: []byte(v.Key), Regexp: re}, err
+	case *protocol.ParentCount:
+		return &ParentCount{*v}, nil
 	case *protocol.Boolean:
 		return &Constant{v.Value}, nil
 	case *protocol.Operator:
@@ -218,6 +223,60 @@ func (dmf *DiffModifiesFile) Match(lc *LazyCommit) (CommitFilterResult, MatchedC
 	return CommitFilterResult{MatchedFileDiffs: matchedFileDiffs}, MatchedCommit{Diff: fileDiffHighlights}, nil
 }
 
+// TrailerMatches is a predicate that matches if the commit message has a
+// trailer with the given key whose value matches the regex pattern.
+type TrailerMatches struct {
+	Key []byte
+	*casetransform.Regexp
+}
+
+func (t *TrailerMatches) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit, error) {
+	var results [][]int
+	for _, trailer := range lc.Trailers() {
+		if !bytes.EqualFold(trailer.Key, t.Key) {
+			continue
+		}
+		value := lc.Message[trailer.ValueStart:trailer.ValueEnd]
+		for _, match := range t.FindAllIndex(value, -1, &lc.LowerBuf) {
+			results = append(results, []int{trailer.ValueStart + match[0], trailer.ValueStart + match[1]})
+		}
+	}
+	if results == nil {
+		return filterResult(false), MatchedCommit{}, nil
+	}
+
+	return filterResult(true), MatchedCommit{
+		Message: matchesToRanges(lc.Message, results),
+	}, nil
+}
+
+// ParentCount is a predicate that matches if the number of parents of the
+// commit is within the given bounds.
+type ParentCount struct {
+	protocol.ParentCount
+}
+
+func (p *ParentCount) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit, error) {
+	count := len(bytes.Fields(lc.ParentHashes))
+	return filterResult(count >= p.Min && (p.Max < 0 || count <= p.Max)), MatchedCommit{}, nil
+}
+
+// includesMerges returns whether the tree contains a ParentCount node, in
+// which case merge commits have to be searched, too.
+func includesMerges(mt MatchTree) bool {
+	switch v := mt.(type) {
+	case *ParentCount:
+		return true
+	case *Operator:
+		for _, operand := range v.Operands {
+			if includesMerges(operand) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type Constant struct {
 	Value bool
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -89,3 +90,61 @@ func Test_matchesToRanges(t *testing.T) {
 		})
 	}
 }
+
+func TestTrailerMatches(t *testing.T) {
+	message := "fix the thing\n\nSome body: not a trailer.\n\nCo-authored-by: Alice <alice@example.com>\nsigned-off-by:   Bob <bob@example.com>  \nThis line is not a trailer"
+	lc := &LazyCommit{RawCommit: &RawCommit{Message: []byte(message)}}
+
+	cases := []struct {
+		node    *protocol.TrailerMatches
+		matched []string
+	}{
+		{&protocol.TrailerMatches{Key: "co-authored-by", Expr: "ALICE"}, nil},
+		{&protocol.TrailerMatches{Key: "co-authored-by", Expr: "alice", IgnoreCase: true}, []string{"Alice", "alice"}},
+		{&protocol.TrailerMatches{Key: "Signed-off-by", Expr: ".*"}, []string{"Bob <bob@example.com>"}},
+		{&protocol.TrailerMatches{Key: "Some body", Expr: ".*"}, nil},
+		{&protocol.TrailerMatches{Key: "Reviewed-by", Expr: ".*"}, nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.node.String(), func(t *testing.T) {
+			mt, err := ToMatchTree(tc.node)
+			require.NoError(t, err)
+			cfr, highlights, err := mt.Match(lc)
+			require.NoError(t, err)
+			require.Equal(t, tc.matched != nil, cfr.Satisfies())
+
+			var matched []string
+			for _, r := range highlights.Message {
+				matched = append(matched, message[r.Start.Offset:r.End.Offset])
+			}
+			require.Equal(t, tc.matched, matched)
+		})
+	}
+
+	// The subject is never parsed for trailers.
+	subjectOnly := &LazyCommit{RawCommit: &RawCommit{Message: []byte("Signed-off-by: Bob")}}
+	require.Empty(t, subjectOnly.Trailers())
+}
+
+func TestParentCount(t *testing.T) {
+	cases := []struct {
+		parents  string
+		node     protocol.ParentCount
+		expected bool
+	}{
+		{"", protocol.ParentCount{Min: 0, Max: 1}, true},
+		{"a", protocol.ParentCount{Min: 0, Max: 1}, true},
+		{"a b", protocol.ParentCount{Min: 0, Max: 1}, false},
+		{"a b", protocol.ParentCount{Min: 2, Max: -1}, true},
+		{"a b c", protocol.ParentCount{Min: 2, Max: -1}, true},
+		{"a", protocol.ParentCount{Min: 2, Max: -1}, false},
+	}
+
+	for _, tc := range cases {
+		lc := &LazyCommit{RawCommit: &RawCommit{ParentHashes: []byte(tc.parents)}}
+		cfr, _, err := (&ParentCount{tc.node}).Match(lc)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, cfr.Satisfies(), "parents %q, %s", tc.parents, tc.node.String())
+	}
+}
@@ -54,7 +54,6 @@ var (
 		"log",
 		"--decorate=full",
 		"-z",
-		"--no-merges",
 		"--format=format:" + strings.Join(commitFields, "%x00") + "%x00",
 	}
 
@@ -123,8 +122,12 @@ func (cs *CommitSearcher) Search(ctx context.Context, onMatch func(*protocol.Com
 }
 
 func (cs *CommitSearcher) feedBatches(ctx context.Context, jobs chan job, resultChans chan chan *protocol.CommitMatch) (err error) {
-	revArgs := revsToGitArgs(cs.Revisions)
-	cmd := exec.CommandContext(ctx, "git", append(logArgs, revArgs...)...)
+	args := append([]string{}, logArgs...)
+	if !includesMerges(cs.Query) {
+		args = append(args, "--no-merges")
+	}
+	args = append(args, revsToGitArgs(cs.Revisions)...)
+	cmd := exec.CommandContext(ctx, "git", args...)
 	cmd.Dir = cs.RepoDir
 	stdoutReader, err := cmd.StdoutPipe()
 	if err != nil {
@@ -235,17 +238,28 @@ func (cs *CommitSearcher) runJobs(ctx context.Context, jobs chan job) error {
 
 func revsToGitArgs(revs []protocol.RevisionSpecifier) []string {
 	revArgs := make([]string, 0, len(revs))
+	hasInclude := false
 	for _, rev := range revs {
 		if rev.RevSpec != "" {
 			revArgs = append(revArgs, rev.RevSpec)
+			hasInclude = true
+		} else if rev.ExcludeRevSpec != "" {
+			revArgs = append(revArgs, "^"+rev.ExcludeRevSpec)
 		} else if rev.RefGlob != "" {
 			revArgs = append(revArgs, "--glob="+rev.RefGlob)
+			hasInclude = true
 		} else if rev.ExcludeRefGlob != "" {
 			revArgs = append(revArgs, "--exclude="+rev.RefGlob)
 		} else {
 			revArgs = append(revArgs, "HEAD")
+			hasInclude = true
 		}
 	}
+	// Like git log without any revisions, only excluding revisions searches
+	// the commits reachable from HEAD.
+	if len(revs) > 0 && !hasInclude {
+		revArgs = append(revArgs, "HEAD")
+	}
 	return revArgs
 }
 
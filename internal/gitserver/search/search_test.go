@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"testing/quick"
@@ -190,6 +191,101 @@ func TestSearch(t *testing.T) {
 	})
 }
 
+func TestSearchMerges(t *testing.T) {
+	dir := initGitRepository(t,
+		"git config user.name camden",
+		"git config user.email camden@ccheek.com",
+		"echo lorem > file1",
+		"git add -A",
+		"git commit -m commit1",
+		"git checkout -b side",
+		"echo ipsum > file2",
+		"git add -A",
+		"git commit -m commit2 -m 'Co-authored-by: Alice <alice@example.com>'",
+		"git checkout -",
+		"echo dolor > file3",
+		"git add -A",
+		"git commit -m commit3",
+		"git merge --no-ff side -m merge",
+	)
+
+	search := func(query protocol.Node) []string {
+		tree, err := ToMatchTree(query)
+		require.NoError(t, err)
+		searcher := &CommitSearcher{
+			RepoDir: dir,
+			Query:   tree,
+		}
+		var messages []string
+		err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			messages = append(messages, strings.SplitN(match.Message.Content, "\n", 2)[0])
+		})
+		require.NoError(t, err)
+		sort.Strings(messages)
+		return messages
+	}
+
+	t.Run("merges are excluded by default", func(t *testing.T) {
+		require.Equal(t, []string{"commit1", "commit2", "commit3"}, search(protocol.NewAnd()))
+	})
+
+	t.Run("merges only", func(t *testing.T) {
+		require.Equal(t, []string{"merge"}, search(&protocol.ParentCount{Min: 2, Max: -1}))
+	})
+
+	t.Run("merges included", func(t *testing.T) {
+		require.Equal(t, []string{"commit1", "commit2", "commit3", "merge"}, search(&protocol.ParentCount{Min: 0, Max: -1}))
+	})
+
+	t.Run("negated merges only", func(t *testing.T) {
+		require.Equal(t, []string{"commit1", "commit2", "commit3"}, search(protocol.NewNot(&protocol.ParentCount{Min: 2, Max: -1})))
+	})
+
+	t.Run("trailer matches", func(t *testing.T) {
+		require.Equal(t, []string{"commit2"}, search(&protocol.TrailerMatches{Key: "co-authored-by", Expr: "alice", IgnoreCase: true}))
+	})
+}
+
+func TestSearchRevisions(t *testing.T) {
+	dir := initGitRepository(t,
+		"git config user.name camden",
+		"git config user.email camden@ccheek.com",
+		"git commit --allow-empty -m commit1",
+		"git tag release-1",
+		"git commit --allow-empty -m commit2",
+		"git commit --allow-empty -m commit3",
+		"git tag release-2",
+		"git commit --allow-empty -m commit4",
+	)
+
+	search := func(revs ...protocol.RevisionSpecifier) []string {
+		searcher := &CommitSearcher{
+			RepoDir:   dir,
+			Query:     &Constant{true},
+			Revisions: revs,
+		}
+		var messages []string
+		err := searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			messages = append(messages, match.Message.Content)
+		})
+		require.NoError(t, err)
+		return messages
+	}
+
+	t.Run("included and excluded revisions", func(t *testing.T) {
+		require.Equal(t, []string{"commit3", "commit2"}, search(
+			protocol.RevisionSpecifier{RevSpec: "release-2"},
+			protocol.RevisionSpecifier{ExcludeRevSpec: "release-1"},
+		))
+	})
+
+	t.Run("only excluded revisions search HEAD", func(t *testing.T) {
+		require.Equal(t, []string{"commit4"}, search(
+			protocol.RevisionSpecifier{ExcludeRevSpec: "release-2"},
+		))
+	})
+}
+
 func TestCommitScanner(t *testing.T) {
 	cases := []struct {
 		input    []byte
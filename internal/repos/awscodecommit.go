@@ -45,6 +45,42 @@ func NewAWSCodeCommitSource(svc *types.ExternalService, cf *httpcli.Factory) (*A
 }
 
 func newAWSCodeCommitSource(svc *types.ExternalService, c *schema.AWSCodeCommitConnection, cf *httpcli.Factory) (*AWSCodeCommitSource, error) {
+	client, err := NewAWSCodeCommitClient(c, cf)
+	if err != nil {
+		return nil, err
+	}
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		eb.Exact(r.Id)
+	}
+	exclude, err := eb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &AWSCodeCommitSource{
+		svc:     svc,
+		config:  c,
+		exclude: exclude,
+		client:  client,
+	}
+
+	endpoint, err := codecommit.NewDefaultEndpointResolver().ResolveEndpoint(c.Region, codecommit.EndpointResolverOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to resolve AWS region %q", c.Region))
+	}
+	s.awsPartition = endpoint.PartitionID
+	s.awsRegion = endpoint.SigningRegion
+
+	return s, nil
+}
+
+// NewAWSCodeCommitClient returns an AWS CodeCommit API client for the given
+// connection. It uses the HTTP transport the AWS SDK expects, built from the
+// given factory.
+func NewAWSCodeCommitClient(c *schema.AWSCodeCommitConnection, cf *httpcli.Factory) (*awscodecommit.Client, error) {
 	if cf == nil {
 		cf = httpcli.ExternalClientFactory
 	}
@@ -80,31 +116,7 @@ func newAWSCodeCommitSource(svc *types.ExternalService, c *schema.AWSCodeCommitC
 		return nil, err
 	}
 
-	var eb excludeBuilder
-	for _, r := range c.Exclude {
-		eb.Exact(r.Name)
-		eb.Exact(r.Id)
-	}
-	exclude, err := eb.Build()
-	if err != nil {
-		return nil, err
-	}
-
-	s := &AWSCodeCommitSource{
-		svc:     svc,
-		config:  c,
-		exclude: exclude,
-		client:  awscodecommit.NewClient(awsConfig),
-	}
-
-	endpoint, err := codecommit.NewDefaultEndpointResolver().ResolveEndpoint(c.Region, codecommit.EndpointResolverOptions{})
-	if err != nil {
-		return nil, errors.Wrap(err, fmt.Sprintf("failed to resolve AWS region %q", c.Region))
-	}
-	s.awsPartition = endpoint.PartitionID
-	s.awsRegion = endpoint.SigningRegion
-
-	return s, nil
+	return awscodecommit.NewClient(awsConfig), nil
 }
 
 // ListRepos returns all AWS Code Commit repositories accessible to all
@@ -0,0 +1,123 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodules"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodules/goproxy"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A GoModulesSource creates git repositories from the zips of Go modules
+// served by Go module proxies.
+type GoModulesSource struct {
+	svc    *types.ExternalService
+	config *schema.GoModulesConnection
+	client *goproxy.Client
+}
+
+// NewGoModulesSource returns a new GoModulesSource from the given external
+// service.
+func NewGoModulesSource(svc *types.ExternalService) (*GoModulesSource, error) {
+	var c schema.GoModulesConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, fmt.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return newGoModulesSource(svc, &c, nil)
+}
+
+func newGoModulesSource(svc *types.ExternalService, c *schema.GoModulesConnection, doer httpcli.Doer) (*GoModulesSource, error) {
+	client, err := goproxy.NewClient(c, doer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return &GoModulesSource{
+		svc:    svc,
+		config: c,
+		client: client,
+	}, nil
+}
+
+// ListRepos returns all Go modules accessible to all connections configured
+// in Sourcegraph via the external services configuration.
+func (s *GoModulesSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	dependencies, err := GoDependencies(*s.config)
+	if err != nil {
+		results <- SourceResult{Err: err}
+		return
+	}
+
+	seen := make(map[reposource.GoModule]bool)
+	for _, dependency := range dependencies {
+		if seen[dependency.GoModule] {
+			continue
+		}
+
+		// Like for JVM packages, we don't return modules that don't
+		// resolve, so that gitserver doesn't fail to clone them over and
+		// over.
+		if exists, err := s.client.Exists(ctx, dependency); !exists {
+			if err != nil {
+				log15.Warn("failed to resolve Go module", "module", dependency.PackageManagerSyntax(), "error", err)
+			} else {
+				log15.Warn("Go module not found in proxies", "module", dependency.PackageManagerSyntax())
+			}
+			continue
+		}
+
+		seen[dependency.GoModule] = true
+		results <- SourceResult{
+			Source: s,
+			Repo:   s.makeRepo(dependency.GoModule),
+		}
+	}
+}
+
+func (s *GoModulesSource) makeRepo(mod reposource.GoModule) *types.Repo {
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: mod.RepoName(),
+		URI:  string(mod.RepoName()),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          string(mod.RepoName()),
+			ServiceID:   extsvc.TypeGoModules,
+			ServiceType: extsvc.TypeGoModules,
+		},
+		Private: false,
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: mod.CloneURL(),
+			},
+		},
+		Metadata: &gomodules.Metadata{
+			Module: mod,
+		},
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *GoModulesSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+// GoDependencies returns the parsed dependencies of the connection.
+func GoDependencies(connection schema.GoModulesConnection) (dependencies []reposource.GoDependency, err error) {
+	for _, dep := range connection.Dependencies {
+		dependency, err := reposource.ParseGoDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies, nil
+}
@@ -0,0 +1,60 @@
+package repos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGoModulesSource_ListRepos(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/golang.org/x/mod/@v/v0.5.1.info", "/golang.org/x/mod/@v/v0.4.2.info", "/github.com/!burnt!sushi/toml/@v/v0.4.1.info":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer proxy.Close()
+
+	svc := &types.ExternalService{ID: 1, Kind: extsvc.KindGoModules}
+	src, err := newGoModulesSource(svc, &schema.GoModulesConnection{
+		Urls: []string{proxy.URL},
+		Dependencies: []string{
+			"golang.org/x/mod@v0.5.1",
+			"golang.org/x/mod@v0.4.2",
+			"github.com/BurntSushi/toml@v0.4.1",
+			"golang.org/x/tools@v0.1.7", // not in the proxy
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := make(chan SourceResult)
+	go func() {
+		src.ListRepos(context.Background(), results)
+		close(results)
+	}()
+
+	var names []api.RepoName
+	for res := range results {
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		names = append(names, res.Repo.Name)
+	}
+
+	want := []api.RepoName{"go/golang.org/x/mod", "go/github.com/BurntSushi/toml"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Fatalf("unexpected repos (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,119 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npmpackages"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npmpackages/npm"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// An NPMPackagesSource creates git repositories from the tarballs of npm
+// packages published to an npm registry.
+type NPMPackagesSource struct {
+	svc    *types.ExternalService
+	config *schema.NPMPackagesConnection
+	client *npm.Client
+}
+
+// NewNPMPackagesSource returns a new NPMPackagesSource from the given external
+// service.
+func NewNPMPackagesSource(svc *types.ExternalService) (*NPMPackagesSource, error) {
+	var c schema.NPMPackagesConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, fmt.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return newNPMPackagesSource(svc, &c, nil)
+}
+
+func newNPMPackagesSource(svc *types.ExternalService, c *schema.NPMPackagesConnection, doer httpcli.Doer) (*NPMPackagesSource, error) {
+	return &NPMPackagesSource{
+		svc:    svc,
+		config: c,
+		client: npm.NewClient(c, doer),
+	}, nil
+}
+
+// ListRepos returns all npm packages accessible to all connections configured
+// in Sourcegraph via the external services configuration.
+func (s *NPMPackagesSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	dependencies, err := NPMDependencies(*s.config)
+	if err != nil {
+		results <- SourceResult{Err: err}
+		return
+	}
+
+	seen := make(map[reposource.NPMPackage]bool)
+	for _, dependency := range dependencies {
+		if seen[dependency.NPMPackage] {
+			continue
+		}
+
+		// Like for JVM packages, we don't return packages that don't
+		// resolve, so that gitserver doesn't fail to clone them over and
+		// over.
+		if exists, err := s.client.Exists(ctx, dependency); !exists {
+			if err != nil {
+				log15.Warn("failed to resolve npm package", "package", dependency.PackageManagerSyntax(), "error", err)
+			} else {
+				log15.Warn("npm package not found in registry", "package", dependency.PackageManagerSyntax())
+			}
+			continue
+		}
+
+		seen[dependency.NPMPackage] = true
+		results <- SourceResult{
+			Source: s,
+			Repo:   s.makeRepo(dependency.NPMPackage),
+		}
+	}
+}
+
+func (s *NPMPackagesSource) makeRepo(pkg reposource.NPMPackage) *types.Repo {
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: pkg.RepoName(),
+		URI:  string(pkg.RepoName()),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          string(pkg.RepoName()),
+			ServiceID:   extsvc.TypeNPMPackages,
+			ServiceType: extsvc.TypeNPMPackages,
+		},
+		Private: false,
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: pkg.CloneURL(),
+			},
+		},
+		Metadata: &npmpackages.Metadata{
+			Package: pkg,
+		},
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *NPMPackagesSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+// NPMDependencies returns the parsed dependencies of the connection.
+func NPMDependencies(connection schema.NPMPackagesConnection) (dependencies []reposource.NPMDependency, err error) {
+	for _, dep := range connection.Dependencies {
+		dependency, err := reposource.ParseNPMDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies, nil
+}
@@ -0,0 +1,61 @@
+package repos
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestNPMPackagesSource_ListRepos(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/react/17.0.2", "/react/16.14.0", "/@types%2Freact/17.0.2":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"dist": map[string]string{"tarball": "unused"}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer registry.Close()
+
+	svc := &types.ExternalService{ID: 1, Kind: extsvc.KindNPMPackages}
+	src, err := newNPMPackagesSource(svc, &schema.NPMPackagesConnection{
+		Registry: registry.URL,
+		Dependencies: []string{
+			"react@17.0.2",
+			"react@16.14.0",
+			"@types/react@17.0.2",
+			"left-pad@1.3.0", // not in the registry
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := make(chan SourceResult)
+	go func() {
+		src.ListRepos(context.Background(), results)
+		close(results)
+	}()
+
+	var names []api.RepoName
+	for res := range results {
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		names = append(names, res.Repo.Name)
+	}
+
+	want := []api.RepoName{"npm/react", "npm/@types/react"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Fatalf("unexpected repos (-want +got):\n%s", diff)
+	}
+}
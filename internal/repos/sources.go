@@ -60,6 +60,10 @@ func NewSource(svc *types.ExternalService, cf *httpcli.Factory) (Source, error)
 		return NewPerforceSource(svc)
 	case extsvc.KindJVMPackages:
 		return NewJVMPackagesSource(svc)
+	case extsvc.KindNPMPackages:
+		return NewNPMPackagesSource(svc)
+	case extsvc.KindGoModules:
+		return NewGoModulesSource(svc)
 	case extsvc.KindOther:
 		return NewOtherSource(svc, cf)
 	default:
@@ -0,0 +1,179 @@
+// Package blame post-filters text and symbol search results by the git blame
+// of the matched lines.
+package blame
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+// Filter only keeps the lines of file matches that were last changed by a
+// matching author after a given date, according to git blame.
+type Filter struct {
+	// Author is matched against the name and the email address of the author
+	// of the commit that last changed a line. It is ignored if nil.
+	Author *regexp.Regexp
+
+	// After is the date after which a line must have been last changed. It
+	// is ignored if zero.
+	After time.Time
+
+	// BlameFile is called to blame a file. It is intended to be mocked by
+	// tests. If nil, git.BlameFile is used.
+	BlameFile func(context.Context, api.RepoName, string, *git.BlameOptions) ([]*git.Hunk, error)
+}
+
+// FilterFromQuery returns the filter for the blameauthor: and blameafter:
+// fields of q, or nil if q has neither.
+func FilterFromQuery(q query.Q) (*Filter, error) {
+	author, _ := q.StringValue(query.FieldBlameAuthor)
+	after, _ := q.StringValue(query.FieldBlameAfter)
+	if author == "" && after == "" {
+		return nil, nil
+	}
+
+	f := &Filter{}
+	if author != "" {
+		if !q.IsCaseSensitive() {
+			author = "(?i:" + author + ")"
+		}
+		re, err := regexp.Compile(author)
+		if err != nil {
+			return nil, err
+		}
+		f.Author = re
+	}
+	if after != "" {
+		t, err := query.ParseGitDate(after, time.Now)
+		if err != nil {
+			return nil, err
+		}
+		f.After = t
+	}
+	return f, nil
+}
+
+// Apply removes the lines and symbols of the file matches in event that don't
+// satisfy the filter, as well as all other matches. It blames at most the
+// range of matched lines of each file, and stops once ctx is done, such that
+// its cost is bounded by the limits and the timeout of the search.
+func (f *Filter) Apply(ctx context.Context, event *streaming.SearchEvent) error {
+	errs := &multierror.Error{}
+	filtered := event.Results[:0]
+	for _, match := range event.Results {
+		if ctx.Err() != nil {
+			break
+		}
+
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+
+		hunks, err := f.blame(ctx, fm)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+
+		if fm = f.filterFileMatch(fm, hunks); fm != nil {
+			filtered = append(filtered, fm)
+		}
+	}
+	event.Results = filtered
+	return errs.ErrorOrNil()
+}
+
+// blame returns the blame hunks of the lines spanned by the line matches and
+// symbols of fm.
+func (f *Filter) blame(ctx context.Context, fm *result.FileMatch) ([]*git.Hunk, error) {
+	// Lines are 1-indexed for git blame.
+	var startLine, endLine int
+	addLine := func(line int) {
+		if startLine == 0 || line < startLine {
+			startLine = line
+		}
+		if line > endLine {
+			endLine = line
+		}
+	}
+	for _, lm := range fm.LineMatches {
+		addLine(int(lm.LineNumber) + 1)
+	}
+	for _, sym := range fm.Symbols {
+		addLine(sym.Symbol.Line)
+	}
+	if startLine == 0 {
+		return nil, nil
+	}
+
+	blameFile := f.BlameFile
+	if blameFile == nil {
+		blameFile = git.BlameFile
+	}
+	hunks, err := blameFile(ctx, fm.Repo.Name, fm.Path, &git.BlameOptions{
+		NewestCommit: fm.CommitID,
+		StartLine:    startLine,
+		EndLine:      endLine,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "blaming %s in %s", fm.Path, fm.Repo.Name)
+	}
+	return hunks, nil
+}
+
+// filterFileMatch returns a copy of fm with only the lines and symbols that
+// satisfy the filter according to hunks, or nil if there are none.
+func (f *Filter) filterFileMatch(fm *result.FileMatch, hunks []*git.Hunk) *result.FileMatch {
+	matches := func(line int) bool {
+		for _, hunk := range hunks {
+			if hunk.StartLine <= line && line < hunk.EndLine {
+				return f.matchesHunk(hunk)
+			}
+		}
+		return false
+	}
+
+	var lineMatches []*result.LineMatch
+	for _, lm := range fm.LineMatches {
+		if matches(int(lm.LineNumber) + 1) {
+			lineMatches = append(lineMatches, lm)
+		}
+	}
+	var symbols []*result.SymbolMatch
+	for _, sym := range fm.Symbols {
+		if matches(sym.Symbol.Line) {
+			symbols = append(symbols, sym)
+		}
+	}
+	if len(lineMatches) == 0 && len(symbols) == 0 {
+		return nil
+	}
+
+	return &result.FileMatch{
+		File:        fm.File,
+		LineMatches: lineMatches,
+		Symbols:     symbols,
+		LimitHit:    fm.LimitHit,
+	}
+}
+
+func (f *Filter) matchesHunk(hunk *git.Hunk) bool {
+	if f.Author != nil && !f.Author.MatchString(hunk.Author.Name) && !f.Author.MatchString(hunk.Author.Email) {
+		return false
+	}
+	if !f.After.IsZero() && !hunk.Author.Date.After(f.After) {
+		return false
+	}
+	return true
+}
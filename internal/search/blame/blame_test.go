@@ -0,0 +1,131 @@
+package blame
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git/gitapi"
+)
+
+func TestFilterFromQuery(t *testing.T) {
+	parse := func(input string) query.Q {
+		t.Helper()
+		plan, err := query.Pipeline(query.Init(input, query.SearchTypeRegex))
+		require.NoError(t, err)
+		return plan.ToParseTree()
+	}
+
+	f, err := FilterFromQuery(parse("panic"))
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	f, err = FilterFromQuery(parse(`panic blameauthor:Alice blameafter:"2021-01-02"`))
+	require.NoError(t, err)
+	require.True(t, f.Author.MatchString("alice"))
+	require.Equal(t, time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), f.After)
+
+	f, err = FilterFromQuery(parse(`panic blameauthor:Alice case:yes`))
+	require.NoError(t, err)
+	require.False(t, f.Author.MatchString("alice"))
+	require.True(t, f.After.IsZero())
+}
+
+func TestFilterApply(t *testing.T) {
+	alice := gitapi.Signature{Name: "Alice", Email: "alice@example.com", Date: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)}
+	bob := gitapi.Signature{Name: "Bob", Email: "bob@example.com", Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	var blamed []git.BlameOptions
+	blameFile := func(_ context.Context, _ api.RepoName, path string, opt *git.BlameOptions) ([]*git.Hunk, error) {
+		blamed = append(blamed, *opt)
+		return []*git.Hunk{
+			{StartLine: 1, EndLine: 3, Author: bob},
+			{StartLine: 3, EndLine: 5, Author: alice},
+		}, nil
+	}
+
+	file := result.File{Repo: types.MinimalRepo{Name: "repo"}, CommitID: "deadbeef", Path: "main.go"}
+	newEvent := func() *streaming.SearchEvent {
+		return &streaming.SearchEvent{Results: []result.Match{
+			&result.FileMatch{
+				File: file,
+				LineMatches: []*result.LineMatch{
+					{LineNumber: 1, Preview: "bob"},
+					{LineNumber: 3, Preview: "alice"},
+				},
+			},
+			&result.FileMatch{File: file}, // path match
+			&result.RepoMatch{Name: "repo"},
+		}}
+	}
+
+	cases := []struct {
+		name   string
+		filter *Filter
+		want   []string
+	}{{
+		name:   "author",
+		filter: &Filter{Author: regexpMustCompile(t, "alice@")},
+		want:   []string{"alice"},
+	}, {
+		name:   "after",
+		filter: &Filter{After: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)},
+		want:   []string{"alice"},
+	}, {
+		name:   "author and after",
+		filter: &Filter{Author: regexpMustCompile(t, "Bob"), After: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)},
+		want:   nil,
+	}, {
+		name:   "no constraints",
+		filter: &Filter{},
+		want:   []string{"bob", "alice"},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			blamed = nil
+			tc.filter.BlameFile = blameFile
+
+			event := newEvent()
+			require.NoError(t, tc.filter.Apply(context.Background(), event))
+
+			var got []string
+			for _, match := range event.Results {
+				for _, lm := range match.(*result.FileMatch).LineMatches {
+					got = append(got, lm.Preview)
+				}
+			}
+			require.Equal(t, tc.want, got)
+
+			// Only the range of matched lines of the file with line matches is blamed.
+			require.Equal(t, []git.BlameOptions{{NewestCommit: "deadbeef", StartLine: 2, EndLine: 4}}, blamed)
+		})
+	}
+
+	t.Run("canceled context", func(t *testing.T) {
+		blamed = nil
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		event := newEvent()
+		require.NoError(t, (&Filter{BlameFile: blameFile}).Apply(ctx, event))
+		require.Empty(t, event.Results)
+		require.Empty(t, blamed)
+	})
+}
+
+func regexpMustCompile(t *testing.T, expr string) *regexp.Regexp {
+	t.Helper()
+	re, err := regexp.Compile(expr)
+	require.NoError(t, err)
+	return re
+}
@@ -198,6 +198,7 @@ func searchRevsToGitserverRevs(in []search.RevisionSpecifier) []gitprotocol.Revi
 	for _, rev := range in {
 		out = append(out, gitprotocol.RevisionSpecifier{
 			RevSpec:        rev.RevSpec,
+			ExcludeRevSpec: rev.ExcludeRevSpec,
 			RefGlob:        rev.RefGlob,
 			ExcludeRefGlob: rev.ExcludeRefGlob,
 		})
@@ -271,6 +272,16 @@ func queryParameterToPredicate(parameter query.Parameter, caseSensitive, diff bo
 		newPred = &gitprotocol.CommitAfter{Time: t}
 	case query.FieldMessage:
 		newPred = &gitprotocol.MessageMatches{Expr: parameter.Value, IgnoreCase: !caseSensitive}
+	case query.FieldTrailer:
+		key, expr, _ := query.ParseTrailer(parameter.Value) // field already validated
+		newPred = &gitprotocol.TrailerMatches{Key: key, Expr: expr, IgnoreCase: !caseSensitive}
+	case query.FieldMerge:
+		switch query.ParseYesNoOnly(parameter.Value) {
+		case query.Yes:
+			newPred = &gitprotocol.ParentCount{Min: 0, Max: -1}
+		case query.Only:
+			newPred = &gitprotocol.ParentCount{Min: 2, Max: -1}
+		}
 	case query.FieldContent:
 		if diff {
 			newPred = &gitprotocol.DiffMatches{Expr: parameter.Value, IgnoreCase: !caseSensitive}
@@ -134,6 +134,26 @@ func TestQueryToGitQuery(t *testing.T) {
 			&protocol.MessageMatches{Expr: "message2", IgnoreCase: true},
 			&protocol.DiffModifiesFile{Expr: "file", IgnoreCase: true},
 		),
+	}, {
+		name: "trailer and merge nodes are converted",
+		input: []query.Node{
+			query.Parameter{Field: query.FieldTrailer, Value: "Co-authored-by: alice"},
+			query.Parameter{Field: query.FieldTrailer, Value: "Signed-off-by", Negated: true},
+			query.Parameter{Field: query.FieldMerge, Value: "only"},
+		},
+		diff: false,
+		output: protocol.NewAnd(
+			&protocol.ParentCount{Min: 2, Max: -1},
+			&protocol.TrailerMatches{Key: "Co-authored-by", Expr: "alice", IgnoreCase: true},
+			protocol.NewNot(&protocol.TrailerMatches{Key: "Signed-off-by", Expr: ".*", IgnoreCase: true}),
+		),
+	}, {
+		name: "merge:no does not add a node",
+		input: []query.Node{
+			query.Parameter{Field: query.FieldMerge, Value: "no"},
+		},
+		diff:   false,
+		output: &protocol.Boolean{Value: true},
 	}}
 
 	for _, tc := range cases {
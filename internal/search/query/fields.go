@@ -26,6 +26,16 @@ const (
 	FieldAuthor    = "author"
 	FieldCommitter = "committer"
 	FieldMessage   = "message"
+	FieldTrailer   = "trailer"
+	FieldMerge     = "merge"
+
+	// For text and symbol search only:
+	FieldBlameAuthor = "blameauthor"
+	FieldBlameAfter  = "blameafter"
+
+	// For symbol search only:
+	FieldSymbolKind   = "symbolkind"
+	FieldSymbolParent = "symbolparent"
 
 	// Temporary experimental fields:
 	FieldIndex     = "index"
@@ -63,6 +73,12 @@ var allFields = map[string]struct{}{
 	FieldMessage:            empty,
 	"m":                     empty,
 	"msg":                   empty,
+	FieldTrailer:            empty,
+	FieldMerge:              empty,
+	FieldBlameAuthor:        empty,
+	FieldBlameAfter:         empty,
+	FieldSymbolKind:         empty,
+	FieldSymbolParent:       empty,
 	FieldIndex:              empty,
 	FieldCount:              empty,
 	FieldTimeout:            empty,
@@ -411,6 +411,15 @@ func (q Q) valueToTypedValue(field, value string, label labels) []*Value {
 		FieldMessage, "m", "msg":
 		return []*Value{{Regexp: parseRegexpOrPanic(field, value)}}
 
+	case FieldBlameAuthor:
+		return []*Value{{Regexp: parseRegexpOrPanic(field, value)}}
+
+	case FieldSymbolKind:
+		return []*Value{{String: &value}}
+
+	case FieldSymbolParent:
+		return []*Value{{Regexp: parseRegexpOrPanic(field, value)}}
+
 	case
 		FieldIndex,
 		FieldCount,
@@ -275,6 +275,22 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return err
 	}
 
+	isValidSymbolKind := func() error {
+		if _, err := filter.SelectPathFromString(filter.Symbol + "." + value); err != nil {
+			return errors.Errorf("unknown symbol kind: %q", value)
+		}
+		return nil
+	}
+
+	isValidTrailer := func() error {
+		_, expr, err := ParseTrailer(value)
+		if err != nil {
+			return err
+		}
+		_, err = regexp.Compile(expr)
+		return err
+	}
+
 	isValidGitDate := func() error {
 		_, err := ParseGitDate(value, time.Now)
 		return err
@@ -331,6 +347,18 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldCommitter,
 		FieldMessage:
 		return satisfies(isValidRegexp)
+	case
+		FieldBlameAuthor:
+		return satisfies(isSingular, isNotNegated, isValidRegexp)
+	case
+		FieldBlameAfter:
+		return satisfies(isSingular, isNotNegated, isValidGitDate)
+	case
+		FieldTrailer:
+		return satisfies(isValidTrailer)
+	case
+		FieldMerge:
+		return satisfies(isSingular, isNotNegated, isYesNoOnly)
 	case
 		FieldIndex,
 		FieldFork,
@@ -351,6 +379,12 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldSelect:
 		return satisfies(isSingular, isNotNegated, isValidSelect)
+	case
+		FieldSymbolKind:
+		return satisfies(isNotNegated, isValidSymbolKind)
+	case
+		FieldSymbolParent:
+		return satisfies(isSingular, isNotNegated, isValidRegexp)
 	default:
 		return isUnrecognizedField()
 	}
@@ -398,7 +432,7 @@ func validateCommitParameters(nodes []Node) error {
 	var seenCommitParam string
 	var typeCommitExists bool
 	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
-		if field == FieldAuthor || field == FieldBefore || field == FieldAfter || field == FieldMessage {
+		if field == FieldAuthor || field == FieldBefore || field == FieldAfter || field == FieldMessage || field == FieldTrailer || field == FieldMerge {
 			seenCommitParam = field
 		}
 		if field == FieldType && (value == "commit" || value == "diff") {
@@ -411,6 +445,43 @@ func validateCommitParameters(nodes []Node) error {
 	return nil
 }
 
+// Queries containing symbol parameters without type:symbol are not valid.
+func validateSymbolParameters(nodes []Node) error {
+	var seenSymbolParam string
+	var typeSymbolExists bool
+	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
+		if field == FieldSymbolKind || field == FieldSymbolParent {
+			seenSymbolParam = field
+		}
+		if field == FieldType && value == "symbol" {
+			typeSymbolExists = true
+		}
+	})
+	if seenSymbolParam != "" && !typeSymbolExists {
+		return errors.Errorf(`your query contains the field '%s', which requires type:symbol in the query`, seenSymbolParam)
+	}
+	return nil
+}
+
+// Queries containing blame parameters are not valid for commit and diff
+// searches, whose results have no lines to blame.
+func validateBlameParameters(nodes []Node) error {
+	var seenBlameParam string
+	var typeCommitExists bool
+	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
+		if field == FieldBlameAuthor || field == FieldBlameAfter {
+			seenBlameParam = field
+		}
+		if field == FieldType && (value == "commit" || value == "diff") {
+			typeCommitExists = true
+		}
+	})
+	if seenBlameParam != "" && typeCommitExists {
+		return errors.Errorf(`your query contains the field '%s', which is not supported for type:commit or type:diff. Use author: or after: instead`, seenBlameParam)
+	}
+	return nil
+}
+
 func validateTypeStructural(nodes []Node) error {
 	seenStructural := false
 	seenType := false
@@ -554,6 +625,8 @@ func validate(nodes []Node) error {
 		validateRepoRevPair,
 		validateRepoHasFile,
 		validateCommitParameters,
+		validateSymbolParameters,
+		validateBlameParameters,
 		validateTypeStructural,
 		validateRefGlobs,
 	)
@@ -587,6 +660,24 @@ func ParseYesNoOnly(s string) YesNoOnly {
 	}
 }
 
+// ParseTrailer parses the value of a trailer: field of the form `Key: regexp`
+// into the trailer key and the regexp its value must match. If the value
+// only consists of the key, any value of the trailer matches.
+func ParseTrailer(s string) (key, expr string, err error) {
+	key = s
+	if i := strings.Index(s, ":"); i >= 0 {
+		key, expr = s[:i], strings.TrimSpace(s[i+1:])
+	}
+	key = strings.TrimSpace(key)
+	if expr == "" {
+		expr = ".*"
+	}
+	if key == "" {
+		return "", "", errors.Errorf(`invalid value %q for field %q. Valid values look like "Co-authored-by: alice"`, s, FieldTrailer)
+	}
+	return key, expr, nil
+}
+
 func ContainsRefGlobs(q Q) bool {
 	containsRefGlobs := false
 	if repoFilterValues, _ := q.Repositories(); len(repoFilterValues) > 0 {
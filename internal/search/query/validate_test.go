@@ -83,6 +83,30 @@ func TestValidation(t *testing.T) {
 			input: "repo:foo author:rob@saucegraph.com",
 			want:  `your query contains the field 'author', which requires type:commit or type:diff in the query`,
 		},
+		{
+			input: "repo:foo trailer:Signed-off-by",
+			want:  `your query contains the field 'trailer', which requires type:commit or type:diff in the query`,
+		},
+		{
+			input: "type:commit trailer:': alice'",
+			want:  `invalid value ": alice" for field "trailer". Valid values look like "Co-authored-by: alice"`,
+		},
+		{
+			input: "type:diff blameauthor:alice",
+			want:  `your query contains the field 'blameauthor', which is not supported for type:commit or type:diff. Use author: or after: instead`,
+		},
+		{
+			input: `panic blameafter:"not a date"`,
+			want:  "invalid date format",
+		},
+		{
+			input: "type:commit merge:maybe",
+			want:  `invalid value "maybe" for field "merge". Valid values are: yes, only, no`,
+		},
+		{
+			input: "type:commit -merge:only",
+			want:  `field "merge" does not support negation`,
+		},
 		{
 			input: "repohasfile:README type:symbol yolo",
 			want:  "repohasfile is not compatible for type:symbol. Subscribe to https://github.com/sourcegraph/sourcegraph/issues/4610 for updates",
@@ -99,6 +123,22 @@ func TestValidation(t *testing.T) {
 			input: "type:symbol select:symbol.timelime",
 			want:  `invalid field "timelime" on select path "symbol.timelime"`,
 		},
+		{
+			input: "type:symbol symbolkind:timeline Store",
+			want:  `unknown symbol kind: "timeline"`,
+		},
+		{
+			input: "type:symbol -symbolkind:interface Store",
+			want:  `field "symbolkind" does not support negation`,
+		},
+		{
+			input: "type:symbol symbolparent:a symbolparent:b Store",
+			want:  `field "symbolparent" may not be used more than once`,
+		},
+		{
+			input: "symbolkind:interface Store",
+			want:  `your query contains the field 'symbolkind', which requires type:symbol in the query`,
+		},
 		{
 			input:      "nice try type:repo",
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents",
@@ -124,6 +124,7 @@ func ToTextPatternInfo(q query.Basic, p Protocol, transform query.BasicPass) *Te
 	filesExclude = append(filesExclude, mapSlice(langExclude, LangToFileRegexp)...)
 	filesReposMustInclude, filesReposMustExclude := IncludeExcludeValues(q, query.FieldRepoHasFile)
 	selector, _ := filter.SelectPathFromString(q.FindValue(query.FieldSelect)) // Invariant: select is validated
+	symbolKinds, _ := IncludeExcludeValues(q, query.FieldSymbolKind)
 	count := count(q, p)
 
 	// Ugly assumption: for a literal search, the IsRegexp member of
@@ -171,6 +172,8 @@ func ToTextPatternInfo(q query.Basic, p Protocol, transform query.BasicPass) *Te
 		CombyRule:                    q.FindValue(query.FieldCombyRule),
 		Index:                        q.Index(),
 		Select:                       selector,
+		SymbolKinds:                  symbolKinds,
+		SymbolParentPattern:          q.FindValue(query.FieldSymbolParent),
 	}
 }
 
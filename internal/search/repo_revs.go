@@ -11,13 +11,18 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
 
-// RevisionSpecifier represents either a revspec or a ref glob. At most one
-// field is set. The default branch is represented by all fields being empty.
+// RevisionSpecifier represents either a revspec, an excluded revspec or a ref
+// glob. At most one field is set. The default branch is represented by all
+// fields being empty.
 type RevisionSpecifier struct {
 	// RevSpec is a revision range specifier suitable for passing to git. See
 	// the manpage gitrevisions(7).
 	RevSpec string
 
+	// ExcludeRevSpec is a revspec whose reachable commits are excluded. It is
+	// only used by commit and diff searches, see "^<rev>" in gitrevisions(7).
+	ExcludeRevSpec string
+
 	// RefGlob is a reference glob to pass to git. See the documentation for
 	// "--glob" in git-log.
 	RefGlob string
@@ -28,6 +33,9 @@ type RevisionSpecifier struct {
 }
 
 func (r1 RevisionSpecifier) String() string {
+	if r1.ExcludeRevSpec != "" {
+		return "^" + r1.ExcludeRevSpec
+	}
 	if r1.ExcludeRefGlob != "" {
 		return "*!" + r1.ExcludeRefGlob
 	}
@@ -46,6 +54,9 @@ func (r1 RevisionSpecifier) Less(r2 RevisionSpecifier) bool {
 	if r1.RevSpec != r2.RevSpec {
 		return r1.RevSpec < r2.RevSpec
 	}
+	if r1.ExcludeRevSpec != r2.ExcludeRevSpec {
+		return r1.ExcludeRevSpec < r2.ExcludeRevSpec
+	}
 	if r1.RefGlob != r2.RefGlob {
 		return r1.RefGlob < r2.RefGlob
 	}
@@ -110,6 +121,9 @@ func (r *RepositoryRevisions) Equal(other *RepositoryRevisions) bool {
 // - 'foo@*bar' refers to the 'foo' repo and all refs matching the glob 'bar/*',
 //   because git interprets the ref glob 'bar' as being 'bar/*' (see `man git-log`
 //   section on the --glob flag)
+// - 'foo@bar:^baz' and 'foo@baz..bar' both refer to the 'foo' repo and the
+//   commits reachable from 'bar' but not from 'baz'. An omitted end of a range
+//   refers to HEAD, like in git.
 func ParseRepositoryRevisions(repoAndOptionalRev string) (string, []RevisionSpecifier) {
 	i := strings.Index(repoAndOptionalRev, "@")
 	if i == -1 {
@@ -127,7 +141,7 @@ func ParseRepositoryRevisions(repoAndOptionalRev string) (string, []RevisionSpec
 		if part == "" {
 			continue
 		}
-		revs = append(revs, parseRev(part))
+		revs = append(revs, parseRevs(part)...)
 	}
 	if len(revs) == 0 {
 		revs = []RevisionSpecifier{{RevSpec: ""}} // default branch
@@ -135,13 +149,26 @@ func ParseRepositoryRevisions(repoAndOptionalRev string) (string, []RevisionSpec
 	return repo, revs
 }
 
-func parseRev(spec string) RevisionSpecifier {
+// parseRevs parses a single revspec, ref glob or range. A range `a..b` is
+// split into the included revspec b and the excluded revspec a. Symmetric
+// differences (`a...b`) are passed through as-is.
+func parseRevs(spec string) []RevisionSpecifier {
 	if strings.HasPrefix(spec, "*!") {
-		return RevisionSpecifier{ExcludeRefGlob: spec[2:]}
+		return []RevisionSpecifier{{ExcludeRefGlob: spec[2:]}}
 	} else if strings.HasPrefix(spec, "*") {
-		return RevisionSpecifier{RefGlob: spec[1:]}
+		return []RevisionSpecifier{{RefGlob: spec[1:]}}
+	} else if strings.HasPrefix(spec, "^") {
+		return []RevisionSpecifier{{ExcludeRevSpec: spec[1:]}}
+	}
+
+	if i := strings.Index(spec, ".."); i >= 0 && !strings.Contains(spec, "...") {
+		exclude, include := spec[:i], spec[i+2:]
+		if exclude == "" {
+			exclude = "HEAD"
+		}
+		return []RevisionSpecifier{{RevSpec: include}, {ExcludeRevSpec: exclude}}
 	}
-	return RevisionSpecifier{RevSpec: spec}
+	return []RevisionSpecifier{{RevSpec: spec}}
 }
 
 // GitserverRepo is a convenience function to return the api.RepoName for
@@ -165,7 +192,7 @@ func (r *RepositoryRevisions) String() string {
 // OnlyExplicit returns true if all revspecs in Revs are explicit.
 func (r *RepositoryRevisions) OnlyExplicit() bool {
 	for _, rev := range r.Revs {
-		if rev.RefGlob != "" || rev.ExcludeRefGlob != "" {
+		if rev.RefGlob != "" || rev.ExcludeRefGlob != "" || rev.ExcludeRevSpec != "" {
 			return false
 		}
 	}
@@ -173,11 +200,11 @@ func (r *RepositoryRevisions) OnlyExplicit() bool {
 }
 
 // RevSpecs returns a list of all explicitly listed Git revspecs. It does not expand ref globs to
-// their matching revspecs.
+// their matching revspecs, and it does not include excluded revspecs.
 func (r *RepositoryRevisions) RevSpecs() []string {
 	var revspecs []string
 	for _, rev := range r.Revs {
-		if rev.RefGlob == "" && rev.ExcludeRefGlob == "" {
+		if rev.RefGlob == "" && rev.ExcludeRefGlob == "" && rev.ExcludeRevSpec == "" {
 			revspecs = append(revspecs, rev.RevSpec)
 		}
 	}
@@ -225,6 +252,8 @@ func expandedRevSpec(ctx context.Context, r *RepositoryRevisions) ([]string, err
 			globs = append(globs, git.RefGlob{Include: rev.RefGlob})
 		case rev.ExcludeRefGlob != "":
 			globs = append(globs, git.RefGlob{Exclude: rev.ExcludeRefGlob})
+		case rev.ExcludeRevSpec != "":
+			// Only commit and diff searches exclude the history of a revspec.
 		default:
 			revSpecs[rev.RevSpec] = struct{}{}
 		}
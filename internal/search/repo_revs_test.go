@@ -18,8 +18,12 @@ func TestParseRepositoryRevisions(t *testing.T) {
 		"repo@*glob":     {repo: "repo", revs: []RevisionSpecifier{{RefGlob: "glob"}}},
 		"repo@rev1:*glob1:^rev2": {
 			repo: "repo",
-			revs: []RevisionSpecifier{{RevSpec: "rev1"}, {RefGlob: "glob1"}, {RevSpec: "^rev2"}},
+			revs: []RevisionSpecifier{{RevSpec: "rev1"}, {RefGlob: "glob1"}, {ExcludeRevSpec: "rev2"}},
 		},
+		"repo@rev1..rev2":  {repo: "repo", revs: []RevisionSpecifier{{RevSpec: "rev2"}, {ExcludeRevSpec: "rev1"}}},
+		"repo@rev1..":      {repo: "repo", revs: []RevisionSpecifier{{RevSpec: ""}, {ExcludeRevSpec: "rev1"}}},
+		"repo@..rev2":      {repo: "repo", revs: []RevisionSpecifier{{RevSpec: "rev2"}, {ExcludeRevSpec: "HEAD"}}},
+		"repo@rev1...rev2": {repo: "repo", revs: []RevisionSpecifier{{RevSpec: "rev1...rev2"}}},
 		"repo@rev1:*glob1:*!glob2:rev2:*glob3": {
 			repo: "repo",
 			revs: []RevisionSpecifier{
@@ -264,7 +264,11 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (Resolved
 				repoRev.Revs = append(repoRev.Revs, rev)
 				continue
 			}
-			if rev.RevSpec == "" { // skip default branch resolution to save time
+			revSpec := rev.RevSpec
+			if rev.ExcludeRevSpec != "" {
+				revSpec = rev.ExcludeRevSpec
+			}
+			if revSpec == "" { // skip default branch resolution to save time
 				repoRev.Revs = append(repoRev.Revs, rev)
 				continue
 			}
@@ -279,8 +283,7 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (Resolved
 			// searches like "repo:@foobar" (where foobar is an invalid revspec on most repos)
 			// taking a long time because they all ask gitserver to try to fetch from the remote
 			// repo.
-			trimmedRefSpec := strings.TrimPrefix(rev.RevSpec, "^") // handle negated revisions, such as ^<branch>, ^<tag>, or ^<commit>
-			if _, err := git.ResolveRevision(ctx, repoRev.GitserverRepo(), trimmedRefSpec, git.ResolveRevisionOptions{NoEnsureRevision: true}); err != nil {
+			if _, err := git.ResolveRevision(ctx, repoRev.GitserverRepo(), revSpec, git.ResolveRevisionOptions{NoEnsureRevision: true}); err != nil {
 				if errors.Is(err, context.DeadlineExceeded) {
 					return Resolved{}, context.DeadlineExceeded
 				}
@@ -289,13 +292,9 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (Resolved
 				}
 				if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
 					// The revspec does not exist, so don't include it, and report that it's missing.
-					if rev.RevSpec == "" {
-						// Report as HEAD not "" (empty string) to avoid user confusion.
-						rev.RevSpec = "HEAD"
-					}
 					res.MissingRepoRevs = append(res.MissingRepoRevs, &search.RepositoryRevisions{
 						Repo: repo,
-						Revs: []search.RevisionSpecifier{{RevSpec: rev.RevSpec}},
+						Revs: []search.RevisionSpecifier{{RevSpec: revSpec}},
 					})
 				}
 				// If err != nil and is not one of the err values checked for above, cloning and other errors will be handled later, so just ignore an error
@@ -68,7 +68,7 @@ func TestRevisionValidation(t *testing.T) {
 						ExcludeRefGlob: "",
 					},
 					{
-						RevSpec:        "^revBas",
+						ExcludeRevSpec: "revBas",
 						RefGlob:        "",
 						ExcludeRefGlob: "",
 					},
@@ -112,7 +112,7 @@ func TestRevisionValidation(t *testing.T) {
 				Repo: types.MinimalRepo{Name: "repoFoo"},
 				Revs: []search.RevisionSpecifier{
 					{
-						RevSpec:        "^revQux",
+						RevSpec:        "revQux",
 						RefGlob:        "",
 						ExcludeRefGlob: "",
 					},
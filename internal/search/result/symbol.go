@@ -3,6 +3,7 @@ package result
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -307,6 +308,25 @@ func pick(symbols []*SymbolMatch, satisfy func(*SymbolMatch) bool) []*SymbolMatc
 
 func SelectSymbolKind(symbols []*SymbolMatch, field string) []*SymbolMatch {
 	return pick(symbols, func(s *SymbolMatch) bool {
-		return field == toSelectKind[strings.ToLower(s.Symbol.Kind)]
+		return field == s.Symbol.SelectKind()
 	})
 }
+
+// SelectKind returns the kind of the symbol as used by select:symbol.<kind>,
+// or an empty string if the kind has no equivalent.
+func (s Symbol) SelectKind() string {
+	return toSelectKind[strings.ToLower(s.Kind)]
+}
+
+// KindsOfSelectKind returns the lowercase ctags kinds of the symbols that
+// select:symbol.<kind> selects, in sorted order.
+func KindsOfSelectKind(selectKind string) []string {
+	var kinds []string
+	for kind, v := range toSelectKind {
+		if v == selectKind {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
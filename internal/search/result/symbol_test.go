@@ -1,6 +1,7 @@
 package result
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -67,3 +68,25 @@ func TestSymbolURL(t *testing.T) {
 		})
 	}
 }
+
+func TestKindsOfSelectKind(t *testing.T) {
+	for _, symbol := range []Symbol{{Kind: "func"}, {Kind: "Function"}, {Kind: "interface"}} {
+		kind := symbol.SelectKind()
+		if kinds := KindsOfSelectKind(kind); !containsLower(kinds, symbol.Kind) {
+			t.Errorf("KindsOfSelectKind(%q) = %v does not contain %q", kind, kinds, symbol.Kind)
+		}
+	}
+
+	if kinds := KindsOfSelectKind("no-such-kind"); len(kinds) != 0 {
+		t.Errorf("expected no kinds, got %v", kinds)
+	}
+}
+
+func containsLower(kinds []string, kind string) bool {
+	for _, k := range kinds {
+		if k == strings.ToLower(kind) {
+			return true
+		}
+	}
+	return false
+}
@@ -139,6 +139,10 @@ type EventCommitMatch struct {
 	Repository      string     `json:"repository"`
 	RepoStars       int        `json:"repoStars,omitempty"`
 	RepoLastFetched *time.Time `json:"repoLastFetched,omitempty"`
+	OID             string     `json:"oid"`
+	Message         string     `json:"message"`
+	AuthorName      string     `json:"authorName"`
+	AuthorDate      time.Time  `json:"authorDate"`
 	Content         string     `json:"content"`
 	// [line, character, length]
 	Ranges [][3]int32 `json:"ranges"`
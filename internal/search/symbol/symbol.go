@@ -20,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	searchrepos "github.com/sourcegraph/sourcegraph/internal/search/repos"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -56,7 +57,7 @@ func symbolSearchInRepos(
 		run.Acquire()
 		goroutine.Go(func() {
 			defer run.Release()
-			err := request.Search(ctx, stream)
+			err := request.Search(ctx, withSymbolFilters(stream, patternInfo))
 			if err != nil {
 				tr.LogFields(otlog.Error(err))
 				// Only record error if we haven't timed out.
@@ -161,6 +162,8 @@ func searchInRepo(ctx context.Context, repoRevs *search.RepositoryRevisions, pat
 		IsRegExp:        patternInfo.IsRegExp,
 		IncludePatterns: patternInfo.IncludePatterns,
 		ExcludePattern:  patternInfo.ExcludePattern,
+		Kinds:           symbolKinds(patternInfo),
+		ParentPattern:   patternInfo.SymbolParentPattern,
 		// Ask for limit + 1 so we can detect whether there are more results than the limit.
 		First: limit + 1,
 	})
@@ -202,6 +205,76 @@ func searchInRepo(ctx context.Context, repoRevs *search.RepositoryRevisions, pat
 	return matches, err
 }
 
+// symbolKinds returns the kinds of symbols to ask the symbols service for.
+// A select:symbol.<kind> filter is applied to the results anyway, so it is only
+// pushed down if there are no symbolkind: filters.
+func symbolKinds(patternInfo *search.TextPatternInfo) []string {
+	if len(patternInfo.SymbolKinds) > 0 {
+		return patternInfo.SymbolKinds
+	}
+	if patternInfo.Select.Root() == filter.Symbol && len(patternInfo.Select) > 1 {
+		return []string{patternInfo.Select[1]}
+	}
+	return nil
+}
+
+// withSymbolFilters returns a stream that applies the symbolkind: and
+// symbolparent: filters to the symbols in the events sent to stream. Zoekt
+// can't filter symbols by kind or parent, unlike the symbols service.
+func withSymbolFilters(stream streaming.Sender, patternInfo *search.TextPatternInfo) streaming.Sender {
+	if len(patternInfo.SymbolKinds) == 0 && patternInfo.SymbolParentPattern == "" {
+		return stream
+	}
+
+	kinds := make(map[string]struct{}, len(patternInfo.SymbolKinds))
+	for _, kind := range patternInfo.SymbolKinds {
+		kinds[kind] = struct{}{}
+	}
+
+	var parentRegexp *regexp.Regexp
+	if patternInfo.SymbolParentPattern != "" {
+		pattern := patternInfo.SymbolParentPattern
+		if !patternInfo.IsCaseSensitive {
+			pattern = "(?i:" + pattern + ")"
+		}
+		parentRegexp = regexp.MustCompile(pattern) // Invariant: symbolparent is validated
+	}
+
+	keep := func(s *result.SymbolMatch) bool {
+		if len(kinds) > 0 {
+			if _, ok := kinds[s.Symbol.SelectKind()]; !ok {
+				return false
+			}
+		}
+		return parentRegexp == nil || parentRegexp.MatchString(s.Symbol.Parent)
+	}
+
+	return streaming.StreamFunc(func(event streaming.SearchEvent) {
+		matches := event.Results[:0]
+		for _, match := range event.Results {
+			fm, ok := match.(*result.FileMatch)
+			if !ok {
+				matches = append(matches, match)
+				continue
+			}
+
+			symbols := fm.Symbols[:0]
+			for _, s := range fm.Symbols {
+				if keep(s) {
+					symbols = append(symbols, s)
+				}
+			}
+			if len(symbols) == 0 {
+				continue
+			}
+			fm.Symbols = symbols
+			matches = append(matches, fm)
+		}
+		event.Results = matches
+		stream.Send(event)
+	})
+}
+
 // indexedSymbols checks to see if Zoekt has indexed symbols information for a
 // repository at a specific commit. If it has it returns the branch name (for
 // use when querying zoekt). Otherwise an empty string is returned.
@@ -54,6 +54,15 @@ type SymbolsParameters struct {
 	// need to match to get included in the result
 	ExcludePattern string
 
+	// Kinds is an optional list of symbol kinds, using the values of
+	// select:symbol.<kind>. If non-empty, only symbols of one of the kinds
+	// are included in the result.
+	Kinds []string
+
+	// ParentPattern is an optional regex that the parent (scope) of symbols
+	// needs to match to get included in the result.
+	ParentPattern string
+
 	// First indicates that only the first n symbols should be returned.
 	First int
 }
@@ -193,6 +202,14 @@ type TextPatternInfo struct {
 	PatternMatchesPath    bool
 
 	Languages []string
+
+	// SymbolKinds are the kinds of symbols to search for, using the values
+	// of select:symbol.<kind>. Only applies to symbol search.
+	SymbolKinds []string
+
+	// SymbolParentPattern is a regex that the parent (scope) of symbols must
+	// match. Only applies to symbol search.
+	SymbolParentPattern string
 }
 
 func (p *TextPatternInfo) String() string {
@@ -225,6 +242,12 @@ func (p *TextPatternInfo) String() string {
 	for _, lang := range p.Languages {
 		args = append(args, fmt.Sprintf("lang:%s", lang))
 	}
+	for _, kind := range p.SymbolKinds {
+		args = append(args, fmt.Sprintf("symbolkind:%s", kind))
+	}
+	if p.SymbolParentPattern != "" {
+		args = append(args, fmt.Sprintf("symbolparent:%q", p.SymbolParentPattern))
+	}
 
 	for _, inc := range p.FilePatternsReposMustInclude {
 		args = append(args, fmt.Sprintf("repositoryPathPattern:%s", inc))
@@ -103,6 +103,10 @@ func redactionInfo(cfg interface{}) ([]jsonStringField, error) {
 		return []jsonStringField{}, nil
 	case *schema.JVMPackagesConnection:
 		return []jsonStringField{{[]string{"maven", "credentials"}, &cfg.Maven.Credentials}}, nil
+	case *schema.NPMPackagesConnection:
+		return []jsonStringField{{[]string{"credentials"}, &cfg.Credentials}}, nil
+	case *schema.GoModulesConnection:
+		return []jsonStringField{}, nil
 	case *schema.OtherExternalServiceConnection:
 		return []jsonStringField{{[]string{"url"}, &cfg.Url}}, nil
 	default:
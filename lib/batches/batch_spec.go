@@ -41,11 +41,13 @@ type BatchSpec struct {
 }
 
 type ChangesetTemplate struct {
-	Title     string                       `json:"title,omitempty" yaml:"title"`
-	Body      string                       `json:"body,omitempty" yaml:"body"`
-	Branch    string                       `json:"branch,omitempty" yaml:"branch"`
-	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
-	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+	Title      string                       `json:"title,omitempty" yaml:"title"`
+	Body       string                       `json:"body,omitempty" yaml:"body"`
+	Branch     string                       `json:"branch,omitempty" yaml:"branch"`
+	Commit     ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
+	Published  *overridable.BoolOrString    `json:"published" yaml:"published"`
+	AutoMerge  AutoMergeStrategy            `json:"autoMerge,omitempty" yaml:"autoMerge"`
+	AutoRebase bool                         `json:"autoRebase,omitempty" yaml:"autoRebase"`
 }
 
 type GitCommitAuthor struct {
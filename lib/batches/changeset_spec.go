@@ -81,6 +81,9 @@ type ChangesetSpec struct {
 	Commits []GitCommitDescription `json:"commits,omitempty"`
 
 	Published PublishedValue `json:"published,omitempty"`
+
+	AutoMerge  AutoMergeStrategy `json:"autoMerge,omitempty"`
+	AutoRebase bool              `json:"autoRebase,omitempty"`
 }
 
 // MarshalJSON overwrites the default behavior of the json lib while unmarshalling
@@ -103,6 +106,8 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Body           string                 `json:"body,omitempty"`
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
 		Published      *PublishedValue        `json:"published,omitempty"`
+		AutoMerge      AutoMergeStrategy      `json:"autoMerge,omitempty"`
+		AutoRebase     bool                   `json:"autoRebase,omitempty"`
 	}{
 		BaseRepository: c.BaseRepository,
 		ExternalID:     c.ExternalID,
@@ -113,6 +118,8 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Title:          c.Title,
 		Body:           c.Body,
 		Commits:        c.Commits,
+		AutoMerge:      c.AutoMerge,
+		AutoRebase:     c.AutoRebase,
 	}
 	if !c.Published.Nil() {
 		v.Published = &c.Published
@@ -120,6 +127,22 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&v)
 }
 
+// AutoMergeStrategy is the kind of merge performed when a changeset is merged
+// automatically. The empty strategy disables automatic merges.
+type AutoMergeStrategy string
+
+const (
+	AutoMergeStrategyNone   AutoMergeStrategy = ""
+	AutoMergeStrategyMerge  AutoMergeStrategy = "merge"
+	AutoMergeStrategySquash AutoMergeStrategy = "squash"
+)
+
+// Enabled returns true if the changeset should be merged automatically.
+func (s AutoMergeStrategy) Enabled() bool { return s != AutoMergeStrategyNone }
+
+// Squash returns true if the changeset should be squash merged.
+func (s AutoMergeStrategy) Squash() bool { return s == AutoMergeStrategySquash }
+
 type GitCommitDescription struct {
 	Message     string `json:"message,omitempty"`
 	Diff        string `json:"diff,omitempty"`
@@ -136,7 +136,9 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 					Diff:        diff,
 				},
 			},
-			Published: PublishedValue{Val: published},
+			Published:  PublishedValue{Val: published},
+			AutoMerge:  input.Template.AutoMerge,
+			AutoRebase: input.Template.AutoRebase,
 		}, nil
 	}
 
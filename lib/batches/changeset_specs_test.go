@@ -181,6 +181,33 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			features: featuresWithoutOptionalPublished,
 			want:     nil,
 			wantErr:  errOptionalPublishedUnsupported.Error(),
+		},		{
+			name: "auto merge",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Template.AutoMerge = AutoMergeStrategySquash
+			}),
+			features: featuresAllEnabled,
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.AutoMerge = AutoMergeStrategySquash
+				}),
+			},
+			wantErr: "",
+		},
+		{
+			name: "auto rebase",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Template.AutoRebase = true
+			}),
+			features: featuresAllEnabled,
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.AutoRebase = true
+				}),
+			},
+			wantErr: "",
 		},
 	}
 
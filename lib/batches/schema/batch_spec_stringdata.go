@@ -153,7 +153,7 @@ const BatchSpecJSON = `{
                     },
                     {
                       "type": "object",
-                      "description": "An environment variable to set in the step environment: the value will be passed through from the environment src is running within.",
+                      "description": "An environment variable to set in the step environment: the key is used as the environment variable name and the value as the value.",
                       "additionalProperties": {
                         "type": "string"
                       },
@@ -339,6 +339,16 @@ const BatchSpecJSON = `{
               }
             }
           ]
+        },
+        "autoMerge": {
+          "type": "string",
+          "enum": ["merge", "squash"],
+          "description": "Merge the changesets automatically once all their checks have passed and they have been approved on the code host, respecting the rollout windows of the site. \"squash\" performs a squash merge on code hosts that support it, \"merge\" a regular merge. If omitted, changesets are not merged automatically."
+        },
+        "autoRebase": {
+          "type": "boolean",
+          "description": "Update the changesets automatically when their base branch moves or the code host reports them as not mergeable. The workspaces of the batch spec are executed again against the new base commit and the branches are force-pushed. Only supported for batch specs that are executed on Sourcegraph; otherwise the existing diff is applied to the new base.",
+          "default": false
         }
       }
     }
@@ -95,6 +95,15 @@ const ChangesetSpecJSON = `{
         "published": {
           "oneOf": [{ "type": "boolean" }, { "type": "string", "pattern": "^draft$" }, { "type": "null" }],
           "description": "Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host."
+        },
+        "autoMerge": {
+          "type": "string",
+          "enum": ["merge", "squash"],
+          "description": "Merge the changeset automatically once all its checks have passed and it has been approved on the code host. \"squash\" performs a squash merge on code hosts that support it, \"merge\" a regular merge. If omitted, the changeset is not merged automatically."
+        },
+        "autoRebase": {
+          "type": "boolean",
+          "description": "Update the changeset automatically when its base branch moves or the code host reports it as not mergeable, by force-pushing a commit created against the new base commit."
         }
       },
       "required": ["baseRepository", "baseRef", "baseRev", "headRepository", "headRef", "title", "body", "commits"],
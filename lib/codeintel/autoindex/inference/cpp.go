@@ -0,0 +1,85 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func CppPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("compile_commands.json")),
+		pathPattern(rawPattern("CMakeLists.txt")),
+	}
+}
+
+const lsifClangImage = "sourcegraph/lsif-clang:autoindex"
+
+func InferCppIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	// A checked-in compilation database can be indexed directly, without having to
+	// configure the project first.
+	for _, path := range paths {
+		if !isCompilationDatabasePath(path) {
+			continue
+		}
+
+		indexes = append(indexes, config.IndexJob{
+			Steps:       nil,
+			Root:        dirWithoutDot(path),
+			Indexer:     lsifClangImage,
+			IndexerArgs: []string{"lsif-clang", "--project-root=.", "compile_commands.json"},
+			Outfile:     "dump.lsif",
+		})
+	}
+	if len(indexes) > 0 {
+		return indexes
+	}
+
+	for _, path := range paths {
+		if !isCMakeProjectPath(path, paths) {
+			continue
+		}
+
+		root := dirWithoutDot(path)
+
+		indexes = append(indexes, config.IndexJob{
+			Steps: []config.DockerStep{
+				{
+					Root:     root,
+					Image:    lsifClangImage,
+					Commands: []string{"cmake -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON"},
+				},
+			},
+			Root:        root,
+			Indexer:     lsifClangImage,
+			IndexerArgs: []string{"lsif-clang", "--project-root=.", "build/compile_commands.json"},
+			Outfile:     "dump.lsif",
+		})
+	}
+
+	return indexes
+}
+
+var cppSegmentBlockList = append([]string{"third_party", "vendor"}, segmentBlockList...)
+
+func isCompilationDatabasePath(path string) bool {
+	return filepath.Base(path) == "compile_commands.json" && containsNoSegments(path, cppSegmentBlockList...)
+}
+
+// isCMakeProjectPath returns true if the given path is a top-level CMakeLists.txt file.
+// Nested CMakeLists.txt files are generally included by a parent project via the
+// add_subdirectory command and cannot be configured on their own.
+func isCMakeProjectPath(path string, paths []string) bool {
+	if filepath.Base(path) != "CMakeLists.txt" || !containsNoSegments(path, cppSegmentBlockList...) {
+		return false
+	}
+
+	for _, dir := range ancestorDirs(path)[1:] {
+		if contains(paths, filepath.Join(dir, "CMakeLists.txt")) {
+			return false
+		}
+	}
+
+	return true
+}
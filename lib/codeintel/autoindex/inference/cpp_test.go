@@ -0,0 +1,93 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestCppPatterns(t *testing.T) {
+	testLangPatterns(t, CppPatterns(), []PathTestCase{
+		{"compile_commands.json", true},
+		{"build/compile_commands.json", true},
+		{"CMakeLists.txt", true},
+		{"src/CMakeLists.txt", true},
+		{"main.cpp", false},
+		{"Makefile", false},
+	})
+}
+
+func TestInferCppIndexJobs(t *testing.T) {
+	testCases := []struct {
+		description       string
+		paths             []string
+		expectedIndexJobs []config.IndexJob
+	}{
+		{
+			description:       "no build configuration",
+			paths:             []string{"main.cpp", "Makefile"},
+			expectedIndexJobs: nil,
+		},
+		{
+			description: "compilation database",
+			paths:       []string{"compile_commands.json", "CMakeLists.txt"},
+			expectedIndexJobs: []config.IndexJob{
+				{
+					Steps:       nil,
+					Root:        "",
+					Indexer:     lsifClangImage,
+					IndexerArgs: []string{"lsif-clang", "--project-root=.", "compile_commands.json"},
+					Outfile:     "dump.lsif",
+				},
+			},
+		},
+		{
+			description: "nested cmake projects",
+			paths:       []string{"CMakeLists.txt", "src/CMakeLists.txt", "src/lib/CMakeLists.txt"},
+			expectedIndexJobs: []config.IndexJob{
+				cmakeIndexJob(""),
+			},
+		},
+		{
+			description: "independent cmake projects",
+			paths:       []string{"a/CMakeLists.txt", "a/src/CMakeLists.txt", "b/CMakeLists.txt"},
+			expectedIndexJobs: []config.IndexJob{
+				cmakeIndexJob("a"),
+				cmakeIndexJob("b"),
+			},
+		},
+		{
+			description: "blocklisted directories",
+			paths:       []string{"third_party/zlib/CMakeLists.txt", "examples/CMakeLists.txt", "core/CMakeLists.txt"},
+			expectedIndexJobs: []config.IndexJob{
+				cmakeIndexJob("core"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if diff := cmp.Diff(testCase.expectedIndexJobs, InferCppIndexJobs(NewMockGitClient(), testCase.paths)); diff != "" {
+				t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func cmakeIndexJob(root string) config.IndexJob {
+	return config.IndexJob{
+		Steps: []config.DockerStep{
+			{
+				Root:     root,
+				Image:    lsifClangImage,
+				Commands: []string{"cmake -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON"},
+			},
+		},
+		Root:        root,
+		Indexer:     lsifClangImage,
+		IndexerArgs: []string{"lsif-clang", "--project-root=.", "build/compile_commands.json"},
+		Outfile:     "dump.lsif",
+	}
+}
@@ -0,0 +1,77 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func CSharpPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		extensionPattern(rawPattern("sln")),
+		extensionPattern(rawPattern("csproj")),
+	}
+}
+
+const lsifDotnetImage = "sourcegraph/lsif-dotnet:autoindex"
+
+func InferCSharpIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	var solutionDirs []string
+	for _, path := range paths {
+		if !isCSharpSolutionPath(path) {
+			continue
+		}
+
+		solutionDirs = append(solutionDirs, dirWithoutDot(path))
+		indexes = append(indexes, dotnetIndexJob(path))
+	}
+
+	for _, path := range paths {
+		if !isCSharpProjectPath(path) {
+			continue
+		}
+
+		// Projects that are part of a solution in the same or an ancestor directory
+		// are indexed as part of that solution.
+		if containsAny(solutionDirs, ancestorDirs(path)) {
+			continue
+		}
+
+		indexes = append(indexes, dotnetIndexJob(path))
+	}
+
+	return indexes
+}
+
+// dotnetIndexJob creates an index job that restores and indexes the given solution
+// or project file from its containing directory.
+func dotnetIndexJob(path string) config.IndexJob {
+	root := dirWithoutDot(path)
+	file := filepath.Base(path)
+
+	return config.IndexJob{
+		Steps: []config.DockerStep{
+			{
+				Root:     root,
+				Image:    lsifDotnetImage,
+				Commands: []string{"dotnet restore " + file},
+			},
+		},
+		Root:        root,
+		Indexer:     lsifDotnetImage,
+		IndexerArgs: []string{"lsif-dotnet", file, "--output", "dump.lsif"},
+		Outfile:     "dump.lsif",
+	}
+}
+
+var csharpSegmentBlockList = append([]string{"bin", "obj", "packages"}, segmentBlockList...)
+
+func isCSharpSolutionPath(path string) bool {
+	return strings.HasSuffix(path, ".sln") && containsNoSegments(path, csharpSegmentBlockList...)
+}
+
+func isCSharpProjectPath(path string) bool {
+	return strings.HasSuffix(path, ".csproj") && containsNoSegments(path, csharpSegmentBlockList...)
+}
@@ -0,0 +1,86 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestCSharpPatterns(t *testing.T) {
+	testLangPatterns(t, CSharpPatterns(), []PathTestCase{
+		{"App.sln", true},
+		{"src/App/App.csproj", true},
+		{"src/App/Program.cs", false},
+		{"App.sln/subdir", false},
+		{"App.fsproj", false},
+	})
+}
+
+func TestInferCSharpIndexJobs(t *testing.T) {
+	testCases := []struct {
+		description       string
+		paths             []string
+		expectedIndexJobs []config.IndexJob
+	}{
+		{
+			description:       "no solutions or projects",
+			paths:             []string{"Program.cs"},
+			expectedIndexJobs: nil,
+		},
+		{
+			description: "solution covering projects",
+			paths:       []string{"App.sln", "src/App/App.csproj", "src/Lib/Lib.csproj"},
+			expectedIndexJobs: []config.IndexJob{
+				csharpIndexJob("", "App.sln"),
+			},
+		},
+		{
+			description: "standalone project",
+			paths:       []string{"tools/Tool/Tool.csproj"},
+			expectedIndexJobs: []config.IndexJob{
+				csharpIndexJob("tools/Tool", "Tool.csproj"),
+			},
+		},
+		{
+			description: "solution and project outside of it",
+			paths:       []string{"server/Server.sln", "server/Api/Api.csproj", "tools/Tool/Tool.csproj"},
+			expectedIndexJobs: []config.IndexJob{
+				csharpIndexJob("server", "Server.sln"),
+				csharpIndexJob("tools/Tool", "Tool.csproj"),
+			},
+		},
+		{
+			description: "blocklisted directories",
+			paths:       []string{"App.csproj", "obj/App.csproj", "test/AppTests/AppTests.csproj"},
+			expectedIndexJobs: []config.IndexJob{
+				csharpIndexJob("", "App.csproj"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if diff := cmp.Diff(testCase.expectedIndexJobs, InferCSharpIndexJobs(NewMockGitClient(), testCase.paths)); diff != "" {
+				t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func csharpIndexJob(root, file string) config.IndexJob {
+	return config.IndexJob{
+		Steps: []config.DockerStep{
+			{
+				Root:     root,
+				Image:    lsifDotnetImage,
+				Commands: []string{"dotnet restore " + file},
+			},
+		},
+		Root:        root,
+		Indexer:     lsifDotnetImage,
+		IndexerArgs: []string{"lsif-dotnet", file, "--output", "dump.lsif"},
+		Outfile:     "dump.lsif",
+	}
+}
@@ -0,0 +1,70 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func PythonPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("setup.py")),
+		pathPattern(rawPattern("pyproject.toml")),
+		pathPattern(rawPattern("requirements.txt")),
+	}
+}
+
+const lsifPyImage = "sourcegraph/lsif-py:autoindex"
+
+func InferPythonIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	var roots []string
+	for _, path := range paths {
+		if !isPythonProjectPath(path) {
+			continue
+		}
+
+		// A project can be described by several of the files we match on (e.g. both
+		// a pyproject.toml and a requirements.txt); we only emit one job per directory.
+		if root := dirWithoutDot(path); !contains(roots, root) {
+			roots = append(roots, root)
+		}
+	}
+
+	for _, root := range roots {
+		var commands []string
+		if contains(paths, filepath.Join(root, "requirements.txt")) {
+			commands = append(commands, "pip install -r requirements.txt")
+		}
+		if contains(paths, filepath.Join(root, "setup.py")) || contains(paths, filepath.Join(root, "pyproject.toml")) {
+			commands = append(commands, "pip install .")
+		}
+
+		indexes = append(indexes, config.IndexJob{
+			Steps: []config.DockerStep{
+				{
+					Root:     root,
+					Image:    lsifPyImage,
+					Commands: commands,
+				},
+			},
+			Root:        root,
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", "."},
+			Outfile:     "data.lsif",
+		})
+	}
+
+	return indexes
+}
+
+var pythonSegmentBlockList = append([]string{"venv", ".venv", "site-packages", "node_modules"}, segmentBlockList...)
+
+func isPythonProjectPath(path string) bool {
+	switch filepath.Base(path) {
+	case "setup.py", "pyproject.toml", "requirements.txt":
+		return containsNoSegments(path, pythonSegmentBlockList...)
+	}
+
+	return false
+}
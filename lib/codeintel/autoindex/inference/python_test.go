@@ -0,0 +1,96 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestPythonPatterns(t *testing.T) {
+	testLangPatterns(t, PythonPatterns(), []PathTestCase{
+		{"setup.py", true},
+		{"pyproject.toml", true},
+		{"requirements.txt", true},
+		{"subdir/setup.py", true},
+		{"subdir/requirements.txt", true},
+		{"dev-requirements.txt", false},
+		{"setup.py/subdir", false},
+		{"main.py", false},
+	})
+}
+
+func TestInferPythonIndexJobs(t *testing.T) {
+	testCases := []struct {
+		description       string
+		paths             []string
+		expectedIndexJobs []config.IndexJob
+	}{
+		{
+			description:       "no python projects",
+			paths:             []string{"main.py", "lib/util.py"},
+			expectedIndexJobs: nil,
+		},
+		{
+			description: "requirements file",
+			paths:       []string{"requirements.txt", "main.py"},
+			expectedIndexJobs: []config.IndexJob{
+				pythonIndexJob("", "pip install -r requirements.txt"),
+			},
+		},
+		{
+			description: "setup file",
+			paths:       []string{"setup.py", "pkg/__init__.py"},
+			expectedIndexJobs: []config.IndexJob{
+				pythonIndexJob("", "pip install ."),
+			},
+		},
+		{
+			description: "multiple project files in one directory",
+			paths:       []string{"pyproject.toml", "requirements.txt", "setup.py"},
+			expectedIndexJobs: []config.IndexJob{
+				pythonIndexJob("", "pip install -r requirements.txt", "pip install ."),
+			},
+		},
+		{
+			description: "projects in subdirectories",
+			paths:       []string{"a/pyproject.toml", "b/requirements.txt", "b/setup.py"},
+			expectedIndexJobs: []config.IndexJob{
+				pythonIndexJob("a", "pip install ."),
+				pythonIndexJob("b", "pip install -r requirements.txt", "pip install ."),
+			},
+		},
+		{
+			description: "blocklisted directories",
+			paths:       []string{"setup.py", "venv/lib/site-packages/six/setup.py", "tests/requirements.txt"},
+			expectedIndexJobs: []config.IndexJob{
+				pythonIndexJob("", "pip install ."),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if diff := cmp.Diff(testCase.expectedIndexJobs, InferPythonIndexJobs(NewMockGitClient(), testCase.paths)); diff != "" {
+				t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func pythonIndexJob(root string, commands ...string) config.IndexJob {
+	return config.IndexJob{
+		Steps: []config.DockerStep{
+			{
+				Root:     root,
+				Image:    lsifPyImage,
+				Commands: commands,
+			},
+		},
+		Root:        root,
+		Indexer:     lsifPyImage,
+		IndexerArgs: []string{"lsif-py", "."},
+		Outfile:     "data.lsif",
+	}
+}
@@ -9,3 +9,13 @@ func contains(haystack []string, needle string) bool {
 
 	return false
 }
+
+func containsAny(haystack []string, needles []string) bool {
+	for _, needle := range needles {
+		if contains(haystack, needle) {
+			return true
+		}
+	}
+
+	return false
+}
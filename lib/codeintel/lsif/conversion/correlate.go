@@ -29,6 +29,12 @@ func Correlate(ctx context.Context, r io.Reader, root string, getChildren pathex
 		return nil, err
 	}
 
+	return groupCorrelatedState(ctx, state, root, getChildren)
+}
+
+// groupCorrelatedState canonicalizes and prunes the given correlation state, then converts
+// it into the format we send to the writer.
+func groupCorrelatedState(ctx context.Context, state *State, root string, getChildren pathexistence.GetChildrenFunc) (*precise.GroupedBundleDataChans, error) {
 	// Remove duplicate elements, collapse linked elements
 	canonicalize(state)
 
@@ -0,0 +1,437 @@
+package conversion
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/conversion/datastructures"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/pathexistence"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+)
+
+// CorrelateSCIP reads a protobuf-encoded SCIP index from the given reader and returns the
+// same grouped bundle data produced by Correlate for an equivalent LSIF index.
+//
+// SCIP indexes are document-oriented: each occurrence of a symbol is converted into a range
+// attached to a result set shared by all occurrences of that symbol, so that the remainder
+// of the conversion process (canonicalization, pruning, and grouping) is shared with LSIF.
+//
+// If getChildren == nil, no pruning of irrelevant data is performed.
+func CorrelateSCIP(ctx context.Context, r io.Reader, root string, getChildren pathexistence.GetChildrenFunc) (*precise.GroupedBundleDataChans, error) {
+	// Read raw upload stream and return a correlation state
+	state, err := correlateFromSCIPReader(ctx, r, root)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupCorrelatedState(ctx, state, root, getChildren)
+}
+
+// correlateFromSCIPReader reads the given SCIP index and returns a correlation state object.
+// The data in the correlation state is neither canonicalized nor pruned.
+func correlateFromSCIPReader(ctx context.Context, r io.Reader, root string) (*State, error) {
+	state := newSCIPState(root)
+
+	if err := scip.ReadIndex(r, scip.IndexVisitor{
+		VisitMetadata: state.correlateMetadata,
+		VisitDocument: func(document *scip.Document) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := state.correlateDocument(document); err != nil {
+				return errors.Wrapf(err, "document %q malformed", document.RelativePath)
+			}
+
+			return nil
+		},
+		VisitExternalSymbol: state.correlateExternalSymbol,
+	}); err != nil {
+		return nil, err
+	}
+
+	if state.ProjectRoot == "" {
+		return nil, ErrMissingMetaData
+	}
+
+	state.finalize()
+	return state.State, nil
+}
+
+// scipState wraps a correlation state with the bookkeeping necessary to map symbol strings
+// onto the result sets, result identifiers, and monikers of the correlation state.
+type scipState struct {
+	*State
+	dumpRoot          string
+	indexProjectRoot  string
+	nextID            int
+	globalSymbols     map[string]*scipSymbol
+	symbols           []*scipSymbol
+	implementations   map[string]map[string]struct{} // implemented symbol -> implementing symbols
+	packageInfoByName map[string]int
+}
+
+// scipSymbol tracks the correlation state identifiers assigned to a single symbol.
+type scipSymbol struct {
+	resultSetID            int
+	definitionResultID     int
+	referenceResultID      int
+	implementationResultID int
+	hoverResultID          int
+	symbol                 string
+	local                  bool
+	defined                bool
+}
+
+func newSCIPState(dumpRoot string) *scipState {
+	return &scipState{
+		State:             newState(),
+		dumpRoot:          dumpRoot,
+		globalSymbols:     map[string]*scipSymbol{},
+		implementations:   map[string]map[string]struct{}{},
+		packageInfoByName: map[string]int{},
+	}
+}
+
+// id returns a fresh identifier for a new element of the correlation state.
+func (s *scipState) id() int {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *scipState) correlateMetadata(metadata *scip.Metadata) error {
+	projectRoot := metadata.ProjectRoot
+	if !strings.HasSuffix(projectRoot, "/") {
+		projectRoot += "/"
+	}
+	s.indexProjectRoot = projectRoot
+
+	// Document paths are relative to the project root of the index. We normalize the project
+	// root exactly as we do for LSIF indexes: it is assumed to be either the root of the index
+	// or the root of the repository, in which case we append the dump root.
+	if s.dumpRoot != "" && !strings.HasSuffix(projectRoot, "/"+s.dumpRoot) {
+		projectRoot += s.dumpRoot
+	}
+
+	s.ProjectRoot = projectRoot
+	return nil
+}
+
+func (s *scipState) correlateDocument(document *scip.Document) error {
+	if s.ProjectRoot == "" {
+		return ErrMissingMetaData
+	}
+
+	relativePath, err := filepath.Rel(s.ProjectRoot, s.indexProjectRoot+document.RelativePath)
+	if err != nil {
+		return errors.Errorf("document path %q is not relative to project root %q (%s)", document.RelativePath, s.ProjectRoot, err)
+	}
+
+	documentID := s.id()
+	s.DocumentData[documentID] = relativePath
+
+	// Local symbols are only unique within the document that declares them
+	localSymbols := map[string]*scipSymbol{}
+	getSymbol := func(symbol string) *scipSymbol {
+		if scip.IsLocalSymbol(symbol) {
+			if _, ok := localSymbols[symbol]; !ok {
+				localSymbols[symbol] = s.newSymbol(symbol, true)
+			}
+
+			return localSymbols[symbol]
+		}
+
+		return s.getGlobalSymbol(symbol)
+	}
+
+	for _, symbolInformation := range document.Symbols {
+		if symbolInformation.Symbol == "" {
+			continue
+		}
+
+		symbol := getSymbol(symbolInformation.Symbol)
+		symbol.defined = true
+		s.correlateSymbolInformation(symbol, symbolInformation)
+	}
+
+	var diagnostics []Diagnostic
+	for _, occurrence := range document.Occurrences {
+		occurrenceRange, err := scip.NewRange(occurrence.Range)
+		if err != nil {
+			return err
+		}
+
+		for _, diagnostic := range occurrence.Diagnostics {
+			diagnostics = append(diagnostics, Diagnostic{
+				Severity:       int(diagnostic.Severity),
+				Code:           diagnostic.Code,
+				Message:        diagnostic.Message,
+				Source:         diagnostic.Source,
+				StartLine:      int(occurrenceRange.StartLine),
+				StartCharacter: int(occurrenceRange.StartCharacter),
+				EndLine:        int(occurrenceRange.EndLine),
+				EndCharacter:   int(occurrenceRange.EndCharacter),
+			})
+		}
+
+		if occurrence.Symbol == "" {
+			continue
+		}
+		symbol := getSymbol(occurrence.Symbol)
+
+		rangeID := s.id()
+		rangeData := Range{
+			Range: reader.Range{
+				RangeData: protocol.RangeData{
+					Start: protocol.Pos{
+						Line:      int(occurrenceRange.StartLine),
+						Character: int(occurrenceRange.StartCharacter),
+					},
+					End: protocol.Pos{
+						Line:      int(occurrenceRange.EndLine),
+						Character: int(occurrenceRange.EndCharacter),
+					},
+				},
+			},
+		}
+
+		if len(occurrence.OverrideDocumentation) > 0 {
+			hoverResultID := s.id()
+			s.HoverData[hoverResultID] = strings.Join(occurrence.OverrideDocumentation, reader.HoverPartSeparator)
+			rangeData = rangeData.SetHoverResultID(hoverResultID)
+		}
+
+		s.RangeData[rangeID] = rangeData
+		s.NextData[rangeID] = symbol.resultSetID
+		s.Contains.SetAdd(documentID, rangeID)
+
+		// Definitions are also included in the set of references, matching the output of
+		// the majority of LSIF indexers
+		if occurrence.HasRole(scip.SymbolRole_Definition) {
+			symbol.defined = true
+			s.definitionResult(symbol).SetAdd(documentID, rangeID)
+		}
+		s.referenceResult(symbol).SetAdd(documentID, rangeID)
+	}
+
+	if len(diagnostics) > 0 {
+		diagnosticResultID := s.id()
+		s.DiagnosticResults[diagnosticResultID] = diagnostics
+		s.Diagnostics.SetAdd(documentID, diagnosticResultID)
+	}
+
+	return nil
+}
+
+func (s *scipState) correlateExternalSymbol(symbolInformation *scip.SymbolInformation) error {
+	if symbolInformation.Symbol == "" || scip.IsLocalSymbol(symbolInformation.Symbol) {
+		return nil
+	}
+
+	s.correlateSymbolInformation(s.getGlobalSymbol(symbolInformation.Symbol), symbolInformation)
+	return nil
+}
+
+// correlateSymbolInformation attaches the documentation and relationships of the given symbol
+// information to the given symbol.
+func (s *scipState) correlateSymbolInformation(symbol *scipSymbol, symbolInformation *scip.SymbolInformation) {
+	if len(symbolInformation.Documentation) > 0 && symbol.hoverResultID == 0 {
+		symbol.hoverResultID = s.id()
+		s.HoverData[symbol.hoverResultID] = strings.Join(symbolInformation.Documentation, reader.HoverPartSeparator)
+	}
+
+	if symbol.local {
+		return
+	}
+
+	for _, relationship := range symbolInformation.Relationships {
+		if relationship.IsImplementation && relationship.Symbol != "" && !scip.IsLocalSymbol(relationship.Symbol) {
+			if _, ok := s.implementations[relationship.Symbol]; !ok {
+				s.implementations[relationship.Symbol] = map[string]struct{}{}
+			}
+			s.implementations[relationship.Symbol][symbolInformation.Symbol] = struct{}{}
+		}
+	}
+}
+
+func (s *scipState) getGlobalSymbol(symbol string) *scipSymbol {
+	if _, ok := s.globalSymbols[symbol]; !ok {
+		s.globalSymbols[symbol] = s.newSymbol(symbol, false)
+	}
+
+	return s.globalSymbols[symbol]
+}
+
+func (s *scipState) newSymbol(symbol string, local bool) *scipSymbol {
+	scipSymbol := &scipSymbol{
+		resultSetID: s.id(),
+		symbol:      symbol,
+		local:       local,
+	}
+
+	s.symbols = append(s.symbols, scipSymbol)
+	return scipSymbol
+}
+
+// definitionResult returns the definition result of the given symbol, creating it if necessary.
+func (s *scipState) definitionResult(symbol *scipSymbol) *datastructures.DefaultIDSetMap {
+	if symbol.definitionResultID == 0 {
+		symbol.definitionResultID = s.id()
+		s.DefinitionData[symbol.definitionResultID] = datastructures.NewDefaultIDSetMap()
+	}
+
+	return s.DefinitionData[symbol.definitionResultID]
+}
+
+// referenceResult returns the reference result of the given symbol, creating it if necessary.
+func (s *scipState) referenceResult(symbol *scipSymbol) *datastructures.DefaultIDSetMap {
+	if symbol.referenceResultID == 0 {
+		symbol.referenceResultID = s.id()
+		s.ReferenceData[symbol.referenceResultID] = datastructures.NewDefaultIDSetMap()
+	}
+
+	return s.ReferenceData[symbol.referenceResultID]
+}
+
+// finalize populates the result set and moniker data of each symbol. This must happen once
+// the entire index has been read, as the kind of a symbol's moniker depends on whether or
+// not the symbol is defined anywhere in the index.
+func (s *scipState) finalize() {
+	s.correlateImplementations()
+
+	for _, symbol := range s.symbols {
+		s.ResultSetData[symbol.resultSetID] = ResultSet{
+			DefinitionResultID:     symbol.definitionResultID,
+			ReferenceResultID:      symbol.referenceResultID,
+			ImplementationResultID: symbol.implementationResultID,
+			HoverResultID:          symbol.hoverResultID,
+		}
+
+		if symbol.local {
+			continue
+		}
+
+		kind := "import"
+		if symbol.defined {
+			kind = "export"
+		}
+
+		if monikerID, ok := s.addMoniker(symbol.symbol, kind); ok {
+			s.Monikers.SetAdd(symbol.resultSetID, monikerID)
+		}
+	}
+}
+
+// correlateImplementations adds an implementation moniker to each symbol that implements
+// another symbol, and populates implementation results for implemented symbols with the
+// definitions of their implementations that occur in this index.
+func (s *scipState) correlateImplementations() {
+	implementedSymbols := make([]string, 0, len(s.implementations))
+	for implementedSymbol := range s.implementations {
+		implementedSymbols = append(implementedSymbols, implementedSymbol)
+	}
+	sort.Strings(implementedSymbols)
+
+	for _, implementedSymbol := range implementedSymbols {
+		target, hasTarget := s.globalSymbols[implementedSymbol]
+
+		for _, implementingSymbol := range sortedKeys(s.implementations[implementedSymbol]) {
+			symbol, ok := s.globalSymbols[implementingSymbol]
+			if !ok {
+				continue
+			}
+
+			if monikerID, ok := s.addMoniker(implementedSymbol, "implementation"); ok {
+				s.Monikers.SetAdd(symbol.resultSetID, monikerID)
+			}
+
+			if !hasTarget || symbol.definitionResultID == 0 {
+				continue
+			}
+
+			if target.implementationResultID == 0 {
+				target.implementationResultID = s.id()
+				s.ImplementationData[target.implementationResultID] = datastructures.NewDefaultIDSetMap()
+			}
+
+			implementations := s.ImplementationData[target.implementationResultID]
+			s.DefinitionData[symbol.definitionResultID].Each(func(documentID int, rangeIDs *datastructures.IDSet) {
+				implementations.SetUnion(documentID, rangeIDs)
+			})
+		}
+	}
+}
+
+// addMoniker creates a moniker of the given kind for the given global symbol. The scheme of
+// the moniker is the scheme of the symbol and the identifier is the symbol's descriptors. The
+// moniker is linked to the symbol's package so that it can be matched with other indexes. If
+// the symbol cannot be parsed, false is returned.
+func (s *scipState) addMoniker(symbol, kind string) (int, bool) {
+	parsed, err := scip.ParseSymbol(symbol)
+	if err != nil {
+		return 0, false
+	}
+
+	moniker := Moniker{
+		Moniker: reader.Moniker{
+			Kind:       kind,
+			Scheme:     parsed.Scheme,
+			Identifier: parsed.Descriptors,
+		},
+	}
+	if parsed.Package.GetName() != "" {
+		moniker = moniker.SetPackageInformationID(s.packageInformationID(parsed.Package))
+	}
+
+	monikerID := s.id()
+	s.MonikerData[monikerID] = moniker
+
+	if moniker.PackageInformationID != 0 {
+		// Monikers without package information cannot be linked to other indexes
+		switch kind {
+		case "import":
+			s.ImportedMonikers.Add(monikerID)
+		case "export":
+			s.ExportedMonikers.Add(monikerID)
+		case "implementation":
+			s.ImplementedMonikers.Add(monikerID)
+		}
+	}
+
+	return monikerID, true
+}
+
+// packageInformationID returns the identifier of the package information element for the
+// given package, creating it if necessary.
+func (s *scipState) packageInformationID(pkg *scip.Package) int {
+	key := makeKey(pkg.Name, pkg.Version)
+	if id, ok := s.packageInfoByName[key]; ok {
+		return id
+	}
+
+	id := s.id()
+	s.PackageInformationData[id] = PackageInformation{
+		Name:    pkg.Name,
+		Version: pkg.Version,
+	}
+	s.packageInfoByName[key] = id
+	return id
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,243 @@
+package conversion
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip/sciptest"
+)
+
+const (
+	testSCIPBarSymbol   = "scip-go gomod example v1.0.0 `example`/Foo#Bar()."
+	testSCIPBarerSymbol = "scip-go gomod iface v2.0.0 `iface`/Barer#Bar()."
+	testSCIPBazSymbol   = "scip-go gomod dep v0.1.0 `dep`/Baz()."
+)
+
+func TestCorrelateSCIP(t *testing.T) {
+	metadata := &scip.Metadata{
+		ToolInfo:    &scip.ToolInfo{Name: "scip-go"},
+		ProjectRoot: "file:///test",
+	}
+
+	documents := []*scip.Document{
+		{
+			RelativePath: "root/foo.go",
+			Occurrences: []*scip.Occurrence{
+				{
+					Range:       []int32{1, 5, 8},
+					Symbol:      testSCIPBarSymbol,
+					SymbolRoles: int32(scip.SymbolRole_Definition),
+				},
+				{
+					Range:  []int32{3, 2, 5},
+					Symbol: testSCIPBazSymbol,
+					Diagnostics: []*scip.Diagnostic{
+						{Severity: scip.Severity_Warning, Code: "SA1019", Message: "deprecated", Source: "staticcheck"},
+					},
+				},
+				{
+					Range:       []int32{5, 1, 2},
+					Symbol:      "local 0",
+					SymbolRoles: int32(scip.SymbolRole_Definition),
+				},
+				{
+					Range:  []int32{6, 1, 2},
+					Symbol: "local 0",
+				},
+			},
+			Symbols: []*scip.SymbolInformation{
+				{
+					Symbol:        testSCIPBarSymbol,
+					Documentation: []string{"```go\nfunc (Foo) Bar()\n```", "Bar docs"},
+					Relationships: []*scip.Relationship{{Symbol: testSCIPBarerSymbol, IsImplementation: true}},
+				},
+			},
+		},
+		{
+			RelativePath: "root/bar.go",
+			Occurrences: []*scip.Occurrence{
+				{
+					Range:                 []int32{2, 3, 6},
+					Symbol:                testSCIPBarSymbol,
+					OverrideDocumentation: []string{"override"},
+				},
+				{
+					// Local symbols are scoped to their document
+					Range:  []int32{7, 1, 2},
+					Symbol: "local 0",
+				},
+			},
+		},
+	}
+
+	externalSymbols := []*scip.SymbolInformation{
+		{Symbol: testSCIPBazSymbol, Documentation: []string{"Baz docs"}},
+	}
+
+	var buf bytes.Buffer
+	if err := sciptest.WriteIndex(&buf, metadata, documents, externalSymbols); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+
+	bundleData, err := CorrelateSCIP(context.Background(), &buf, "root/", nil)
+	if err != nil {
+		t.Fatalf("unexpected error correlating index: %s", err)
+	}
+
+	documentsByPath := map[string]precise.DocumentData{}
+	for v := range bundleData.Documents {
+		documentsByPath[v.Path] = v.Document
+	}
+	for range bundleData.ResultChunks {
+		// drain so the grouping goroutine completes
+	}
+
+	type rangeSummary struct {
+		Line           int
+		Hover          string
+		HasDefinitions bool
+		HasReferences  bool
+	}
+	summarizeRanges := func(document precise.DocumentData) []rangeSummary {
+		var summaries []rangeSummary
+		for _, r := range document.Ranges {
+			summaries = append(summaries, rangeSummary{
+				Line:           r.StartLine,
+				Hover:          document.HoverResults[r.HoverResultID],
+				HasDefinitions: r.DefinitionResultID != "",
+				HasReferences:  r.ReferenceResultID != "",
+			})
+		}
+		sort.Slice(summaries, func(i, j int) bool { return summaries[i].Line < summaries[j].Line })
+		return summaries
+	}
+
+	expectedRanges := map[string][]rangeSummary{
+		"foo.go": {
+			{Line: 1, Hover: "```go\nfunc (Foo) Bar()\n```\n\n---\n\nBar docs", HasDefinitions: true, HasReferences: true},
+			{Line: 3, Hover: "Baz docs", HasReferences: true},
+			{Line: 5, HasDefinitions: true, HasReferences: true},
+			{Line: 6, HasDefinitions: true, HasReferences: true},
+		},
+		"bar.go": {
+			{Line: 2, Hover: "override", HasDefinitions: true, HasReferences: true},
+			{Line: 7, HasReferences: true},
+		},
+	}
+	actualRanges := map[string][]rangeSummary{}
+	for path, document := range documentsByPath {
+		actualRanges[path] = summarizeRanges(document)
+	}
+	if diff := cmp.Diff(expectedRanges, actualRanges); diff != "" {
+		t.Errorf("unexpected ranges (-want +got):\n%s", diff)
+	}
+
+	expectedDiagnostics := []precise.DiagnosticData{
+		{Severity: 2, Code: "SA1019", Message: "deprecated", Source: "staticcheck", StartLine: 3, StartCharacter: 2, EndLine: 3, EndCharacter: 5},
+	}
+	if diff := cmp.Diff(expectedDiagnostics, documentsByPath["foo.go"].Diagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+	}
+
+	var definitions []precise.MonikerLocations
+	for v := range bundleData.Definitions {
+		definitions = append(definitions, v)
+	}
+	sortMonikerLocations(definitions)
+
+	expectedDefinitions := []precise.MonikerLocations{
+		{
+			Kind:       "export",
+			Scheme:     "scip-go",
+			Identifier: "`example`/Foo#Bar().",
+			Locations: []precise.LocationData{
+				{URI: "foo.go", StartLine: 1, StartCharacter: 5, EndLine: 1, EndCharacter: 8},
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedDefinitions, definitions); diff != "" {
+		t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+	}
+
+	var references []precise.MonikerLocations
+	for v := range bundleData.References {
+		references = append(references, v)
+	}
+	sortMonikerLocations(references)
+
+	expectedReferences := []precise.MonikerLocations{
+		{
+			Kind:       "import",
+			Scheme:     "scip-go",
+			Identifier: "`dep`/Baz().",
+			Locations: []precise.LocationData{
+				{URI: "foo.go", StartLine: 3, StartCharacter: 2, EndLine: 3, EndCharacter: 5},
+			},
+		},
+		{
+			Kind:       "export",
+			Scheme:     "scip-go",
+			Identifier: "`example`/Foo#Bar().",
+			Locations: []precise.LocationData{
+				{URI: "bar.go", StartLine: 2, StartCharacter: 3, EndLine: 2, EndCharacter: 6},
+				{URI: "foo.go", StartLine: 1, StartCharacter: 5, EndLine: 1, EndCharacter: 8},
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedReferences, references); diff != "" {
+		t.Errorf("unexpected references (-want +got):\n%s", diff)
+	}
+
+	var implementations []precise.MonikerLocations
+	for v := range bundleData.Implementations {
+		implementations = append(implementations, v)
+	}
+	sortMonikerLocations(implementations)
+
+	expectedImplementations := []precise.MonikerLocations{
+		{
+			Kind:       "implementation",
+			Scheme:     "scip-go",
+			Identifier: "`iface`/Barer#Bar().",
+			Locations: []precise.LocationData{
+				{URI: "foo.go", StartLine: 1, StartCharacter: 5, EndLine: 1, EndCharacter: 8},
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedImplementations, implementations); diff != "" {
+		t.Errorf("unexpected implementations (-want +got):\n%s", diff)
+	}
+
+	expectedPackages := []precise.Package{
+		{Scheme: "scip-go", Name: "example", Version: "v1.0.0"},
+	}
+	if diff := cmp.Diff(expectedPackages, bundleData.Packages); diff != "" {
+		t.Errorf("unexpected packages (-want +got):\n%s", diff)
+	}
+
+	var packageReferences []precise.Package
+	for _, packageReference := range bundleData.PackageReferences {
+		packageReferences = append(packageReferences, packageReference.Package)
+	}
+	sort.Slice(packageReferences, func(i, j int) bool { return packageReferences[i].Name < packageReferences[j].Name })
+
+	expectedPackageReferences := []precise.Package{
+		{Scheme: "scip-go", Name: "dep", Version: "v0.1.0"},
+		{Scheme: "scip-go", Name: "iface", Version: "v2.0.0"},
+	}
+	if diff := cmp.Diff(expectedPackageReferences, packageReferences); diff != "" {
+		t.Errorf("unexpected package references (-want +got):\n%s", diff)
+	}
+}
+
+func TestCorrelateSCIPMalformed(t *testing.T) {
+	if _, err := CorrelateSCIP(context.Background(), bytes.NewReader([]byte{0x12, 0x05, 0x0a}), "", nil); err == nil {
+		t.Fatalf("expected error correlating malformed index")
+	}
+}
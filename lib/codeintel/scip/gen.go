@@ -0,0 +1,6 @@
+package scip
+
+// Generating requires protoc. The Go plugin is built from the version of the
+// protobuf module that this module depends on.
+//go:generate go build -o .bin/protoc-gen-go google.golang.org/protobuf/cmd/protoc-gen-go
+//go:generate protoc --plugin=protoc-gen-go=.bin/protoc-gen-go --go_out=. --go_opt=paths=source_relative scip.proto
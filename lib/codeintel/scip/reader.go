@@ -0,0 +1,207 @@
+package scip
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrMalformedIndex occurs when the input cannot be decoded as a SCIP index.
+var ErrMalformedIndex = errors.New("malformed SCIP index")
+
+// ErrMissingMetadata occurs when an index does not begin with a metadata message.
+var ErrMissingMetadata = errors.New("SCIP index does not begin with metadata")
+
+// MaxMetadataSize is the maximum encoded size of the metadata message read by ReadMetadata.
+const MaxMetadataSize = 128 * 1024
+
+// MaxMessageSize is the maximum encoded size of a single top-level message (a document or an
+// external symbol) read by ReadIndex. Larger messages are rejected as malformed rather than
+// being buffered into memory.
+const MaxMessageSize = 256 * 1024 * 1024
+
+// Field numbers of the top-level Index message.
+const (
+	indexMetadataField        protowire.Number = 1
+	indexDocumentsField       protowire.Number = 2
+	indexExternalSymbolsField protowire.Number = 3
+)
+
+// IndexVisitor holds the functions invoked for each top-level message of an index. Nil
+// functions are skipped.
+type IndexVisitor struct {
+	VisitMetadata       func(metadata *Metadata) error
+	VisitDocument       func(document *Document) error
+	VisitExternalSymbol func(symbol *SymbolInformation) error
+}
+
+// ReadIndex decodes the given protobuf-encoded SCIP index and invokes the visitor for each
+// metadata, document, and external symbol message in the order that they occur. Only a single
+// top-level message is held in memory at a time, so arbitrarily large indexes can be read as
+// long as each individual message is no larger than MaxMessageSize. The ranges of the
+// occurrences of each document are validated with NewRange.
+func ReadIndex(r io.Reader, visitor IndexVisitor) error {
+	br := bufio.NewReader(r)
+
+	for {
+		num, payload, err := readTopLevelField(br, MaxMessageSize)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		switch num {
+		case indexMetadataField:
+			metadata := &Metadata{}
+			if err := unmarshal(payload, metadata); err != nil {
+				return err
+			}
+			if visitor.VisitMetadata != nil {
+				if err := visitor.VisitMetadata(metadata); err != nil {
+					return err
+				}
+			}
+
+		case indexDocumentsField:
+			document := &Document{}
+			if err := unmarshal(payload, document); err != nil {
+				return err
+			}
+			for _, occurrence := range document.Occurrences {
+				if _, err := NewRange(occurrence.Range); err != nil {
+					return err
+				}
+			}
+			if visitor.VisitDocument != nil {
+				if err := visitor.VisitDocument(document); err != nil {
+					return err
+				}
+			}
+
+		case indexExternalSymbolsField:
+			symbol := &SymbolInformation{}
+			if err := unmarshal(payload, symbol); err != nil {
+				return err
+			}
+			if visitor.VisitExternalSymbol != nil {
+				if err := visitor.VisitExternalSymbol(symbol); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// ReadMetadata decodes only the metadata message at the start of the given index. Encoders
+// emit fields in field number order, so a well-formed index always begins with its metadata.
+func ReadMetadata(r io.Reader) (*Metadata, error) {
+	num, payload, err := readTopLevelField(bufio.NewReader(r), MaxMetadataSize)
+	if err != nil {
+		if err == io.EOF {
+			return nil, ErrMissingMetadata
+		}
+		return nil, err
+	}
+	if num != indexMetadataField {
+		return nil, ErrMissingMetadata
+	}
+
+	metadata := &Metadata{}
+	if err := unmarshal(payload, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// IsIndexPrefix returns true if the given bytes look like the start of a SCIP index rather
+// than an LSIF index. LSIF indexes are JSON lines and always begin with an object, whereas
+// SCIP indexes begin with the tag of a length-delimited top-level Index field.
+func IsIndexPrefix(prefix []byte) bool {
+	if len(prefix) == 0 {
+		return false
+	}
+
+	num, typ, n := protowire.ConsumeTag(prefix)
+	if n < 0 {
+		return false
+	}
+
+	return typ == protowire.BytesType && num >= indexMetadataField && num <= indexExternalSymbolsField
+}
+
+// readTopLevelField reads the next field of the Index message from the given reader. The
+// payload of length-delimited fields is returned; other fields are skipped and a nil payload
+// is returned. Payloads larger than maxSize are rejected. This function returns io.EOF only
+// if the reader is exhausted at a field boundary.
+func readTopLevelField(r *bufio.Reader, maxSize uint64) (protowire.Number, []byte, error) {
+	tag, err := binary.ReadUvarint(r)
+	if err != nil {
+		if err == io.EOF {
+			return 0, nil, io.EOF
+		}
+		return 0, nil, errors.Wrap(ErrMalformedIndex, err.Error())
+	}
+
+	num, typ := protowire.DecodeTag(tag)
+	if !num.IsValid() {
+		return 0, nil, ErrMalformedIndex
+	}
+
+	switch typ {
+	case protowire.VarintType:
+		if _, err := binary.ReadUvarint(r); err != nil {
+			return 0, nil, errors.Wrap(ErrMalformedIndex, err.Error())
+		}
+		return num, nil, nil
+
+	case protowire.Fixed32Type:
+		return num, nil, discard(r, 4)
+
+	case protowire.Fixed64Type:
+		return num, nil, discard(r, 8)
+
+	case protowire.BytesType:
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return 0, nil, errors.Wrap(ErrMalformedIndex, err.Error())
+		}
+		if size > maxSize {
+			return 0, nil, errors.Wrapf(ErrMalformedIndex, "message of %d bytes exceeds limit of %d bytes", size, maxSize)
+		}
+
+		// Copy the payload rather than allocating the declared size up front so that a
+		// truncated input cannot force a large allocation.
+		var payload bytes.Buffer
+		if _, err := io.CopyN(&payload, r, int64(size)); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, nil, errors.Wrap(ErrMalformedIndex, err.Error())
+		}
+		return num, payload.Bytes(), nil
+	}
+
+	return 0, nil, errors.Wrapf(ErrMalformedIndex, "unsupported wire type %d", typ)
+}
+
+// unmarshal decodes the payload of a top-level field into the given message.
+func unmarshal(payload []byte, m proto.Message) error {
+	if err := proto.Unmarshal(payload, m); err != nil {
+		return errors.Wrap(ErrMalformedIndex, err.Error())
+	}
+	return nil
+}
+
+func discard(r *bufio.Reader, n int) error {
+	if _, err := r.Discard(n); err != nil {
+		return errors.Wrap(ErrMalformedIndex, err.Error())
+	}
+	return nil
+}
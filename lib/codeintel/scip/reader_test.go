@@ -0,0 +1,226 @@
+package scip_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip/sciptest"
+)
+
+var testMetadata = &scip.Metadata{
+	ToolInfo:    &scip.ToolInfo{Name: "scip-test", Version: "0.1.0", Arguments: []string{"--verbose", "index"}},
+	ProjectRoot: "file:///src",
+}
+
+var testDocuments = []*scip.Document{
+	{
+		RelativePath: "foo.go",
+		Occurrences: []*scip.Occurrence{
+			{
+				Range:       []int32{1, 5, 8},
+				Symbol:      "scip-go gomod example v1.0.0 `example`/Foo().",
+				SymbolRoles: int32(scip.SymbolRole_Definition),
+			},
+			{
+				Range:                 []int32{3, 2, 5, 1},
+				Symbol:                "local 0",
+				OverrideDocumentation: []string{"override"},
+				Diagnostics: []*scip.Diagnostic{
+					{Severity: scip.Severity_Warning, Code: "W1", Message: "unused", Source: "vet"},
+				},
+			},
+		},
+		Symbols: []*scip.SymbolInformation{
+			{
+				Symbol:        "scip-go gomod example v1.0.0 `example`/Foo().",
+				Documentation: []string{"```go\nfunc Foo()\n```", "Foo does things."},
+				Relationships: []*scip.Relationship{
+					{Symbol: "scip-go gomod example v1.0.0 `example`/Fooer#Foo().", IsImplementation: true},
+				},
+			},
+		},
+	},
+	{
+		RelativePath: "bar.go",
+	},
+}
+
+var testExternalSymbols = []*scip.SymbolInformation{
+	{Symbol: "scip-go gomod fmt . `fmt`/Println().", Documentation: []string{"Println formats"}},
+}
+
+// readIndex reads the given encoded index and returns all of its top-level messages.
+func readIndex(t *testing.T, encoded []byte) (metadata []*scip.Metadata, documents []*scip.Document, externalSymbols []*scip.SymbolInformation) {
+	t.Helper()
+
+	if err := scip.ReadIndex(bytes.NewReader(encoded), scip.IndexVisitor{
+		VisitMetadata:       func(m *scip.Metadata) error { metadata = append(metadata, m); return nil },
+		VisitDocument:       func(d *scip.Document) error { documents = append(documents, d); return nil },
+		VisitExternalSymbol: func(s *scip.SymbolInformation) error { externalSymbols = append(externalSymbols, s); return nil },
+	}); err != nil {
+		t.Fatalf("unexpected error reading index: %s", err)
+	}
+
+	return metadata, documents, externalSymbols
+}
+
+func TestReadIndex(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sciptest.WriteIndex(&buf, testMetadata, testDocuments, testExternalSymbols); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+
+	metadata, documents, externalSymbols := readIndex(t, buf.Bytes())
+	if diff := cmp.Diff([]*scip.Metadata{testMetadata}, metadata, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected metadata (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(testDocuments, documents, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected documents (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(testExternalSymbols, externalSymbols, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected external symbols (-want +got):\n%s", diff)
+	}
+}
+
+// TestReadIndexMarshalledIndex reads an index that is marshalled as a whole by the protobuf
+// runtime rather than one top-level message at a time.
+func TestReadIndexMarshalledIndex(t *testing.T) {
+	contents, err := os.ReadFile("testdata/index.textproto")
+	if err != nil {
+		t.Fatalf("unexpected error reading index: %s", err)
+	}
+	var index scip.Index
+	if err := prototext.Unmarshal(contents, &index); err != nil {
+		t.Fatalf("unexpected error parsing index: %s", err)
+	}
+	encoded, err := proto.Marshal(&index)
+	if err != nil {
+		t.Fatalf("unexpected error encoding index: %s", err)
+	}
+
+	metadata, documents, externalSymbols := readIndex(t, encoded)
+	if diff := cmp.Diff([]*scip.Metadata{index.Metadata}, metadata, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected metadata (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(index.Documents, documents, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected documents (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(index.ExternalSymbols, externalSymbols, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected external symbols (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadIndexVisitorError(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sciptest.WriteIndex(&buf, testMetadata, testDocuments, nil); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+
+	expectedErr := errors.New("oops")
+	err := scip.ReadIndex(&buf, scip.IndexVisitor{
+		VisitDocument: func(d *scip.Document) error { return expectedErr },
+	})
+	if err != expectedErr {
+		t.Fatalf("unexpected error. want=%q have=%q", expectedErr, err)
+	}
+}
+
+func TestReadIndexMalformed(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sciptest.WriteIndex(&buf, testMetadata, testDocuments, nil); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+
+	truncated := buf.Bytes()[:buf.Len()-3]
+	if err := scip.ReadIndex(bytes.NewReader(truncated), scip.IndexVisitor{}); !errors.Is(err, scip.ErrMalformedIndex) {
+		t.Fatalf("unexpected error. want=%q have=%q", scip.ErrMalformedIndex, err)
+	}
+
+	// An occurrence range must have three or four elements
+	document := protowire.AppendTag(nil, 2, protowire.BytesType)
+	document = protowire.AppendBytes(document, protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1))
+	index := protowire.AppendTag(nil, sciptest.IndexDocumentsField, protowire.BytesType)
+	index = protowire.AppendBytes(index, document)
+
+	if err := scip.ReadIndex(bytes.NewReader(index), scip.IndexVisitor{}); !errors.Is(err, scip.ErrMalformedIndex) {
+		t.Fatalf("unexpected error. want=%q have=%q", scip.ErrMalformedIndex, err)
+	}
+}
+
+func TestReadIndexOversizedMessage(t *testing.T) {
+	testCases := map[string]uint64{
+		"over limit":     scip.MaxMessageSize + 1,
+		"max uint64":     1<<64 - 1,
+		"truncated body": 1024,
+	}
+
+	for name, size := range testCases {
+		t.Run(name, func(t *testing.T) {
+			index := protowire.AppendTag(nil, sciptest.IndexDocumentsField, protowire.BytesType)
+			index = protowire.AppendVarint(index, size)
+			index = append(index, "bar.go"...)
+
+			if err := scip.ReadIndex(bytes.NewReader(index), scip.IndexVisitor{}); !errors.Is(err, scip.ErrMalformedIndex) {
+				t.Fatalf("unexpected error. want=%q have=%q", scip.ErrMalformedIndex, err)
+			}
+		})
+	}
+}
+
+func TestReadMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sciptest.WriteIndex(&buf, testMetadata, testDocuments, nil); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+
+	metadata, err := scip.ReadMetadata(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error reading metadata: %s", err)
+	}
+	if diff := cmp.Diff(testMetadata, metadata, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected metadata (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadMetadataMissing(t *testing.T) {
+	document, err := proto.Marshal(testDocuments[0])
+	if err != nil {
+		t.Fatalf("unexpected error encoding document: %s", err)
+	}
+	index := sciptest.AppendMessage(nil, sciptest.IndexDocumentsField, document)
+
+	if _, err := scip.ReadMetadata(bytes.NewReader(index)); err != scip.ErrMissingMetadata {
+		t.Fatalf("unexpected error. want=%q have=%q", scip.ErrMissingMetadata, err)
+	}
+	if _, err := scip.ReadMetadata(bytes.NewReader(nil)); err != scip.ErrMissingMetadata {
+		t.Fatalf("unexpected error. want=%q have=%q", scip.ErrMissingMetadata, err)
+	}
+}
+
+func TestIsIndexPrefix(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sciptest.WriteIndex(&buf, testMetadata, nil, nil); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+
+	testCases := map[string]bool{
+		buf.String(): true,
+		`{"id": 1, "type": "vertex", "label": "metaData"}`: false,
+		"": false,
+	}
+
+	for prefix, expected := range testCases {
+		if actual := scip.IsIndexPrefix([]byte(prefix)); actual != expected {
+			t.Errorf("unexpected result for %q. want=%v have=%v", prefix, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Package sciptest encodes SCIP indexes for use in tests of code that reads them.
+package sciptest
+
+import (
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+)
+
+// Field numbers of the top-level Index message.
+const (
+	IndexMetadataField        protowire.Number = 1
+	IndexDocumentsField       protowire.Number = 2
+	IndexExternalSymbolsField protowire.Number = 3
+)
+
+// WriteIndex writes the protobuf encoding of an index with the given metadata, documents,
+// and external symbols to the given writer. Each top-level message is written as soon as
+// it is encoded, which results in the same encoding as marshalling the whole index.
+func WriteIndex(w io.Writer, metadata *scip.Metadata, documents []*scip.Document, externalSymbols []*scip.SymbolInformation) error {
+	if err := writeMessage(w, IndexMetadataField, metadata); err != nil {
+		return err
+	}
+
+	for _, document := range documents {
+		if err := writeMessage(w, IndexDocumentsField, document); err != nil {
+			return err
+		}
+	}
+
+	for _, symbol := range externalSymbols {
+		if err := writeMessage(w, IndexExternalSymbolsField, symbol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeMessage(w io.Writer, num protowire.Number, m proto.Message) error {
+	encoded, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(AppendMessage(nil, num, encoded))
+	return err
+}
+
+// AppendMessage appends the given encoded message as a length-delimited field. Empty
+// messages are still written so that repeated fields retain their cardinality.
+func AppendMessage(b []byte, num protowire.Number, message []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, message)
+}
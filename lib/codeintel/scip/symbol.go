@@ -0,0 +1,90 @@
+package scip
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// localSymbolPrefix is the prefix of symbols that are only visible within a single document.
+const localSymbolPrefix = "local "
+
+// ParsedSymbol is the parsed form of a SCIP symbol string. Unlike Symbol, it keeps the
+// descriptors in their string form.
+//
+// Global symbols have the form `<scheme> <manager> <package-name> <version> <descriptors>`.
+// Spaces within the first four components are escaped as double spaces, and an empty
+// component is written as a single period.
+type ParsedSymbol struct {
+	Scheme      string
+	Package     *Package
+	Descriptors string
+}
+
+// IsLocalSymbol returns true if the given symbol is only visible within a single document.
+func IsLocalSymbol(symbol string) bool {
+	return strings.HasPrefix(symbol, localSymbolPrefix)
+}
+
+// ParseSymbol parses the given global symbol string.
+func ParseSymbol(symbol string) (ParsedSymbol, error) {
+	if symbol == "" {
+		return ParsedSymbol{}, errors.New("empty symbol")
+	}
+	if IsLocalSymbol(symbol) {
+		return ParsedSymbol{}, errors.Newf("local symbol %q has no global identity", symbol)
+	}
+
+	var components [4]string
+	rest := symbol
+	for i := range components {
+		component, remainder, ok := splitSymbolComponent(rest)
+		if !ok {
+			return ParsedSymbol{}, errors.Newf("malformed symbol %q", symbol)
+		}
+
+		components[i] = component
+		rest = remainder
+	}
+	if rest == "" {
+		return ParsedSymbol{}, errors.Newf("symbol %q has no descriptors", symbol)
+	}
+
+	return ParsedSymbol{
+		Scheme: components[0],
+		Package: &Package{
+			Manager: components[1],
+			Name:    components[2],
+			Version: components[3],
+		},
+		Descriptors: rest,
+	}, nil
+}
+
+// splitSymbolComponent returns the leading space-terminated component of the given string
+// with escaped spaces unescaped, along with the remainder of the string following the
+// terminating space.
+func splitSymbolComponent(s string) (component, rest string, ok bool) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != ' ' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		if i+1 < len(s) && s[i+1] == ' ' {
+			// Double space is an escaped space
+			b.WriteByte(' ')
+			i++
+			continue
+		}
+
+		component = b.String()
+		if component == "." {
+			component = ""
+		}
+		return component, s[i+1:], true
+	}
+
+	return "", "", false
+}
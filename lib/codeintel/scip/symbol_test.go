@@ -0,0 +1,58 @@
+package scip
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseSymbol(t *testing.T) {
+	testCases := []struct {
+		symbol   string
+		expected ParsedSymbol
+	}{
+		{
+			symbol: "scip-go gomod github.com/example/pkg v1.2.3 `github.com/example/pkg`/Foo#Bar().",
+			expected: ParsedSymbol{
+				Scheme:      "scip-go",
+				Package:     &Package{Manager: "gomod", Name: "github.com/example/pkg", Version: "v1.2.3"},
+				Descriptors: "`github.com/example/pkg`/Foo#Bar().",
+			},
+		},
+		{
+			symbol: "scip-typescript npm . . src/`index.ts`/foo.",
+			expected: ParsedSymbol{
+				Scheme:      "scip-typescript",
+				Package:     &Package{Manager: "npm"},
+				Descriptors: "src/`index.ts`/foo.",
+			},
+		},
+		{
+			symbol: "scip-java maven my  pkg 1.0 com/example/Foo#",
+			expected: ParsedSymbol{
+				Scheme:      "scip-java",
+				Package:     &Package{Manager: "maven", Name: "my pkg", Version: "1.0"},
+				Descriptors: "com/example/Foo#",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		symbol, err := ParseSymbol(testCase.symbol)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", testCase.symbol, err)
+		}
+		if diff := cmp.Diff(testCase.expected, symbol, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected symbol for %q (-want +got):\n%s", testCase.symbol, diff)
+		}
+	}
+}
+
+func TestParseSymbolMalformed(t *testing.T) {
+	for _, symbol := range []string{"", "local 12", "scip-go gomod", "scip-go gomod example v1.0.0 "} {
+		if _, err := ParseSymbol(symbol); err == nil {
+			t.Errorf("expected error parsing %q", symbol)
+		}
+	}
+}
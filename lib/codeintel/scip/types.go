@@ -0,0 +1,28 @@
+package scip
+
+import "github.com/cockroachdb/errors"
+
+// Range is a zero-based, half-open source range.
+type Range struct {
+	StartLine      int32
+	StartCharacter int32
+	EndLine        int32
+	EndCharacter   int32
+}
+
+// NewRange returns the range encoded in the range field of an occurrence. Single-line
+// ranges are encoded as three integers; EndLine is populated with StartLine in that case.
+func NewRange(r []int32) (Range, error) {
+	switch len(r) {
+	case 3:
+		return Range{StartLine: r[0], StartCharacter: r[1], EndLine: r[0], EndCharacter: r[2]}, nil
+	case 4:
+		return Range{StartLine: r[0], StartCharacter: r[1], EndLine: r[2], EndCharacter: r[3]}, nil
+	}
+	return Range{}, errors.Wrapf(ErrMalformedIndex, "occurrence range has %d elements", len(r))
+}
+
+// HasRole returns true if the occurrence has the given symbol role.
+func (o *Occurrence) HasRole(role SymbolRole) bool {
+	return o.GetSymbolRoles()&int32(role) != 0
+}
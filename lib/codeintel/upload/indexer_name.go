@@ -6,6 +6,8 @@ import (
 	"io"
 
 	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
 )
 
 // MaxBufferSize is the maximum size of the metaData line in the dump. This should be large enough
@@ -33,9 +35,15 @@ type toolInfo struct {
 
 // ReadIndexerName returns the name of the tool that generated the given index contents.
 // This function reads only the first line of the file, where the metadata vertex is
-// assumed to be in all valid dumps.
+// assumed to be in all valid dumps. SCIP indexes are also accepted, in which case only
+// the leading metadata message is read.
 func ReadIndexerName(r io.Reader) (string, error) {
-	line, isPrefix, err := bufio.NewReaderSize(r, MaxBufferSize).ReadLine()
+	br := bufio.NewReaderSize(r, MaxBufferSize)
+	if prefix, err := br.Peek(1); err == nil && scip.IsIndexPrefix(prefix) {
+		return readSCIPIndexerName(br)
+	}
+
+	line, isPrefix, err := br.ReadLine()
 	if err != nil {
 		return "", err
 	}
@@ -54,3 +62,13 @@ func ReadIndexerName(r io.Reader) (string, error) {
 
 	return meta.ToolInfo.Name, nil
 }
+
+// readSCIPIndexerName returns the name of the tool that generated the given SCIP index.
+func readSCIPIndexerName(r io.Reader) (string, error) {
+	metadata, err := scip.ReadMetadata(r)
+	if err != nil || metadata.GetToolInfo().GetName() == "" {
+		return "", ErrInvalidMetaDataVertex
+	}
+
+	return metadata.ToolInfo.Name, nil
+}
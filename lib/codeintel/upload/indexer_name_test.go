@@ -5,6 +5,9 @@ import (
 	"io"
 	"strings"
 	"testing"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip/sciptest"
 )
 
 const testMetaDataVertex = `{"label": "metaData", "toolInfo": {"name": "test"}}`
@@ -28,6 +31,34 @@ func TestReadIndexerNameMalformed(t *testing.T) {
 	}
 }
 
+func TestReadIndexerNameSCIP(t *testing.T) {
+	var buf bytes.Buffer
+	metadata := &scip.Metadata{ToolInfo: &scip.ToolInfo{Name: "scip-test"}, ProjectRoot: "file:///src"}
+	documents := []*scip.Document{{RelativePath: "main.go"}}
+	if err := sciptest.WriteIndex(&buf, metadata, documents, nil); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+
+	name, err := ReadIndexerName(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error reading indexer name: %s", err)
+	}
+	if name != "scip-test" {
+		t.Errorf("unexpected indexer name. want=%s have=%s", "scip-test", name)
+	}
+}
+
+func TestReadIndexerNameSCIPMissingMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sciptest.WriteIndex(&buf, &scip.Metadata{ProjectRoot: "file:///src"}, nil, nil); err != nil {
+		t.Fatalf("unexpected error writing index: %s", err)
+	}
+
+	if _, err := ReadIndexerName(&buf); err != ErrInvalidMetaDataVertex {
+		t.Fatalf("unexpected error reading indexer name. want=%q have=%q", ErrInvalidMetaDataVertex, err)
+	}
+}
+
 func generateTestIndex(metaDataVertex string) io.Reader {
 	lines := []string{metaDataVertex}
 	for i := 0; i < 20000; i++ {
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE cm_webhooks DROP COLUMN IF EXISTS secret;
+
+COMMIT;
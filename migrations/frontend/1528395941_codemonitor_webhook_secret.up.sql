@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE cm_webhooks ADD COLUMN IF NOT EXISTS secret TEXT;
+
+COMMENT ON COLUMN cm_webhooks.secret IS 'The secret used to sign webhook payloads. When not set, payloads are sent unsigned';
+
+COMMIT;
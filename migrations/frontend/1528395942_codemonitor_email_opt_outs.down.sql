@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS cm_email_opt_outs;
+
+COMMIT;
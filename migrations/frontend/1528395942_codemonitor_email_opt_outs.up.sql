@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS cm_email_opt_outs (
+	monitor BIGINT NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (monitor, user_id)
+);
+
+COMMENT ON TABLE cm_email_opt_outs IS 'Users who opted out of receiving emails from a code monitor';
+COMMENT ON COLUMN cm_email_opt_outs.monitor IS 'The code monitor the user no longer wants to receive emails from';
+COMMENT ON COLUMN cm_email_opt_outs.user_id IS 'The user who opted out. The user is skipped even if they are a direct or an organization recipient';
+
+COMMIT;
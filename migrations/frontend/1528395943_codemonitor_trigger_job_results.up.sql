@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE cm_trigger_jobs ADD COLUMN IF NOT EXISTS search_results JSONB;
+
+COMMENT ON COLUMN cm_trigger_jobs.search_results IS 'The first new commit and diff matches found by the trigger query, rendered by actions';
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE cm_webhooks DROP COLUMN IF EXISTS include_results;
+ALTER TABLE cm_slack_webhooks DROP COLUMN IF EXISTS include_results;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE cm_webhooks ADD COLUMN IF NOT EXISTS include_results BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE cm_slack_webhooks ADD COLUMN IF NOT EXISTS include_results BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN cm_webhooks.include_results IS 'Whether to include the matched commits and diff excerpts in webhook payloads';
+COMMENT ON COLUMN cm_slack_webhooks.include_results IS 'Whether to include the matched commits and diff excerpts in Slack messages';
+
+COMMIT;
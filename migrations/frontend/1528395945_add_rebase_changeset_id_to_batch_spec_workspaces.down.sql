@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE batch_spec_workspaces
+  DROP COLUMN IF EXISTS rebase_changeset_id;
+
+COMMIT;
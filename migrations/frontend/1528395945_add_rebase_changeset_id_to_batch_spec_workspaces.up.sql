@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE batch_spec_workspaces
+  ADD COLUMN IF NOT EXISTS rebase_changeset_id INTEGER REFERENCES changesets(id) ON DELETE SET NULL DEFERRABLE;
+
+COMMENT ON COLUMN batch_spec_workspaces.rebase_changeset_id IS 'The changeset with an auto-rebase policy that this workspace is executed again for, against the new base of the changeset';
+
+COMMIT;
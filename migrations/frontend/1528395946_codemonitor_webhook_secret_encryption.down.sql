@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE cm_webhooks DROP COLUMN IF EXISTS encryption_key_id;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE cm_webhooks ADD COLUMN IF NOT EXISTS encryption_key_id TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN cm_webhooks.encryption_key_id IS 'The identifier of the key used to encrypt secret. Empty when secret is stored in plaintext';
+
+COMMIT;
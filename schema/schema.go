@@ -78,12 +78,14 @@ type AuthProviderCommon struct {
 	DisplayName string `json:"displayName,omitempty"`
 }
 type AuthProviders struct {
-	Builtin       *BuiltinAuthProvider
-	Saml          *SAMLAuthProvider
-	Openidconnect *OpenIDConnectAuthProvider
-	HttpHeader    *HTTPHeaderAuthProvider
-	Github        *GitHubAuthProvider
-	Gitlab        *GitLabAuthProvider
+	Builtin        *BuiltinAuthProvider
+	Saml           *SAMLAuthProvider
+	Openidconnect  *OpenIDConnectAuthProvider
+	HttpHeader     *HTTPHeaderAuthProvider
+	Github         *GitHubAuthProvider
+	Gitlab         *GitLabAuthProvider
+	Bitbucketcloud *BitbucketCloudAuthProvider
+	Ldap           *LDAPAuthProvider
 }
 
 func (v AuthProviders) MarshalJSON() ([]byte, error) {
@@ -105,6 +107,12 @@ func (v AuthProviders) MarshalJSON() ([]byte, error) {
 	if v.Gitlab != nil {
 		return json.Marshal(v.Gitlab)
 	}
+	if v.Bitbucketcloud != nil {
+		return json.Marshal(v.Bitbucketcloud)
+	}
+	if v.Ldap != nil {
+		return json.Marshal(v.Ldap)
+	}
 	return nil, errors.New("tagged union type must have exactly 1 non-nil field value")
 }
 func (v *AuthProviders) UnmarshalJSON(data []byte) error {
@@ -115,6 +123,8 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	switch d.DiscriminantProperty {
+	case "bitbucketcloud":
+		return json.Unmarshal(data, &v.Bitbucketcloud)
 	case "builtin":
 		return json.Unmarshal(data, &v.Builtin)
 	case "github":
@@ -123,12 +133,14 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 		return json.Unmarshal(data, &v.Gitlab)
 	case "http-header":
 		return json.Unmarshal(data, &v.HttpHeader)
+	case "ldap":
+		return json.Unmarshal(data, &v.Ldap)
 	case "openidconnect":
 		return json.Unmarshal(data, &v.Openidconnect)
 	case "saml":
 		return json.Unmarshal(data, &v.Saml)
 	}
-	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"builtin", "saml", "openidconnect", "http-header", "github", "gitlab"})
+	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"builtin", "saml", "openidconnect", "http-header", "github", "gitlab", "bitbucketcloud", "ldap"})
 }
 
 type BackendInsight struct {
@@ -179,12 +191,34 @@ type BatchSpec struct {
 	Workspaces []*WorkspaceConfiguration `json:"workspaces,omitempty"`
 }
 
+// BitbucketCloudAuthProvider description: Configures the Bitbucket Cloud OAuth authentication provider for SSO. In addition to specifying this configuration object, you must also create an OAuth consumer in the settings of your Bitbucket Cloud workspace: https://support.atlassian.com/bitbucket-cloud/docs/use-oauth-on-bitbucket-cloud/. The consumer should have the `account`, `email` and `repository` permissions and the callback URL set to the concatenation of your Sourcegraph instance URL and "/.auth/bitbucketcloud/callback".
+type BitbucketCloudAuthProvider struct {
+	// AllowSignup description: Allows new visitors to sign up for accounts via Bitbucket Cloud authentication. If false, users signing in via Bitbucket Cloud must have an existing Sourcegraph account, which will be linked to their Bitbucket Cloud identity after sign-in.
+	AllowSignup *bool `json:"allowSignup,omitempty"`
+	// ApiURL description: The API URL of Bitbucket Cloud. Generally, admin should not modify the value of this option because Bitbucket Cloud is a public hosting platform.
+	ApiURL string `json:"apiURL,omitempty"`
+	// ClientKey description: The Key of the Bitbucket Cloud OAuth consumer.
+	ClientKey string `json:"clientKey"`
+	// ClientSecret description: The Secret of the Bitbucket Cloud OAuth consumer.
+	ClientSecret string `json:"clientSecret"`
+	DisplayName  string `json:"displayName,omitempty"`
+	Type         string `json:"type"`
+	// Url description: URL of Bitbucket Cloud. Generally, admin should not modify the value of this option because Bitbucket Cloud is a public hosting platform.
+	Url string `json:"url,omitempty"`
+}
+
+// BitbucketCloudAuthorization description: If non-null, enforces Bitbucket Cloud repository permissions. This requires that there is an item in the `auth.providers` field of type "bitbucketcloud" with the same `url` field as specified in this `BitbucketCloudConnection`, and that the configured user is an administrator of the workspaces in "teams".
+type BitbucketCloudAuthorization struct {
+}
+
 // BitbucketCloudConnection description: Configuration for a connection to Bitbucket Cloud.
 type BitbucketCloudConnection struct {
 	// ApiURL description: The API URL of Bitbucket Cloud, such as https://api.bitbucket.org. Generally, admin should not modify the value of this option because Bitbucket Cloud is a public hosting platform.
 	ApiURL string `json:"apiURL,omitempty"`
 	// AppPassword description: The app password to use when authenticating to the Bitbucket Cloud. Also set the corresponding "username" field.
 	AppPassword string `json:"appPassword"`
+	// Authorization description: If non-null, enforces Bitbucket Cloud repository permissions. This requires that there is an item in the `auth.providers` field of type "bitbucketcloud" with the same `url` field as specified in this `BitbucketCloudConnection`, and that the configured user is an administrator of the workspaces in "teams".
+	Authorization *BitbucketCloudAuthorization `json:"authorization,omitempty"`
 	// Exclude description: A list of repositories to never mirror from Bitbucket Cloud. Takes precedence over "teams" configuration.
 	//
 	// Supports excluding by name ({"name": "myorg/myrepo"}) or by UUID ({"uuid": "{fceb73c7-cef6-4abe-956d-e471281126bd}"}).
@@ -342,6 +376,10 @@ type BitbucketServerUsernameIdentity struct {
 	Type string `json:"type"`
 }
 type BranchChangesetSpec struct {
+	// AutoMerge description: Merge the changeset automatically once all its checks have passed and it has been approved on the code host. "squash" performs a squash merge on code hosts that support it, "merge" a regular merge. If omitted, the changeset is not merged automatically.
+	AutoMerge string `json:"autoMerge,omitempty"`
+	// AutoRebase description: Update the changeset automatically when its base branch moves or the code host reports it as not mergeable, by force-pushing a commit created against the new base commit.
+	AutoRebase bool `json:"autoRebase,omitempty"`
 	// BaseRef description: The full name of the Git ref in the base repository that this changeset is based on (and is proposing to be merged into). This ref must exist on the base repository.
 	BaseRef string `json:"baseRef"`
 	// BaseRepository description: The GraphQL ID of the repository that this changeset spec is proposing to change.
@@ -393,6 +431,10 @@ type BuiltinAuthProvider struct {
 
 // ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 type ChangesetTemplate struct {
+	// AutoMerge description: Merge the changesets automatically once all their checks have passed and they have been approved on the code host, respecting the rollout windows of the site. "squash" performs a squash merge on code hosts that support it, "merge" a regular merge. If omitted, changesets are not merged automatically.
+	AutoMerge string `json:"autoMerge,omitempty"`
+	// AutoRebase description: Update the changesets automatically when their base branch moves or the code host reports them as not mergeable. The workspaces of the batch spec are executed again against the new base commit and the branches are force-pushed. Only supported for batch specs that are executed on Sourcegraph; otherwise the existing diff is applied to the new base.
+	AutoRebase bool `json:"autoRebase,omitempty"`
 	// Body description: The body (description) of the changeset.
 	Body string `json:"body,omitempty"`
 	// Branch description: The name of the Git branch to create or update on each repository with the changes.
@@ -487,7 +529,8 @@ func (v *EncryptionKey) UnmarshalJSON(data []byte) error {
 type EncryptionKeys struct {
 	BatchChangesCredentialKey *EncryptionKey `json:"batchChangesCredentialKey,omitempty"`
 	// CacheSize description: number of values to keep in LRU cache
-	CacheSize int `json:"cacheSize,omitempty"`
+	CacheSize             int            `json:"cacheSize,omitempty"`
+	CodeMonitorWebhookKey *EncryptionKey `json:"codeMonitorWebhookKey,omitempty"`
 	// EnableCache description: enable LRU cache for decryption APIs
 	EnableCache            bool           `json:"enableCache,omitempty"`
 	ExternalServiceKey     *EncryptionKey `json:"externalServiceKey,omitempty"`
@@ -575,8 +618,12 @@ type ExperimentalFeatures struct {
 	EnableSubRepoPermissions bool `json:"enableSubRepoPermissions,omitempty"`
 	// EventLogging description: Enables user event logging inside of the Sourcegraph instance. This will allow admins to have greater visibility of user activity, such as frequently viewed pages, frequent searches, and more. These event logs (and any specific user actions) are only stored locally, and never leave this Sourcegraph instance.
 	EventLogging string `json:"eventLogging,omitempty"`
+	// GoPackages description: Allow adding Go module proxy code host connections
+	GoPackages string `json:"goPackages,omitempty"`
 	// JvmPackages description: Allow adding JVM packages code host connections
 	JvmPackages string `json:"jvmPackages,omitempty"`
+	// NpmPackages description: Allow adding npm packages code host connections
+	NpmPackages string `json:"npmPackages,omitempty"`
 	// Perforce description: Allow adding Perforce code host connections
 	Perforce string `json:"perforce,omitempty"`
 	// Ranking description: Experimental search result ranking options.
@@ -865,6 +912,26 @@ type GitoliteConnection struct {
 	Prefix string `json:"prefix"`
 }
 
+// GoModulesConnection description: Configuration for a connection to Go module proxies
+type GoModulesConnection struct {
+	// ChecksumDatabase description: The checksum database that module zips are verified against, in the format of GOSUMDB: a verifier key, optionally followed by a space and the URL of the database. Set to "off" to skip the verification, for example for private modules that aren't in the public checksum database.
+	ChecksumDatabase string `json:"checksumDatabase,omitempty"`
+	// Dependencies description: An array of "modulePath@version" strings specifying which Go modules to mirror on Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the Go module proxies.
+	RateLimit *GoRateLimit `json:"rateLimit,omitempty"`
+	// Urls description: The list of Go module proxy URLs to fetch modules from. Like GOPROXY, the proxies are tried in order, falling back to the next one if a module isn't found.
+	Urls []string `json:"urls,omitempty"`
+}
+
+// GoRateLimit description: Rate limit applied when making background API requests to the Go module proxies.
+type GoRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
 // HTTPHeaderAuthProvider description: Configures the HTTP header authentication provider (which authenticates users by consulting an HTTP request header set by an authentication proxy such as https://github.com/bitly/oauth2_proxy).
 type HTTPHeaderAuthProvider struct {
 	// EmailHeader description: The name (case-insensitive) of an HTTP header whose value is taken to be the email of the client requesting the page. Set this value when using an HTTP proxy that authenticates requests, and you don't want the extra configurability of the other authentication methods.
@@ -960,6 +1027,38 @@ type JVMPackagesConnection struct {
 	Maven *Maven `json:"maven,omitempty"`
 }
 
+// LDAPAuthProvider description: Configures the LDAP authentication provider, which authenticates users against an LDAP directory such as Active Directory. Sourcegraph binds with a service account, searches for the user entry and then binds as the user with the password they entered to verify it.
+type LDAPAuthProvider struct {
+	// AllowSignup description: Allows new visitors to sign up for accounts via LDAP authentication. If false, users signing in via LDAP must have an existing Sourcegraph account, which will be linked to their LDAP identity after sign-in.
+	AllowSignup *bool `json:"allowSignup,omitempty"`
+	// BindDN description: The DN of the service account used to search for users. If empty, searches are performed anonymously.
+	BindDN string `json:"bindDN,omitempty"`
+	// BindPassword description: The password of the service account.
+	BindPassword string `json:"bindPassword,omitempty"`
+	DisplayName  string `json:"displayName,omitempty"`
+	// DisplayNameAttribute description: The attribute of the user entry that holds the display name of the user.
+	DisplayNameAttribute string `json:"displayNameAttribute,omitempty"`
+	// EmailAttribute description: The attribute of the user entry that holds the email address of the user.
+	EmailAttribute string `json:"emailAttribute,omitempty"`
+	// GroupMembershipAttribute description: The attribute of the user entry that lists the DNs of the groups the user is a member of.
+	GroupMembershipAttribute string `json:"groupMembershipAttribute,omitempty"`
+	// GroupsToOrgs description: Maps the DNs of LDAP groups (case-insensitive) to the names of the Sourcegraph organizations that members of the group are added to when they sign in.
+	GroupsToOrgs map[string][]string `json:"groupsToOrgs,omitempty"`
+	// InsecureSkipVerify description: Do not verify the TLS certificate of the LDAP server. This is insecure and should only be used for testing.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// StartTLS description: Upgrade the connection to TLS with the StartTLS operation after connecting. Only applies to ldap:// URLs.
+	StartTLS bool   `json:"startTLS,omitempty"`
+	Type     string `json:"type"`
+	// Url description: URL of the LDAP server. Use the ldaps scheme to connect over TLS.
+	Url string `json:"url"`
+	// UserBaseDN description: The DN below which users are searched for.
+	UserBaseDN string `json:"userBaseDN"`
+	// UserFilter description: The LDAP filter that finds the entry of a user. The string `{username}` is replaced with the escaped username entered on the sign-in form.
+	UserFilter string `json:"userFilter,omitempty"`
+	// UsernameAttribute description: The attribute of the user entry that is used as the Sourcegraph username.
+	UsernameAttribute string `json:"usernameAttribute,omitempty"`
+}
+
 // Log description: Configuration for logging and alerting, including to external services.
 type Log struct {
 	// Sentry description: Configuration for Sentry
@@ -995,6 +1094,26 @@ type MountedEncryptionKey struct {
 	Version    string `json:"version,omitempty"`
 }
 
+// NPMPackagesConnection description: Configuration for a connection to an npm packages repository.
+type NPMPackagesConnection struct {
+	// Credentials description: Access token for logging into the npm registry.
+	Credentials string `json:"credentials,omitempty"`
+	// Dependencies description: An array of "(@scope/)?packageName@version" strings specifying which npm packages to mirror on Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the npm registry.
+	RateLimit *NPMRateLimit `json:"rateLimit,omitempty"`
+	// Registry description: The URL at which the npm registry can be found.
+	Registry string `json:"registry,omitempty"`
+}
+
+// NPMRateLimit description: Rate limit applied when making background API requests to the npm registry.
+type NPMRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
 // NoOpEncryptionKey description: This encryption key is a no op, leaving your data in plaintext (not recommended).
 type NoOpEncryptionKey struct {
 	Type string `json:"type"`